@@ -22,18 +22,87 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ManagementService_Kubeconfig_FullMethodName                 = "/management.ManagementService/Kubeconfig"
-	ManagementService_Talosconfig_FullMethodName                = "/management.ManagementService/Talosconfig"
-	ManagementService_Omniconfig_FullMethodName                 = "/management.ManagementService/Omniconfig"
-	ManagementService_MachineLogs_FullMethodName                = "/management.ManagementService/MachineLogs"
-	ManagementService_ValidateConfig_FullMethodName             = "/management.ManagementService/ValidateConfig"
-	ManagementService_CreateServiceAccount_FullMethodName       = "/management.ManagementService/CreateServiceAccount"
-	ManagementService_RenewServiceAccount_FullMethodName        = "/management.ManagementService/RenewServiceAccount"
-	ManagementService_ListServiceAccounts_FullMethodName        = "/management.ManagementService/ListServiceAccounts"
-	ManagementService_DestroyServiceAccount_FullMethodName      = "/management.ManagementService/DestroyServiceAccount"
-	ManagementService_KubernetesUpgradePreChecks_FullMethodName = "/management.ManagementService/KubernetesUpgradePreChecks"
-	ManagementService_KubernetesSyncManifests_FullMethodName    = "/management.ManagementService/KubernetesSyncManifests"
-	ManagementService_CreateSchematic_FullMethodName            = "/management.ManagementService/CreateSchematic"
+	ManagementService_Kubeconfig_FullMethodName                       = "/management.ManagementService/Kubeconfig"
+	ManagementService_Talosconfig_FullMethodName                      = "/management.ManagementService/Talosconfig"
+	ManagementService_Omniconfig_FullMethodName                       = "/management.ManagementService/Omniconfig"
+	ManagementService_MachineLogs_FullMethodName                      = "/management.ManagementService/MachineLogs"
+	ManagementService_ValidateConfig_FullMethodName                   = "/management.ManagementService/ValidateConfig"
+	ManagementService_CreateServiceAccount_FullMethodName             = "/management.ManagementService/CreateServiceAccount"
+	ManagementService_RenewServiceAccount_FullMethodName              = "/management.ManagementService/RenewServiceAccount"
+	ManagementService_RotateServiceAccount_FullMethodName             = "/management.ManagementService/RotateServiceAccount"
+	ManagementService_ListServiceAccounts_FullMethodName              = "/management.ManagementService/ListServiceAccounts"
+	ManagementService_DestroyServiceAccount_FullMethodName            = "/management.ManagementService/DestroyServiceAccount"
+	ManagementService_GetServiceAccountKey_FullMethodName             = "/management.ManagementService/GetServiceAccountKey"
+	ManagementService_KubernetesUpgradePreChecks_FullMethodName       = "/management.ManagementService/KubernetesUpgradePreChecks"
+	ManagementService_KubernetesSyncManifests_FullMethodName          = "/management.ManagementService/KubernetesSyncManifests"
+	ManagementService_CreateSchematic_FullMethodName                  = "/management.ManagementService/CreateSchematic"
+	ManagementService_ImportExternalNodes_FullMethodName              = "/management.ManagementService/ImportExternalNodes"
+	ManagementService_ValidateServiceAccountKey_FullMethodName        = "/management.ManagementService/ValidateServiceAccountKey"
+	ManagementService_CompareKernelArgs_FullMethodName                = "/management.ManagementService/CompareKernelArgs"
+	ManagementService_GetSchematicPXEURL_FullMethodName               = "/management.ManagementService/GetSchematicPXEURL"
+	ManagementService_GetInstallerImageURL_FullMethodName             = "/management.ManagementService/GetInstallerImageURL"
+	ManagementService_WatchKubernetesEvents_FullMethodName            = "/management.ManagementService/WatchKubernetesEvents"
+	ManagementService_CreateMachineGroup_FullMethodName               = "/management.ManagementService/CreateMachineGroup"
+	ManagementService_LabelMachineGroup_FullMethodName                = "/management.ManagementService/LabelMachineGroup"
+	ManagementService_RebootMachineGroup_FullMethodName               = "/management.ManagementService/RebootMachineGroup"
+	ManagementService_GetMachinePatchOrder_FullMethodName             = "/management.ManagementService/GetMachinePatchOrder"
+	ManagementService_SetKubernetesUpgradeWindow_FullMethodName       = "/management.ManagementService/SetKubernetesUpgradeWindow"
+	ManagementService_DeleteKubernetesUpgradeWindow_FullMethodName    = "/management.ManagementService/DeleteKubernetesUpgradeWindow"
+	ManagementService_DecommissionMachine_FullMethodName              = "/management.ManagementService/DecommissionMachine"
+	ManagementService_ListMachinesByTalosVersion_FullMethodName       = "/management.ManagementService/ListMachinesByTalosVersion"
+	ManagementService_GetMachineDmesg_FullMethodName                  = "/management.ManagementService/GetMachineDmesg"
+	ManagementService_SimulateAccessPolicy_FullMethodName             = "/management.ManagementService/SimulateAccessPolicy"
+	ManagementService_GetKubeletStatus_FullMethodName                 = "/management.ManagementService/GetKubeletStatus"
+	ManagementService_GetInstallDiskSelection_FullMethodName          = "/management.ManagementService/GetInstallDiskSelection"
+	ManagementService_CreateSchematics_FullMethodName                 = "/management.ManagementService/CreateSchematics"
+	ManagementService_CompareSchematicToBaseline_FullMethodName       = "/management.ManagementService/CompareSchematicToBaseline"
+	ManagementService_GetMachineStatusAt_FullMethodName               = "/management.ManagementService/GetMachineStatusAt"
+	ManagementService_ValidateUpgradeImageReachability_FullMethodName = "/management.ManagementService/ValidateUpgradeImageReachability"
+	ManagementService_ExportServiceAccounts_FullMethodName            = "/management.ManagementService/ExportServiceAccounts"
+	ManagementService_ImportServiceAccounts_FullMethodName            = "/management.ManagementService/ImportServiceAccounts"
+	ManagementService_ListUnhealthyMachines_FullMethodName            = "/management.ManagementService/ListUnhealthyMachines"
+	ManagementService_GetClusterCapacity_FullMethodName               = "/management.ManagementService/GetClusterCapacity"
+	ManagementService_CreateSchematicWithProgress_FullMethodName      = "/management.ManagementService/CreateSchematicWithProgress"
+	ManagementService_GetClockDrift_FullMethodName                    = "/management.ManagementService/GetClockDrift"
+	ManagementService_SetMachineReserved_FullMethodName               = "/management.ManagementService/SetMachineReserved"
+	ManagementService_ListUnallocatedMachines_FullMethodName          = "/management.ManagementService/ListUnallocatedMachines"
+	ManagementService_GetMachineResource_FullMethodName               = "/management.ManagementService/GetMachineResource"
+	ManagementService_OmniconfigFor_FullMethodName                    = "/management.ManagementService/OmniconfigFor"
+	ManagementService_ReapplyMachineConfig_FullMethodName             = "/management.ManagementService/ReapplyMachineConfig"
+	ManagementService_ListKubernetesUpgrades_FullMethodName           = "/management.ManagementService/ListKubernetesUpgrades"
+	ManagementService_ValidateClusterComposition_FullMethodName       = "/management.ManagementService/ValidateClusterComposition"
+	ManagementService_StreamClusterUtilization_FullMethodName         = "/management.ManagementService/StreamClusterUtilization"
+	ManagementService_SetSchematicName_FullMethodName                 = "/management.ManagementService/SetSchematicName"
+	ManagementService_ValidateOmniEndpoint_FullMethodName             = "/management.ManagementService/ValidateOmniEndpoint"
+	ManagementService_DiffSchematics_FullMethodName                   = "/management.ManagementService/DiffSchematics"
+	ManagementService_ListInvalidSchematicMachines_FullMethodName     = "/management.ManagementService/ListInvalidSchematicMachines"
+	ManagementService_GetMachineStatusJSON_FullMethodName             = "/management.ManagementService/GetMachineStatusJSON"
+	ManagementService_ConfirmServiceAccountKey_FullMethodName         = "/management.ManagementService/ConfirmServiceAccountKey"
+	ManagementService_QueryMachineStatuses_FullMethodName             = "/management.ManagementService/QueryMachineStatuses"
+	ManagementService_GetPendingMachineConfig_FullMethodName          = "/management.ManagementService/GetPendingMachineConfig"
+	ManagementService_ListAllServiceAccountKeys_FullMethodName        = "/management.ManagementService/ListAllServiceAccountKeys"
+	ManagementService_SetMachineLogRetention_FullMethodName           = "/management.ManagementService/SetMachineLogRetention"
+	ManagementService_ValidateClusterMembership_FullMethodName        = "/management.ManagementService/ValidateClusterMembership"
+	ManagementService_GetJoinKernelArgs_FullMethodName                = "/management.ManagementService/GetJoinKernelArgs"
+	ManagementService_GetAvailableExtensionUpdates_FullMethodName     = "/management.ManagementService/GetAvailableExtensionUpdates"
+	ManagementService_ValidateSchematicUpgrade_FullMethodName         = "/management.ManagementService/ValidateSchematicUpgrade"
+	ManagementService_GetClusterTopology_FullMethodName               = "/management.ManagementService/GetClusterTopology"
+	ManagementService_ListMachinesByUptime_FullMethodName             = "/management.ManagementService/ListMachinesByUptime"
+	ManagementService_ApplyMaintenanceConfig_FullMethodName           = "/management.ManagementService/ApplyMaintenanceConfig"
+	ManagementService_ListSchematicsWithStaleArgs_FullMethodName      = "/management.ManagementService/ListSchematicsWithStaleArgs"
+	ManagementService_DestroyServiceAccounts_FullMethodName           = "/management.ManagementService/DestroyServiceAccounts"
+	ManagementService_GetConfigSchema_FullMethodName                  = "/management.ManagementService/GetConfigSchema"
+	ManagementService_GetMachineEvents_FullMethodName                 = "/management.ManagementService/GetMachineEvents"
+	ManagementService_ValidateMetaValues_FullMethodName               = "/management.ManagementService/ValidateMetaValues"
+	ManagementService_WhoAmI_FullMethodName                           = "/management.ManagementService/WhoAmI"
+	ManagementService_GetExtensionUsage_FullMethodName                = "/management.ManagementService/GetExtensionUsage"
+	ManagementService_GetSchematicISOURL_FullMethodName               = "/management.ManagementService/GetSchematicISOURL"
+	ManagementService_ListMachinesWithHardwareChanges_FullMethodName  = "/management.ManagementService/ListMachinesWithHardwareChanges"
+	ManagementService_TestServiceAccount_FullMethodName               = "/management.ManagementService/TestServiceAccount"
+	ManagementService_WatchUpgradeProgress_FullMethodName             = "/management.ManagementService/WatchUpgradeProgress"
+	ManagementService_GetMachineStatusTimeSeries_FullMethodName       = "/management.ManagementService/GetMachineStatusTimeSeries"
+	ManagementService_ListMachinesPendingReboot_FullMethodName        = "/management.ManagementService/ListMachinesPendingReboot"
+	ManagementService_ValidateClusterConfigs_FullMethodName           = "/management.ManagementService/ValidateClusterConfigs"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -44,14 +113,83 @@ type ManagementServiceClient interface {
 	Talosconfig(ctx context.Context, in *TalosconfigRequest, opts ...grpc.CallOption) (*TalosconfigResponse, error)
 	Omniconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OmniconfigResponse, error)
 	MachineLogs(ctx context.Context, in *MachineLogsRequest, opts ...grpc.CallOption) (ManagementService_MachineLogsClient, error)
-	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
 	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
 	RenewServiceAccount(ctx context.Context, in *RenewServiceAccountRequest, opts ...grpc.CallOption) (*RenewServiceAccountResponse, error)
-	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
-	DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RotateServiceAccount(ctx context.Context, in *RotateServiceAccountRequest, opts ...grpc.CallOption) (*RotateServiceAccountResponse, error)
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*DestroyServiceAccountResponse, error)
+	GetServiceAccountKey(ctx context.Context, in *GetServiceAccountKeyRequest, opts ...grpc.CallOption) (*GetServiceAccountKeyResponse, error)
 	KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error)
 	KubernetesSyncManifests(ctx context.Context, in *KubernetesSyncManifestRequest, opts ...grpc.CallOption) (ManagementService_KubernetesSyncManifestsClient, error)
 	CreateSchematic(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (*CreateSchematicResponse, error)
+	ImportExternalNodes(ctx context.Context, in *ImportExternalNodesRequest, opts ...grpc.CallOption) (*ImportExternalNodesResponse, error)
+	ValidateServiceAccountKey(ctx context.Context, in *ValidateServiceAccountKeyRequest, opts ...grpc.CallOption) (*ValidateServiceAccountKeyResponse, error)
+	CompareKernelArgs(ctx context.Context, in *CompareKernelArgsRequest, opts ...grpc.CallOption) (*CompareKernelArgsResponse, error)
+	GetSchematicPXEURL(ctx context.Context, in *GetSchematicPXEURLRequest, opts ...grpc.CallOption) (*GetSchematicPXEURLResponse, error)
+	GetInstallerImageURL(ctx context.Context, in *GetInstallerImageURLRequest, opts ...grpc.CallOption) (*GetInstallerImageURLResponse, error)
+	WatchKubernetesEvents(ctx context.Context, in *WatchKubernetesEventsRequest, opts ...grpc.CallOption) (ManagementService_WatchKubernetesEventsClient, error)
+	CreateMachineGroup(ctx context.Context, in *CreateMachineGroupRequest, opts ...grpc.CallOption) (*CreateMachineGroupResponse, error)
+	LabelMachineGroup(ctx context.Context, in *LabelMachineGroupRequest, opts ...grpc.CallOption) (*MachineGroupOperationResponse, error)
+	RebootMachineGroup(ctx context.Context, in *RebootMachineGroupRequest, opts ...grpc.CallOption) (*MachineGroupOperationResponse, error)
+	GetMachinePatchOrder(ctx context.Context, in *GetMachinePatchOrderRequest, opts ...grpc.CallOption) (*GetMachinePatchOrderResponse, error)
+	SetKubernetesUpgradeWindow(ctx context.Context, in *SetKubernetesUpgradeWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteKubernetesUpgradeWindow(ctx context.Context, in *DeleteKubernetesUpgradeWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecommissionMachine(ctx context.Context, in *DecommissionMachineRequest, opts ...grpc.CallOption) (ManagementService_DecommissionMachineClient, error)
+	ListMachinesByTalosVersion(ctx context.Context, in *ListMachinesByTalosVersionRequest, opts ...grpc.CallOption) (*ListMachinesByTalosVersionResponse, error)
+	GetMachineDmesg(ctx context.Context, in *GetMachineDmesgRequest, opts ...grpc.CallOption) (ManagementService_GetMachineDmesgClient, error)
+	SimulateAccessPolicy(ctx context.Context, in *SimulateAccessPolicyRequest, opts ...grpc.CallOption) (*SimulateAccessPolicyResponse, error)
+	GetKubeletStatus(ctx context.Context, in *GetKubeletStatusRequest, opts ...grpc.CallOption) (*GetKubeletStatusResponse, error)
+	GetInstallDiskSelection(ctx context.Context, in *GetInstallDiskSelectionRequest, opts ...grpc.CallOption) (*GetInstallDiskSelectionResponse, error)
+	CreateSchematics(ctx context.Context, in *CreateSchematicsRequest, opts ...grpc.CallOption) (*CreateSchematicsResponse, error)
+	CompareSchematicToBaseline(ctx context.Context, in *CompareSchematicToBaselineRequest, opts ...grpc.CallOption) (*CompareSchematicToBaselineResponse, error)
+	GetMachineStatusAt(ctx context.Context, in *GetMachineStatusAtRequest, opts ...grpc.CallOption) (*GetMachineStatusAtResponse, error)
+	ValidateUpgradeImageReachability(ctx context.Context, in *ValidateUpgradeImageReachabilityRequest, opts ...grpc.CallOption) (*ValidateUpgradeImageReachabilityResponse, error)
+	ExportServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportServiceAccountsResponse, error)
+	ImportServiceAccounts(ctx context.Context, in *ImportServiceAccountsRequest, opts ...grpc.CallOption) (*ImportServiceAccountsResponse, error)
+	ListUnhealthyMachines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListUnhealthyMachinesResponse, error)
+	GetClusterCapacity(ctx context.Context, in *GetClusterCapacityRequest, opts ...grpc.CallOption) (*GetClusterCapacityResponse, error)
+	CreateSchematicWithProgress(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (ManagementService_CreateSchematicWithProgressClient, error)
+	GetClockDrift(ctx context.Context, in *GetClockDriftRequest, opts ...grpc.CallOption) (*GetClockDriftResponse, error)
+	SetMachineReserved(ctx context.Context, in *SetMachineReservedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListUnallocatedMachines(ctx context.Context, in *ListUnallocatedMachinesRequest, opts ...grpc.CallOption) (*ListUnallocatedMachinesResponse, error)
+	GetMachineResource(ctx context.Context, in *GetMachineResourceRequest, opts ...grpc.CallOption) (*GetMachineResourceResponse, error)
+	OmniconfigFor(ctx context.Context, in *OmniconfigForRequest, opts ...grpc.CallOption) (*OmniconfigResponse, error)
+	ReapplyMachineConfig(ctx context.Context, in *ReapplyMachineConfigRequest, opts ...grpc.CallOption) (*ReapplyMachineConfigResponse, error)
+	ListKubernetesUpgrades(ctx context.Context, in *ListKubernetesUpgradesRequest, opts ...grpc.CallOption) (*ListKubernetesUpgradesResponse, error)
+	ValidateClusterComposition(ctx context.Context, in *ValidateClusterCompositionRequest, opts ...grpc.CallOption) (*ValidateClusterCompositionResponse, error)
+	StreamClusterUtilization(ctx context.Context, in *StreamClusterUtilizationRequest, opts ...grpc.CallOption) (ManagementService_StreamClusterUtilizationClient, error)
+	SetSchematicName(ctx context.Context, in *SetSchematicNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateOmniEndpoint(ctx context.Context, in *ValidateOmniEndpointRequest, opts ...grpc.CallOption) (*ValidateOmniEndpointResponse, error)
+	DiffSchematics(ctx context.Context, in *DiffSchematicsRequest, opts ...grpc.CallOption) (*DiffSchematicsResponse, error)
+	ListInvalidSchematicMachines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListInvalidSchematicMachinesResponse, error)
+	GetMachineStatusJSON(ctx context.Context, in *GetMachineStatusJSONRequest, opts ...grpc.CallOption) (*GetMachineStatusJSONResponse, error)
+	ConfirmServiceAccountKey(ctx context.Context, in *ConfirmServiceAccountKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QueryMachineStatuses(ctx context.Context, in *QueryMachineStatusesRequest, opts ...grpc.CallOption) (*QueryMachineStatusesResponse, error)
+	GetPendingMachineConfig(ctx context.Context, in *GetPendingMachineConfigRequest, opts ...grpc.CallOption) (*GetPendingMachineConfigResponse, error)
+	ListAllServiceAccountKeys(ctx context.Context, in *ListAllServiceAccountKeysRequest, opts ...grpc.CallOption) (*ListAllServiceAccountKeysResponse, error)
+	SetMachineLogRetention(ctx context.Context, in *SetMachineLogRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateClusterMembership(ctx context.Context, in *ValidateClusterMembershipRequest, opts ...grpc.CallOption) (*ValidateClusterMembershipResponse, error)
+	GetJoinKernelArgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetJoinKernelArgsResponse, error)
+	GetAvailableExtensionUpdates(ctx context.Context, in *GetAvailableExtensionUpdatesRequest, opts ...grpc.CallOption) (*GetAvailableExtensionUpdatesResponse, error)
+	ValidateSchematicUpgrade(ctx context.Context, in *ValidateSchematicUpgradeRequest, opts ...grpc.CallOption) (*ValidateSchematicUpgradeResponse, error)
+	GetClusterTopology(ctx context.Context, in *GetClusterTopologyRequest, opts ...grpc.CallOption) (*GetClusterTopologyResponse, error)
+	ListMachinesByUptime(ctx context.Context, in *ListMachinesByUptimeRequest, opts ...grpc.CallOption) (*ListMachinesByUptimeResponse, error)
+	ApplyMaintenanceConfig(ctx context.Context, in *ApplyMaintenanceConfigRequest, opts ...grpc.CallOption) (*ApplyMaintenanceConfigResponse, error)
+	ListSchematicsWithStaleArgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSchematicsWithStaleArgsResponse, error)
+	DestroyServiceAccounts(ctx context.Context, in *DestroyServiceAccountsRequest, opts ...grpc.CallOption) (*DestroyServiceAccountsResponse, error)
+	GetConfigSchema(ctx context.Context, in *GetConfigSchemaRequest, opts ...grpc.CallOption) (*GetConfigSchemaResponse, error)
+	GetMachineEvents(ctx context.Context, in *GetMachineEventsRequest, opts ...grpc.CallOption) (*GetMachineEventsResponse, error)
+	ValidateMetaValues(ctx context.Context, in *ValidateMetaValuesRequest, opts ...grpc.CallOption) (*ValidateMetaValuesResponse, error)
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	GetExtensionUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExtensionUsageResponse, error)
+	GetSchematicISOURL(ctx context.Context, in *GetSchematicISOURLRequest, opts ...grpc.CallOption) (*GetSchematicISOURLResponse, error)
+	ListMachinesWithHardwareChanges(ctx context.Context, in *ListMachinesWithHardwareChangesRequest, opts ...grpc.CallOption) (*ListMachinesWithHardwareChangesResponse, error)
+	TestServiceAccount(ctx context.Context, in *TestServiceAccountRequest, opts ...grpc.CallOption) (*TestServiceAccountResponse, error)
+	WatchUpgradeProgress(ctx context.Context, in *WatchUpgradeProgressRequest, opts ...grpc.CallOption) (ManagementService_WatchUpgradeProgressClient, error)
+	GetMachineStatusTimeSeries(ctx context.Context, in *GetMachineStatusTimeSeriesRequest, opts ...grpc.CallOption) (*GetMachineStatusTimeSeriesResponse, error)
+	ListMachinesPendingReboot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMachinesPendingRebootResponse, error)
+	ValidateClusterConfigs(ctx context.Context, in *ValidateClusterConfigsRequest, opts ...grpc.CallOption) (ManagementService_ValidateClusterConfigsClient, error)
 }
 
 type managementServiceClient struct {
@@ -121,8 +259,8 @@ func (x *managementServiceMachineLogsClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
-func (c *managementServiceClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *managementServiceClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	out := new(ValidateConfigResponse)
 	err := c.cc.Invoke(ctx, ManagementService_ValidateConfig_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -148,7 +286,16 @@ func (c *managementServiceClient) RenewServiceAccount(ctx context.Context, in *R
 	return out, nil
 }
 
-func (c *managementServiceClient) ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+func (c *managementServiceClient) RotateServiceAccount(ctx context.Context, in *RotateServiceAccountRequest, opts ...grpc.CallOption) (*RotateServiceAccountResponse, error) {
+	out := new(RotateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RotateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
 	out := new(ListServiceAccountsResponse)
 	err := c.cc.Invoke(ctx, ManagementService_ListServiceAccounts_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -157,8 +304,8 @@ func (c *managementServiceClient) ListServiceAccounts(ctx context.Context, in *e
 	return out, nil
 }
 
-func (c *managementServiceClient) DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *managementServiceClient) DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*DestroyServiceAccountResponse, error) {
+	out := new(DestroyServiceAccountResponse)
 	err := c.cc.Invoke(ctx, ManagementService_DestroyServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -166,6 +313,15 @@ func (c *managementServiceClient) DestroyServiceAccount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *managementServiceClient) GetServiceAccountKey(ctx context.Context, in *GetServiceAccountKeyRequest, opts ...grpc.CallOption) (*GetServiceAccountKeyResponse, error) {
+	out := new(GetServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementServiceClient) KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error) {
 	out := new(KubernetesUpgradePreChecksResponse)
 	err := c.cc.Invoke(ctx, ManagementService_KubernetesUpgradePreChecks_FullMethodName, in, out, opts...)
@@ -216,300 +372,2603 @@ func (c *managementServiceClient) CreateSchematic(ctx context.Context, in *Creat
 	return out, nil
 }
 
-// ManagementServiceServer is the server API for ManagementService service.
-// All implementations must embed UnimplementedManagementServiceServer
-// for forward compatibility
-type ManagementServiceServer interface {
-	Kubeconfig(context.Context, *KubeconfigRequest) (*KubeconfigResponse, error)
-	Talosconfig(context.Context, *TalosconfigRequest) (*TalosconfigResponse, error)
-	Omniconfig(context.Context, *emptypb.Empty) (*OmniconfigResponse, error)
-	MachineLogs(*MachineLogsRequest, ManagementService_MachineLogsServer) error
-	ValidateConfig(context.Context, *ValidateConfigRequest) (*emptypb.Empty, error)
-	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
-	RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error)
-	ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error)
-	DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error)
-	KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error)
-	KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error
-	CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error)
-	mustEmbedUnimplementedManagementServiceServer()
+func (c *managementServiceClient) ImportExternalNodes(ctx context.Context, in *ImportExternalNodesRequest, opts ...grpc.CallOption) (*ImportExternalNodesResponse, error) {
+	out := new(ImportExternalNodesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ImportExternalNodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedManagementServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedManagementServiceServer struct {
+func (c *managementServiceClient) ValidateServiceAccountKey(ctx context.Context, in *ValidateServiceAccountKeyRequest, opts ...grpc.CallOption) (*ValidateServiceAccountKeyResponse, error) {
+	out := new(ValidateServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedManagementServiceServer) Kubeconfig(context.Context, *KubeconfigRequest) (*KubeconfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+func (c *managementServiceClient) CompareKernelArgs(ctx context.Context, in *CompareKernelArgsRequest, opts ...grpc.CallOption) (*CompareKernelArgsResponse, error) {
+	out := new(CompareKernelArgsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_CompareKernelArgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) Talosconfig(context.Context, *TalosconfigRequest) (*TalosconfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Talosconfig not implemented")
+
+func (c *managementServiceClient) GetSchematicPXEURL(ctx context.Context, in *GetSchematicPXEURLRequest, opts ...grpc.CallOption) (*GetSchematicPXEURLResponse, error) {
+	out := new(GetSchematicPXEURLResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetSchematicPXEURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) Omniconfig(context.Context, *emptypb.Empty) (*OmniconfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Omniconfig not implemented")
+
+func (c *managementServiceClient) GetInstallerImageURL(ctx context.Context, in *GetInstallerImageURLRequest, opts ...grpc.CallOption) (*GetInstallerImageURLResponse, error) {
+	out := new(GetInstallerImageURLResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetInstallerImageURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) MachineLogs(*MachineLogsRequest, ManagementService_MachineLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method MachineLogs not implemented")
+
+func (c *managementServiceClient) WatchKubernetesEvents(ctx context.Context, in *WatchKubernetesEventsRequest, opts ...grpc.CallOption) (ManagementService_WatchKubernetesEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[2], ManagementService_WatchKubernetesEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceWatchKubernetesEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedManagementServiceServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+
+type ManagementService_WatchKubernetesEventsClient interface {
+	Recv() (*WatchKubernetesEventsResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedManagementServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+
+type managementServiceWatchKubernetesEventsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedManagementServiceServer) RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewServiceAccount not implemented")
+
+func (x *managementServiceWatchKubernetesEventsClient) Recv() (*WatchKubernetesEventsResponse, error) {
+	m := new(WatchKubernetesEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedManagementServiceServer) ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+
+func (c *managementServiceClient) CreateMachineGroup(ctx context.Context, in *CreateMachineGroupRequest, opts ...grpc.CallOption) (*CreateMachineGroupResponse, error) {
+	out := new(CreateMachineGroupResponse)
+	err := c.cc.Invoke(ctx, ManagementService_CreateMachineGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DestroyServiceAccount not implemented")
+
+func (c *managementServiceClient) LabelMachineGroup(ctx context.Context, in *LabelMachineGroupRequest, opts ...grpc.CallOption) (*MachineGroupOperationResponse, error) {
+	out := new(MachineGroupOperationResponse)
+	err := c.cc.Invoke(ctx, ManagementService_LabelMachineGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KubernetesUpgradePreChecks not implemented")
+
+func (c *managementServiceClient) RebootMachineGroup(ctx context.Context, in *RebootMachineGroupRequest, opts ...grpc.CallOption) (*MachineGroupOperationResponse, error) {
+	out := new(MachineGroupOperationResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RebootMachineGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error {
-	return status.Errorf(codes.Unimplemented, "method KubernetesSyncManifests not implemented")
+
+func (c *managementServiceClient) GetMachinePatchOrder(ctx context.Context, in *GetMachinePatchOrderRequest, opts ...grpc.CallOption) (*GetMachinePatchOrderResponse, error) {
+	out := new(GetMachinePatchOrderResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachinePatchOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSchematic not implemented")
+
+func (c *managementServiceClient) SetKubernetesUpgradeWindow(ctx context.Context, in *SetKubernetesUpgradeWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_SetKubernetesUpgradeWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
 
-// UnsafeManagementServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ManagementServiceServer will
-// result in compilation errors.
-type UnsafeManagementServiceServer interface {
-	mustEmbedUnimplementedManagementServiceServer()
+func (c *managementServiceClient) DeleteKubernetesUpgradeWindow(ctx context.Context, in *DeleteKubernetesUpgradeWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_DeleteKubernetesUpgradeWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterManagementServiceServer(s grpc.ServiceRegistrar, srv ManagementServiceServer) {
-	s.RegisterService(&ManagementService_ServiceDesc, srv)
+func (c *managementServiceClient) DecommissionMachine(ctx context.Context, in *DecommissionMachineRequest, opts ...grpc.CallOption) (ManagementService_DecommissionMachineClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[3], ManagementService_DecommissionMachine_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceDecommissionMachineClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func _ManagementService_Kubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KubeconfigRequest)
+type ManagementService_DecommissionMachineClient interface {
+	Recv() (*DecommissionMachineResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceDecommissionMachineClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceDecommissionMachineClient) Recv() (*DecommissionMachineResponse, error) {
+	m := new(DecommissionMachineResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) ListMachinesByTalosVersion(ctx context.Context, in *ListMachinesByTalosVersionRequest, opts ...grpc.CallOption) (*ListMachinesByTalosVersionResponse, error) {
+	out := new(ListMachinesByTalosVersionResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListMachinesByTalosVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineDmesg(ctx context.Context, in *GetMachineDmesgRequest, opts ...grpc.CallOption) (ManagementService_GetMachineDmesgClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[4], ManagementService_GetMachineDmesg_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceGetMachineDmesgClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_GetMachineDmesgClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type managementServiceGetMachineDmesgClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceGetMachineDmesgClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) SimulateAccessPolicy(ctx context.Context, in *SimulateAccessPolicyRequest, opts ...grpc.CallOption) (*SimulateAccessPolicyResponse, error) {
+	out := new(SimulateAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, ManagementService_SimulateAccessPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetKubeletStatus(ctx context.Context, in *GetKubeletStatusRequest, opts ...grpc.CallOption) (*GetKubeletStatusResponse, error) {
+	out := new(GetKubeletStatusResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetKubeletStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetInstallDiskSelection(ctx context.Context, in *GetInstallDiskSelectionRequest, opts ...grpc.CallOption) (*GetInstallDiskSelectionResponse, error) {
+	out := new(GetInstallDiskSelectionResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetInstallDiskSelection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) CreateSchematics(ctx context.Context, in *CreateSchematicsRequest, opts ...grpc.CallOption) (*CreateSchematicsResponse, error) {
+	out := new(CreateSchematicsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_CreateSchematics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) CompareSchematicToBaseline(ctx context.Context, in *CompareSchematicToBaselineRequest, opts ...grpc.CallOption) (*CompareSchematicToBaselineResponse, error) {
+	out := new(CompareSchematicToBaselineResponse)
+	err := c.cc.Invoke(ctx, ManagementService_CompareSchematicToBaseline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineStatusAt(ctx context.Context, in *GetMachineStatusAtRequest, opts ...grpc.CallOption) (*GetMachineStatusAtResponse, error) {
+	out := new(GetMachineStatusAtResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineStatusAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateUpgradeImageReachability(ctx context.Context, in *ValidateUpgradeImageReachabilityRequest, opts ...grpc.CallOption) (*ValidateUpgradeImageReachabilityResponse, error) {
+	out := new(ValidateUpgradeImageReachabilityResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateUpgradeImageReachability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ExportServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportServiceAccountsResponse, error) {
+	out := new(ExportServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ExportServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ImportServiceAccounts(ctx context.Context, in *ImportServiceAccountsRequest, opts ...grpc.CallOption) (*ImportServiceAccountsResponse, error) {
+	out := new(ImportServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ImportServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListUnhealthyMachines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListUnhealthyMachinesResponse, error) {
+	out := new(ListUnhealthyMachinesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListUnhealthyMachines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetClusterCapacity(ctx context.Context, in *GetClusterCapacityRequest, opts ...grpc.CallOption) (*GetClusterCapacityResponse, error) {
+	out := new(GetClusterCapacityResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetClusterCapacity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) CreateSchematicWithProgress(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (ManagementService_CreateSchematicWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[5], ManagementService_CreateSchematicWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceCreateSchematicWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_CreateSchematicWithProgressClient interface {
+	Recv() (*CreateSchematicProgress, error)
+	grpc.ClientStream
+}
+
+type managementServiceCreateSchematicWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceCreateSchematicWithProgressClient) Recv() (*CreateSchematicProgress, error) {
+	m := new(CreateSchematicProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) GetClockDrift(ctx context.Context, in *GetClockDriftRequest, opts ...grpc.CallOption) (*GetClockDriftResponse, error) {
+	out := new(GetClockDriftResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetClockDrift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) SetMachineReserved(ctx context.Context, in *SetMachineReservedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_SetMachineReserved_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListUnallocatedMachines(ctx context.Context, in *ListUnallocatedMachinesRequest, opts ...grpc.CallOption) (*ListUnallocatedMachinesResponse, error) {
+	out := new(ListUnallocatedMachinesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListUnallocatedMachines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineResource(ctx context.Context, in *GetMachineResourceRequest, opts ...grpc.CallOption) (*GetMachineResourceResponse, error) {
+	out := new(GetMachineResourceResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) OmniconfigFor(ctx context.Context, in *OmniconfigForRequest, opts ...grpc.CallOption) (*OmniconfigResponse, error) {
+	out := new(OmniconfigResponse)
+	err := c.cc.Invoke(ctx, ManagementService_OmniconfigFor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ReapplyMachineConfig(ctx context.Context, in *ReapplyMachineConfigRequest, opts ...grpc.CallOption) (*ReapplyMachineConfigResponse, error) {
+	out := new(ReapplyMachineConfigResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ReapplyMachineConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListKubernetesUpgrades(ctx context.Context, in *ListKubernetesUpgradesRequest, opts ...grpc.CallOption) (*ListKubernetesUpgradesResponse, error) {
+	out := new(ListKubernetesUpgradesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListKubernetesUpgrades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateClusterComposition(ctx context.Context, in *ValidateClusterCompositionRequest, opts ...grpc.CallOption) (*ValidateClusterCompositionResponse, error) {
+	out := new(ValidateClusterCompositionResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateClusterComposition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) StreamClusterUtilization(ctx context.Context, in *StreamClusterUtilizationRequest, opts ...grpc.CallOption) (ManagementService_StreamClusterUtilizationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[6], ManagementService_StreamClusterUtilization_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceStreamClusterUtilizationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_StreamClusterUtilizationClient interface {
+	Recv() (*StreamClusterUtilizationResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceStreamClusterUtilizationClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceStreamClusterUtilizationClient) Recv() (*StreamClusterUtilizationResponse, error) {
+	m := new(StreamClusterUtilizationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) SetSchematicName(ctx context.Context, in *SetSchematicNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_SetSchematicName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateOmniEndpoint(ctx context.Context, in *ValidateOmniEndpointRequest, opts ...grpc.CallOption) (*ValidateOmniEndpointResponse, error) {
+	out := new(ValidateOmniEndpointResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateOmniEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) DiffSchematics(ctx context.Context, in *DiffSchematicsRequest, opts ...grpc.CallOption) (*DiffSchematicsResponse, error) {
+	out := new(DiffSchematicsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_DiffSchematics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListInvalidSchematicMachines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListInvalidSchematicMachinesResponse, error) {
+	out := new(ListInvalidSchematicMachinesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListInvalidSchematicMachines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineStatusJSON(ctx context.Context, in *GetMachineStatusJSONRequest, opts ...grpc.CallOption) (*GetMachineStatusJSONResponse, error) {
+	out := new(GetMachineStatusJSONResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineStatusJSON_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ConfirmServiceAccountKey(ctx context.Context, in *ConfirmServiceAccountKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_ConfirmServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) QueryMachineStatuses(ctx context.Context, in *QueryMachineStatusesRequest, opts ...grpc.CallOption) (*QueryMachineStatusesResponse, error) {
+	out := new(QueryMachineStatusesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_QueryMachineStatuses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetPendingMachineConfig(ctx context.Context, in *GetPendingMachineConfigRequest, opts ...grpc.CallOption) (*GetPendingMachineConfigResponse, error) {
+	out := new(GetPendingMachineConfigResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetPendingMachineConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListAllServiceAccountKeys(ctx context.Context, in *ListAllServiceAccountKeysRequest, opts ...grpc.CallOption) (*ListAllServiceAccountKeysResponse, error) {
+	out := new(ListAllServiceAccountKeysResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListAllServiceAccountKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) SetMachineLogRetention(ctx context.Context, in *SetMachineLogRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_SetMachineLogRetention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateClusterMembership(ctx context.Context, in *ValidateClusterMembershipRequest, opts ...grpc.CallOption) (*ValidateClusterMembershipResponse, error) {
+	out := new(ValidateClusterMembershipResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateClusterMembership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetJoinKernelArgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetJoinKernelArgsResponse, error) {
+	out := new(GetJoinKernelArgsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetJoinKernelArgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetAvailableExtensionUpdates(ctx context.Context, in *GetAvailableExtensionUpdatesRequest, opts ...grpc.CallOption) (*GetAvailableExtensionUpdatesResponse, error) {
+	out := new(GetAvailableExtensionUpdatesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetAvailableExtensionUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateSchematicUpgrade(ctx context.Context, in *ValidateSchematicUpgradeRequest, opts ...grpc.CallOption) (*ValidateSchematicUpgradeResponse, error) {
+	out := new(ValidateSchematicUpgradeResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateSchematicUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetClusterTopology(ctx context.Context, in *GetClusterTopologyRequest, opts ...grpc.CallOption) (*GetClusterTopologyResponse, error) {
+	out := new(GetClusterTopologyResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetClusterTopology_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListMachinesByUptime(ctx context.Context, in *ListMachinesByUptimeRequest, opts ...grpc.CallOption) (*ListMachinesByUptimeResponse, error) {
+	out := new(ListMachinesByUptimeResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListMachinesByUptime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ApplyMaintenanceConfig(ctx context.Context, in *ApplyMaintenanceConfigRequest, opts ...grpc.CallOption) (*ApplyMaintenanceConfigResponse, error) {
+	out := new(ApplyMaintenanceConfigResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ApplyMaintenanceConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListSchematicsWithStaleArgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSchematicsWithStaleArgsResponse, error) {
+	out := new(ListSchematicsWithStaleArgsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListSchematicsWithStaleArgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) DestroyServiceAccounts(ctx context.Context, in *DestroyServiceAccountsRequest, opts ...grpc.CallOption) (*DestroyServiceAccountsResponse, error) {
+	out := new(DestroyServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_DestroyServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetConfigSchema(ctx context.Context, in *GetConfigSchemaRequest, opts ...grpc.CallOption) (*GetConfigSchemaResponse, error) {
+	out := new(GetConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineEvents(ctx context.Context, in *GetMachineEventsRequest, opts ...grpc.CallOption) (*GetMachineEventsResponse, error) {
+	out := new(GetMachineEventsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateMetaValues(ctx context.Context, in *ValidateMetaValuesRequest, opts ...grpc.CallOption) (*ValidateMetaValuesResponse, error) {
+	out := new(ValidateMetaValuesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateMetaValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
+	out := new(WhoAmIResponse)
+	err := c.cc.Invoke(ctx, ManagementService_WhoAmI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetExtensionUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExtensionUsageResponse, error) {
+	out := new(GetExtensionUsageResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetExtensionUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetSchematicISOURL(ctx context.Context, in *GetSchematicISOURLRequest, opts ...grpc.CallOption) (*GetSchematicISOURLResponse, error) {
+	out := new(GetSchematicISOURLResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetSchematicISOURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListMachinesWithHardwareChanges(ctx context.Context, in *ListMachinesWithHardwareChangesRequest, opts ...grpc.CallOption) (*ListMachinesWithHardwareChangesResponse, error) {
+	out := new(ListMachinesWithHardwareChangesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListMachinesWithHardwareChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) TestServiceAccount(ctx context.Context, in *TestServiceAccountRequest, opts ...grpc.CallOption) (*TestServiceAccountResponse, error) {
+	out := new(TestServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ManagementService_TestServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) WatchUpgradeProgress(ctx context.Context, in *WatchUpgradeProgressRequest, opts ...grpc.CallOption) (ManagementService_WatchUpgradeProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[7], ManagementService_WatchUpgradeProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceWatchUpgradeProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_WatchUpgradeProgressClient interface {
+	Recv() (*WatchUpgradeProgressResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceWatchUpgradeProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceWatchUpgradeProgressClient) Recv() (*WatchUpgradeProgressResponse, error) {
+	m := new(WatchUpgradeProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) GetMachineStatusTimeSeries(ctx context.Context, in *GetMachineStatusTimeSeriesRequest, opts ...grpc.CallOption) (*GetMachineStatusTimeSeriesResponse, error) {
+	out := new(GetMachineStatusTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineStatusTimeSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListMachinesPendingReboot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMachinesPendingRebootResponse, error) {
+	out := new(ListMachinesPendingRebootResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListMachinesPendingReboot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateClusterConfigs(ctx context.Context, in *ValidateClusterConfigsRequest, opts ...grpc.CallOption) (ManagementService_ValidateClusterConfigsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[8], ManagementService_ValidateClusterConfigs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceValidateClusterConfigsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_ValidateClusterConfigsClient interface {
+	Recv() (*ValidateClusterConfigsResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceValidateClusterConfigsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceValidateClusterConfigsClient) Recv() (*ValidateClusterConfigsResponse, error) {
+	m := new(ValidateClusterConfigsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ManagementServiceServer is the server API for ManagementService service.
+// All implementations must embed UnimplementedManagementServiceServer
+// for forward compatibility
+type ManagementServiceServer interface {
+	Kubeconfig(context.Context, *KubeconfigRequest) (*KubeconfigResponse, error)
+	Talosconfig(context.Context, *TalosconfigRequest) (*TalosconfigResponse, error)
+	Omniconfig(context.Context, *emptypb.Empty) (*OmniconfigResponse, error)
+	MachineLogs(*MachineLogsRequest, ManagementService_MachineLogsServer) error
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error)
+	RotateServiceAccount(context.Context, *RotateServiceAccountRequest) (*RotateServiceAccountResponse, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*DestroyServiceAccountResponse, error)
+	GetServiceAccountKey(context.Context, *GetServiceAccountKeyRequest) (*GetServiceAccountKeyResponse, error)
+	KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error)
+	KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error
+	CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error)
+	ImportExternalNodes(context.Context, *ImportExternalNodesRequest) (*ImportExternalNodesResponse, error)
+	ValidateServiceAccountKey(context.Context, *ValidateServiceAccountKeyRequest) (*ValidateServiceAccountKeyResponse, error)
+	CompareKernelArgs(context.Context, *CompareKernelArgsRequest) (*CompareKernelArgsResponse, error)
+	GetSchematicPXEURL(context.Context, *GetSchematicPXEURLRequest) (*GetSchematicPXEURLResponse, error)
+	GetInstallerImageURL(context.Context, *GetInstallerImageURLRequest) (*GetInstallerImageURLResponse, error)
+	WatchKubernetesEvents(*WatchKubernetesEventsRequest, ManagementService_WatchKubernetesEventsServer) error
+	CreateMachineGroup(context.Context, *CreateMachineGroupRequest) (*CreateMachineGroupResponse, error)
+	LabelMachineGroup(context.Context, *LabelMachineGroupRequest) (*MachineGroupOperationResponse, error)
+	RebootMachineGroup(context.Context, *RebootMachineGroupRequest) (*MachineGroupOperationResponse, error)
+	GetMachinePatchOrder(context.Context, *GetMachinePatchOrderRequest) (*GetMachinePatchOrderResponse, error)
+	SetKubernetesUpgradeWindow(context.Context, *SetKubernetesUpgradeWindowRequest) (*emptypb.Empty, error)
+	DeleteKubernetesUpgradeWindow(context.Context, *DeleteKubernetesUpgradeWindowRequest) (*emptypb.Empty, error)
+	DecommissionMachine(*DecommissionMachineRequest, ManagementService_DecommissionMachineServer) error
+	ListMachinesByTalosVersion(context.Context, *ListMachinesByTalosVersionRequest) (*ListMachinesByTalosVersionResponse, error)
+	GetMachineDmesg(*GetMachineDmesgRequest, ManagementService_GetMachineDmesgServer) error
+	SimulateAccessPolicy(context.Context, *SimulateAccessPolicyRequest) (*SimulateAccessPolicyResponse, error)
+	GetKubeletStatus(context.Context, *GetKubeletStatusRequest) (*GetKubeletStatusResponse, error)
+	GetInstallDiskSelection(context.Context, *GetInstallDiskSelectionRequest) (*GetInstallDiskSelectionResponse, error)
+	CreateSchematics(context.Context, *CreateSchematicsRequest) (*CreateSchematicsResponse, error)
+	CompareSchematicToBaseline(context.Context, *CompareSchematicToBaselineRequest) (*CompareSchematicToBaselineResponse, error)
+	GetMachineStatusAt(context.Context, *GetMachineStatusAtRequest) (*GetMachineStatusAtResponse, error)
+	ValidateUpgradeImageReachability(context.Context, *ValidateUpgradeImageReachabilityRequest) (*ValidateUpgradeImageReachabilityResponse, error)
+	ExportServiceAccounts(context.Context, *emptypb.Empty) (*ExportServiceAccountsResponse, error)
+	ImportServiceAccounts(context.Context, *ImportServiceAccountsRequest) (*ImportServiceAccountsResponse, error)
+	ListUnhealthyMachines(context.Context, *emptypb.Empty) (*ListUnhealthyMachinesResponse, error)
+	GetClusterCapacity(context.Context, *GetClusterCapacityRequest) (*GetClusterCapacityResponse, error)
+	CreateSchematicWithProgress(*CreateSchematicRequest, ManagementService_CreateSchematicWithProgressServer) error
+	GetClockDrift(context.Context, *GetClockDriftRequest) (*GetClockDriftResponse, error)
+	SetMachineReserved(context.Context, *SetMachineReservedRequest) (*emptypb.Empty, error)
+	ListUnallocatedMachines(context.Context, *ListUnallocatedMachinesRequest) (*ListUnallocatedMachinesResponse, error)
+	GetMachineResource(context.Context, *GetMachineResourceRequest) (*GetMachineResourceResponse, error)
+	OmniconfigFor(context.Context, *OmniconfigForRequest) (*OmniconfigResponse, error)
+	ReapplyMachineConfig(context.Context, *ReapplyMachineConfigRequest) (*ReapplyMachineConfigResponse, error)
+	ListKubernetesUpgrades(context.Context, *ListKubernetesUpgradesRequest) (*ListKubernetesUpgradesResponse, error)
+	ValidateClusterComposition(context.Context, *ValidateClusterCompositionRequest) (*ValidateClusterCompositionResponse, error)
+	StreamClusterUtilization(*StreamClusterUtilizationRequest, ManagementService_StreamClusterUtilizationServer) error
+	SetSchematicName(context.Context, *SetSchematicNameRequest) (*emptypb.Empty, error)
+	ValidateOmniEndpoint(context.Context, *ValidateOmniEndpointRequest) (*ValidateOmniEndpointResponse, error)
+	DiffSchematics(context.Context, *DiffSchematicsRequest) (*DiffSchematicsResponse, error)
+	ListInvalidSchematicMachines(context.Context, *emptypb.Empty) (*ListInvalidSchematicMachinesResponse, error)
+	GetMachineStatusJSON(context.Context, *GetMachineStatusJSONRequest) (*GetMachineStatusJSONResponse, error)
+	ConfirmServiceAccountKey(context.Context, *ConfirmServiceAccountKeyRequest) (*emptypb.Empty, error)
+	QueryMachineStatuses(context.Context, *QueryMachineStatusesRequest) (*QueryMachineStatusesResponse, error)
+	GetPendingMachineConfig(context.Context, *GetPendingMachineConfigRequest) (*GetPendingMachineConfigResponse, error)
+	ListAllServiceAccountKeys(context.Context, *ListAllServiceAccountKeysRequest) (*ListAllServiceAccountKeysResponse, error)
+	SetMachineLogRetention(context.Context, *SetMachineLogRetentionRequest) (*emptypb.Empty, error)
+	ValidateClusterMembership(context.Context, *ValidateClusterMembershipRequest) (*ValidateClusterMembershipResponse, error)
+	GetJoinKernelArgs(context.Context, *emptypb.Empty) (*GetJoinKernelArgsResponse, error)
+	GetAvailableExtensionUpdates(context.Context, *GetAvailableExtensionUpdatesRequest) (*GetAvailableExtensionUpdatesResponse, error)
+	ValidateSchematicUpgrade(context.Context, *ValidateSchematicUpgradeRequest) (*ValidateSchematicUpgradeResponse, error)
+	GetClusterTopology(context.Context, *GetClusterTopologyRequest) (*GetClusterTopologyResponse, error)
+	ListMachinesByUptime(context.Context, *ListMachinesByUptimeRequest) (*ListMachinesByUptimeResponse, error)
+	ApplyMaintenanceConfig(context.Context, *ApplyMaintenanceConfigRequest) (*ApplyMaintenanceConfigResponse, error)
+	ListSchematicsWithStaleArgs(context.Context, *emptypb.Empty) (*ListSchematicsWithStaleArgsResponse, error)
+	DestroyServiceAccounts(context.Context, *DestroyServiceAccountsRequest) (*DestroyServiceAccountsResponse, error)
+	GetConfigSchema(context.Context, *GetConfigSchemaRequest) (*GetConfigSchemaResponse, error)
+	GetMachineEvents(context.Context, *GetMachineEventsRequest) (*GetMachineEventsResponse, error)
+	ValidateMetaValues(context.Context, *ValidateMetaValuesRequest) (*ValidateMetaValuesResponse, error)
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
+	GetExtensionUsage(context.Context, *emptypb.Empty) (*GetExtensionUsageResponse, error)
+	GetSchematicISOURL(context.Context, *GetSchematicISOURLRequest) (*GetSchematicISOURLResponse, error)
+	ListMachinesWithHardwareChanges(context.Context, *ListMachinesWithHardwareChangesRequest) (*ListMachinesWithHardwareChangesResponse, error)
+	TestServiceAccount(context.Context, *TestServiceAccountRequest) (*TestServiceAccountResponse, error)
+	WatchUpgradeProgress(*WatchUpgradeProgressRequest, ManagementService_WatchUpgradeProgressServer) error
+	GetMachineStatusTimeSeries(context.Context, *GetMachineStatusTimeSeriesRequest) (*GetMachineStatusTimeSeriesResponse, error)
+	ListMachinesPendingReboot(context.Context, *emptypb.Empty) (*ListMachinesPendingRebootResponse, error)
+	ValidateClusterConfigs(*ValidateClusterConfigsRequest, ManagementService_ValidateClusterConfigsServer) error
+	mustEmbedUnimplementedManagementServiceServer()
+}
+
+// UnimplementedManagementServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedManagementServiceServer struct {
+}
+
+func (UnimplementedManagementServiceServer) Kubeconfig(context.Context, *KubeconfigRequest) (*KubeconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+}
+func (UnimplementedManagementServiceServer) Talosconfig(context.Context, *TalosconfigRequest) (*TalosconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Talosconfig not implemented")
+}
+func (UnimplementedManagementServiceServer) Omniconfig(context.Context, *emptypb.Empty) (*OmniconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Omniconfig not implemented")
+}
+func (UnimplementedManagementServiceServer) MachineLogs(*MachineLogsRequest, ManagementService_MachineLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MachineLogs not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedManagementServiceServer) RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewServiceAccount not implemented")
+}
+func (UnimplementedManagementServiceServer) RotateServiceAccount(context.Context, *RotateServiceAccountRequest) (*RotateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccount not implemented")
+}
+func (UnimplementedManagementServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedManagementServiceServer) DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*DestroyServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyServiceAccount not implemented")
+}
+func (UnimplementedManagementServiceServer) GetServiceAccountKey(context.Context, *GetServiceAccountKeyRequest) (*GetServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountKey not implemented")
+}
+func (UnimplementedManagementServiceServer) KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KubernetesUpgradePreChecks not implemented")
+}
+func (UnimplementedManagementServiceServer) KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method KubernetesSyncManifests not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchematic not implemented")
+}
+func (UnimplementedManagementServiceServer) ImportExternalNodes(context.Context, *ImportExternalNodesRequest) (*ImportExternalNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExternalNodes not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateServiceAccountKey(context.Context, *ValidateServiceAccountKeyRequest) (*ValidateServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateServiceAccountKey not implemented")
+}
+func (UnimplementedManagementServiceServer) CompareKernelArgs(context.Context, *CompareKernelArgsRequest) (*CompareKernelArgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKernelArgs not implemented")
+}
+func (UnimplementedManagementServiceServer) GetSchematicPXEURL(context.Context, *GetSchematicPXEURLRequest) (*GetSchematicPXEURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchematicPXEURL not implemented")
+}
+func (UnimplementedManagementServiceServer) GetInstallerImageURL(context.Context, *GetInstallerImageURLRequest) (*GetInstallerImageURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallerImageURL not implemented")
+}
+func (UnimplementedManagementServiceServer) WatchKubernetesEvents(*WatchKubernetesEventsRequest, ManagementService_WatchKubernetesEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchKubernetesEvents not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateMachineGroup(context.Context, *CreateMachineGroupRequest) (*CreateMachineGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMachineGroup not implemented")
+}
+func (UnimplementedManagementServiceServer) LabelMachineGroup(context.Context, *LabelMachineGroupRequest) (*MachineGroupOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabelMachineGroup not implemented")
+}
+func (UnimplementedManagementServiceServer) RebootMachineGroup(context.Context, *RebootMachineGroupRequest) (*MachineGroupOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootMachineGroup not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachinePatchOrder(context.Context, *GetMachinePatchOrderRequest) (*GetMachinePatchOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachinePatchOrder not implemented")
+}
+func (UnimplementedManagementServiceServer) SetKubernetesUpgradeWindow(context.Context, *SetKubernetesUpgradeWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKubernetesUpgradeWindow not implemented")
+}
+func (UnimplementedManagementServiceServer) DeleteKubernetesUpgradeWindow(context.Context, *DeleteKubernetesUpgradeWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKubernetesUpgradeWindow not implemented")
+}
+func (UnimplementedManagementServiceServer) DecommissionMachine(*DecommissionMachineRequest, ManagementService_DecommissionMachineServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecommissionMachine not implemented")
+}
+func (UnimplementedManagementServiceServer) ListMachinesByTalosVersion(context.Context, *ListMachinesByTalosVersionRequest) (*ListMachinesByTalosVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachinesByTalosVersion not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineDmesg(*GetMachineDmesgRequest, ManagementService_GetMachineDmesgServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetMachineDmesg not implemented")
+}
+func (UnimplementedManagementServiceServer) SimulateAccessPolicy(context.Context, *SimulateAccessPolicyRequest) (*SimulateAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAccessPolicy not implemented")
+}
+func (UnimplementedManagementServiceServer) GetKubeletStatus(context.Context, *GetKubeletStatusRequest) (*GetKubeletStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKubeletStatus not implemented")
+}
+func (UnimplementedManagementServiceServer) GetInstallDiskSelection(context.Context, *GetInstallDiskSelectionRequest) (*GetInstallDiskSelectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallDiskSelection not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateSchematics(context.Context, *CreateSchematicsRequest) (*CreateSchematicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchematics not implemented")
+}
+func (UnimplementedManagementServiceServer) CompareSchematicToBaseline(context.Context, *CompareSchematicToBaselineRequest) (*CompareSchematicToBaselineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareSchematicToBaseline not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineStatusAt(context.Context, *GetMachineStatusAtRequest) (*GetMachineStatusAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineStatusAt not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateUpgradeImageReachability(context.Context, *ValidateUpgradeImageReachabilityRequest) (*ValidateUpgradeImageReachabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateUpgradeImageReachability not implemented")
+}
+func (UnimplementedManagementServiceServer) ExportServiceAccounts(context.Context, *emptypb.Empty) (*ExportServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportServiceAccounts not implemented")
+}
+func (UnimplementedManagementServiceServer) ImportServiceAccounts(context.Context, *ImportServiceAccountsRequest) (*ImportServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportServiceAccounts not implemented")
+}
+func (UnimplementedManagementServiceServer) ListUnhealthyMachines(context.Context, *emptypb.Empty) (*ListUnhealthyMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnhealthyMachines not implemented")
+}
+func (UnimplementedManagementServiceServer) GetClusterCapacity(context.Context, *GetClusterCapacityRequest) (*GetClusterCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterCapacity not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateSchematicWithProgress(*CreateSchematicRequest, ManagementService_CreateSchematicWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateSchematicWithProgress not implemented")
+}
+func (UnimplementedManagementServiceServer) GetClockDrift(context.Context, *GetClockDriftRequest) (*GetClockDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClockDrift not implemented")
+}
+func (UnimplementedManagementServiceServer) SetMachineReserved(context.Context, *SetMachineReservedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMachineReserved not implemented")
+}
+func (UnimplementedManagementServiceServer) ListUnallocatedMachines(context.Context, *ListUnallocatedMachinesRequest) (*ListUnallocatedMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnallocatedMachines not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineResource(context.Context, *GetMachineResourceRequest) (*GetMachineResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineResource not implemented")
+}
+func (UnimplementedManagementServiceServer) OmniconfigFor(context.Context, *OmniconfigForRequest) (*OmniconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OmniconfigFor not implemented")
+}
+func (UnimplementedManagementServiceServer) ReapplyMachineConfig(context.Context, *ReapplyMachineConfigRequest) (*ReapplyMachineConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReapplyMachineConfig not implemented")
+}
+func (UnimplementedManagementServiceServer) ListKubernetesUpgrades(context.Context, *ListKubernetesUpgradesRequest) (*ListKubernetesUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubernetesUpgrades not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateClusterComposition(context.Context, *ValidateClusterCompositionRequest) (*ValidateClusterCompositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateClusterComposition not implemented")
+}
+func (UnimplementedManagementServiceServer) StreamClusterUtilization(*StreamClusterUtilizationRequest, ManagementService_StreamClusterUtilizationServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamClusterUtilization not implemented")
+}
+func (UnimplementedManagementServiceServer) SetSchematicName(context.Context, *SetSchematicNameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchematicName not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateOmniEndpoint(context.Context, *ValidateOmniEndpointRequest) (*ValidateOmniEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateOmniEndpoint not implemented")
+}
+func (UnimplementedManagementServiceServer) DiffSchematics(context.Context, *DiffSchematicsRequest) (*DiffSchematicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSchematics not implemented")
+}
+func (UnimplementedManagementServiceServer) ListInvalidSchematicMachines(context.Context, *emptypb.Empty) (*ListInvalidSchematicMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvalidSchematicMachines not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineStatusJSON(context.Context, *GetMachineStatusJSONRequest) (*GetMachineStatusJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineStatusJSON not implemented")
+}
+func (UnimplementedManagementServiceServer) ConfirmServiceAccountKey(context.Context, *ConfirmServiceAccountKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmServiceAccountKey not implemented")
+}
+func (UnimplementedManagementServiceServer) QueryMachineStatuses(context.Context, *QueryMachineStatusesRequest) (*QueryMachineStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMachineStatuses not implemented")
+}
+func (UnimplementedManagementServiceServer) GetPendingMachineConfig(context.Context, *GetPendingMachineConfigRequest) (*GetPendingMachineConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingMachineConfig not implemented")
+}
+func (UnimplementedManagementServiceServer) ListAllServiceAccountKeys(context.Context, *ListAllServiceAccountKeysRequest) (*ListAllServiceAccountKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllServiceAccountKeys not implemented")
+}
+func (UnimplementedManagementServiceServer) SetMachineLogRetention(context.Context, *SetMachineLogRetentionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMachineLogRetention not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateClusterMembership(context.Context, *ValidateClusterMembershipRequest) (*ValidateClusterMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateClusterMembership not implemented")
+}
+func (UnimplementedManagementServiceServer) GetJoinKernelArgs(context.Context, *emptypb.Empty) (*GetJoinKernelArgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinKernelArgs not implemented")
+}
+func (UnimplementedManagementServiceServer) GetAvailableExtensionUpdates(context.Context, *GetAvailableExtensionUpdatesRequest) (*GetAvailableExtensionUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableExtensionUpdates not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateSchematicUpgrade(context.Context, *ValidateSchematicUpgradeRequest) (*ValidateSchematicUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSchematicUpgrade not implemented")
+}
+func (UnimplementedManagementServiceServer) GetClusterTopology(context.Context, *GetClusterTopologyRequest) (*GetClusterTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterTopology not implemented")
+}
+func (UnimplementedManagementServiceServer) ListMachinesByUptime(context.Context, *ListMachinesByUptimeRequest) (*ListMachinesByUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachinesByUptime not implemented")
+}
+func (UnimplementedManagementServiceServer) ApplyMaintenanceConfig(context.Context, *ApplyMaintenanceConfigRequest) (*ApplyMaintenanceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyMaintenanceConfig not implemented")
+}
+func (UnimplementedManagementServiceServer) ListSchematicsWithStaleArgs(context.Context, *emptypb.Empty) (*ListSchematicsWithStaleArgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchematicsWithStaleArgs not implemented")
+}
+func (UnimplementedManagementServiceServer) DestroyServiceAccounts(context.Context, *DestroyServiceAccountsRequest) (*DestroyServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyServiceAccounts not implemented")
+}
+func (UnimplementedManagementServiceServer) GetConfigSchema(context.Context, *GetConfigSchemaRequest) (*GetConfigSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigSchema not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineEvents(context.Context, *GetMachineEventsRequest) (*GetMachineEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineEvents not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateMetaValues(context.Context, *ValidateMetaValuesRequest) (*ValidateMetaValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMetaValues not implemented")
+}
+func (UnimplementedManagementServiceServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedManagementServiceServer) GetExtensionUsage(context.Context, *emptypb.Empty) (*GetExtensionUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExtensionUsage not implemented")
+}
+func (UnimplementedManagementServiceServer) GetSchematicISOURL(context.Context, *GetSchematicISOURLRequest) (*GetSchematicISOURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchematicISOURL not implemented")
+}
+func (UnimplementedManagementServiceServer) ListMachinesWithHardwareChanges(context.Context, *ListMachinesWithHardwareChangesRequest) (*ListMachinesWithHardwareChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachinesWithHardwareChanges not implemented")
+}
+func (UnimplementedManagementServiceServer) TestServiceAccount(context.Context, *TestServiceAccountRequest) (*TestServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestServiceAccount not implemented")
+}
+func (UnimplementedManagementServiceServer) WatchUpgradeProgress(*WatchUpgradeProgressRequest, ManagementService_WatchUpgradeProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUpgradeProgress not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineStatusTimeSeries(context.Context, *GetMachineStatusTimeSeriesRequest) (*GetMachineStatusTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineStatusTimeSeries not implemented")
+}
+func (UnimplementedManagementServiceServer) ListMachinesPendingReboot(context.Context, *emptypb.Empty) (*ListMachinesPendingRebootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachinesPendingReboot not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateClusterConfigs(*ValidateClusterConfigsRequest, ManagementService_ValidateClusterConfigsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateClusterConfigs not implemented")
+}
+func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
+
+// UnsafeManagementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManagementServiceServer will
+// result in compilation errors.
+type UnsafeManagementServiceServer interface {
+	mustEmbedUnimplementedManagementServiceServer()
+}
+
+func RegisterManagementServiceServer(s grpc.ServiceRegistrar, srv ManagementServiceServer) {
+	s.RegisterService(&ManagementService_ServiceDesc, srv)
+}
+
+func _ManagementService_Kubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).Kubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_Kubeconfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).Kubeconfig(ctx, req.(*KubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_Talosconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TalosconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).Talosconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_Talosconfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).Talosconfig(ctx, req.(*TalosconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_Omniconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).Omniconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_Omniconfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).Omniconfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_MachineLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MachineLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).MachineLogs(m, &managementServiceMachineLogsServer{stream})
+}
+
+type ManagementService_MachineLogsServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type managementServiceMachineLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceMachineLogsServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RenewServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RenewServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RenewServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RenewServiceAccount(ctx, req.(*RenewServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RotateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RotateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RotateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RotateServiceAccount(ctx, req.(*RotateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DestroyServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).DestroyServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_DestroyServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).DestroyServiceAccount(ctx, req.(*DestroyServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetServiceAccountKey(ctx, req.(*GetServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_KubernetesUpgradePreChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KubernetesUpgradePreChecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).KubernetesUpgradePreChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_KubernetesUpgradePreChecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).KubernetesUpgradePreChecks(ctx, req.(*KubernetesUpgradePreChecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_KubernetesSyncManifests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KubernetesSyncManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).KubernetesSyncManifests(m, &managementServiceKubernetesSyncManifestsServer{stream})
+}
+
+type ManagementService_KubernetesSyncManifestsServer interface {
+	Send(*KubernetesSyncManifestResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceKubernetesSyncManifestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceKubernetesSyncManifestsServer) Send(m *KubernetesSyncManifestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_CreateSchematic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchematicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CreateSchematic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CreateSchematic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CreateSchematic(ctx, req.(*CreateSchematicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ImportExternalNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExternalNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ImportExternalNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ImportExternalNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ImportExternalNodes(ctx, req.(*ImportExternalNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateServiceAccountKey(ctx, req.(*ValidateServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CompareKernelArgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareKernelArgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CompareKernelArgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CompareKernelArgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CompareKernelArgs(ctx, req.(*CompareKernelArgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetSchematicPXEURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchematicPXEURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetSchematicPXEURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetSchematicPXEURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetSchematicPXEURL(ctx, req.(*GetSchematicPXEURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetInstallerImageURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallerImageURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetInstallerImageURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetInstallerImageURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetInstallerImageURL(ctx, req.(*GetInstallerImageURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_WatchKubernetesEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchKubernetesEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).WatchKubernetesEvents(m, &managementServiceWatchKubernetesEventsServer{stream})
+}
+
+type ManagementService_WatchKubernetesEventsServer interface {
+	Send(*WatchKubernetesEventsResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceWatchKubernetesEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceWatchKubernetesEventsServer) Send(m *WatchKubernetesEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_CreateMachineGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMachineGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CreateMachineGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CreateMachineGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CreateMachineGroup(ctx, req.(*CreateMachineGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_LabelMachineGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelMachineGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).LabelMachineGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_LabelMachineGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).LabelMachineGroup(ctx, req.(*LabelMachineGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RebootMachineGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootMachineGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RebootMachineGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RebootMachineGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RebootMachineGroup(ctx, req.(*RebootMachineGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachinePatchOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachinePatchOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachinePatchOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachinePatchOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachinePatchOrder(ctx, req.(*GetMachinePatchOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_SetKubernetesUpgradeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKubernetesUpgradeWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).SetKubernetesUpgradeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_SetKubernetesUpgradeWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).SetKubernetesUpgradeWindow(ctx, req.(*SetKubernetesUpgradeWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DeleteKubernetesUpgradeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKubernetesUpgradeWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).DeleteKubernetesUpgradeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_DeleteKubernetesUpgradeWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).DeleteKubernetesUpgradeWindow(ctx, req.(*DeleteKubernetesUpgradeWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DecommissionMachine_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DecommissionMachineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).DecommissionMachine(m, &managementServiceDecommissionMachineServer{stream})
+}
+
+type ManagementService_DecommissionMachineServer interface {
+	Send(*DecommissionMachineResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceDecommissionMachineServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceDecommissionMachineServer) Send(m *DecommissionMachineResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_ListMachinesByTalosVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachinesByTalosVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListMachinesByTalosVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListMachinesByTalosVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListMachinesByTalosVersion(ctx, req.(*ListMachinesByTalosVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachineDmesg_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMachineDmesgRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).GetMachineDmesg(m, &managementServiceGetMachineDmesgServer{stream})
+}
+
+type ManagementService_GetMachineDmesgServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type managementServiceGetMachineDmesgServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceGetMachineDmesgServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_SimulateAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).SimulateAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_SimulateAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).SimulateAccessPolicy(ctx, req.(*SimulateAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetKubeletStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKubeletStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetKubeletStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetKubeletStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetKubeletStatus(ctx, req.(*GetKubeletStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetInstallDiskSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallDiskSelectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetInstallDiskSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetInstallDiskSelection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetInstallDiskSelection(ctx, req.(*GetInstallDiskSelectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CreateSchematics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchematicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CreateSchematics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CreateSchematics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CreateSchematics(ctx, req.(*CreateSchematicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CompareSchematicToBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareSchematicToBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CompareSchematicToBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CompareSchematicToBaseline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CompareSchematicToBaseline(ctx, req.(*CompareSchematicToBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachineStatusAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineStatusAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachineStatusAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachineStatusAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachineStatusAt(ctx, req.(*GetMachineStatusAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateUpgradeImageReachability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateUpgradeImageReachabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateUpgradeImageReachability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateUpgradeImageReachability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateUpgradeImageReachability(ctx, req.(*ValidateUpgradeImageReachabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ExportServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ExportServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ExportServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ExportServiceAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ImportServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ImportServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ImportServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ImportServiceAccounts(ctx, req.(*ImportServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListUnhealthyMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListUnhealthyMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListUnhealthyMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListUnhealthyMachines(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetClusterCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetClusterCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetClusterCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetClusterCapacity(ctx, req.(*GetClusterCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CreateSchematicWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateSchematicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).CreateSchematicWithProgress(m, &managementServiceCreateSchematicWithProgressServer{stream})
+}
+
+type ManagementService_CreateSchematicWithProgressServer interface {
+	Send(*CreateSchematicProgress) error
+	grpc.ServerStream
+}
+
+type managementServiceCreateSchematicWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceCreateSchematicWithProgressServer) Send(m *CreateSchematicProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_GetClockDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClockDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetClockDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetClockDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetClockDrift(ctx, req.(*GetClockDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_SetMachineReserved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMachineReservedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).SetMachineReserved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_SetMachineReserved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).SetMachineReserved(ctx, req.(*SetMachineReservedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListUnallocatedMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnallocatedMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListUnallocatedMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListUnallocatedMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListUnallocatedMachines(ctx, req.(*ListUnallocatedMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachineResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachineResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachineResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachineResource(ctx, req.(*GetMachineResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_OmniconfigFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OmniconfigForRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).OmniconfigFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_OmniconfigFor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).OmniconfigFor(ctx, req.(*OmniconfigForRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ReapplyMachineConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReapplyMachineConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ReapplyMachineConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ReapplyMachineConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ReapplyMachineConfig(ctx, req.(*ReapplyMachineConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListKubernetesUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubernetesUpgradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListKubernetesUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListKubernetesUpgrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListKubernetesUpgrades(ctx, req.(*ListKubernetesUpgradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateClusterComposition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateClusterCompositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateClusterComposition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateClusterComposition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateClusterComposition(ctx, req.(*ValidateClusterCompositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_StreamClusterUtilization_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamClusterUtilizationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).StreamClusterUtilization(m, &managementServiceStreamClusterUtilizationServer{stream})
+}
+
+type ManagementService_StreamClusterUtilizationServer interface {
+	Send(*StreamClusterUtilizationResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceStreamClusterUtilizationServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceStreamClusterUtilizationServer) Send(m *StreamClusterUtilizationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_SetSchematicName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSchematicNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).SetSchematicName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_SetSchematicName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).SetSchematicName(ctx, req.(*SetSchematicNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateOmniEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateOmniEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateOmniEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateOmniEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateOmniEndpoint(ctx, req.(*ValidateOmniEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DiffSchematics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSchematicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).DiffSchematics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_DiffSchematics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).DiffSchematics(ctx, req.(*DiffSchematicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListInvalidSchematicMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListInvalidSchematicMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListInvalidSchematicMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListInvalidSchematicMachines(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachineStatusJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineStatusJSONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachineStatusJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachineStatusJSON_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachineStatusJSON(ctx, req.(*GetMachineStatusJSONRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ConfirmServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ConfirmServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ConfirmServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ConfirmServiceAccountKey(ctx, req.(*ConfirmServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_QueryMachineStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMachineStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).QueryMachineStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_QueryMachineStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).QueryMachineStatuses(ctx, req.(*QueryMachineStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetPendingMachineConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingMachineConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetPendingMachineConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetPendingMachineConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetPendingMachineConfig(ctx, req.(*GetPendingMachineConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListAllServiceAccountKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllServiceAccountKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListAllServiceAccountKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListAllServiceAccountKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListAllServiceAccountKeys(ctx, req.(*ListAllServiceAccountKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_SetMachineLogRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMachineLogRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).SetMachineLogRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_SetMachineLogRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).SetMachineLogRetention(ctx, req.(*SetMachineLogRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateClusterMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateClusterMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateClusterMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateClusterMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateClusterMembership(ctx, req.(*ValidateClusterMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetJoinKernelArgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetJoinKernelArgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetJoinKernelArgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetJoinKernelArgs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetAvailableExtensionUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableExtensionUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetAvailableExtensionUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetAvailableExtensionUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetAvailableExtensionUpdates(ctx, req.(*GetAvailableExtensionUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateSchematicUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSchematicUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateSchematicUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateSchematicUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateSchematicUpgrade(ctx, req.(*ValidateSchematicUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetClusterTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetClusterTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetClusterTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetClusterTopology(ctx, req.(*GetClusterTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListMachinesByUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachinesByUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListMachinesByUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListMachinesByUptime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListMachinesByUptime(ctx, req.(*ListMachinesByUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ApplyMaintenanceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyMaintenanceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ApplyMaintenanceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ApplyMaintenanceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ApplyMaintenanceConfig(ctx, req.(*ApplyMaintenanceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListSchematicsWithStaleArgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).Kubeconfig(ctx, in)
+		return srv.(ManagementServiceServer).ListSchematicsWithStaleArgs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_Kubeconfig_FullMethodName,
+		FullMethod: ManagementService_ListSchematicsWithStaleArgs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).Kubeconfig(ctx, req.(*KubeconfigRequest))
+		return srv.(ManagementServiceServer).ListSchematicsWithStaleArgs(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_Talosconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TalosconfigRequest)
+func _ManagementService_DestroyServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyServiceAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).Talosconfig(ctx, in)
+		return srv.(ManagementServiceServer).DestroyServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_Talosconfig_FullMethodName,
+		FullMethod: ManagementService_DestroyServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).Talosconfig(ctx, req.(*TalosconfigRequest))
+		return srv.(ManagementServiceServer).DestroyServiceAccounts(ctx, req.(*DestroyServiceAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_Omniconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ManagementService_GetConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).Omniconfig(ctx, in)
+		return srv.(ManagementServiceServer).GetConfigSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_Omniconfig_FullMethodName,
+		FullMethod: ManagementService_GetConfigSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).Omniconfig(ctx, req.(*emptypb.Empty))
+		return srv.(ManagementServiceServer).GetConfigSchema(ctx, req.(*GetConfigSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_MachineLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(MachineLogsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ManagementService_GetMachineEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ManagementServiceServer).MachineLogs(m, &managementServiceMachineLogsServer{stream})
-}
-
-type ManagementService_MachineLogsServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type managementServiceMachineLogsServer struct {
-	grpc.ServerStream
-}
-
-func (x *managementServiceMachineLogsServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachineEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachineEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachineEvents(ctx, req.(*GetMachineEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateConfigRequest)
+func _ManagementService_ValidateMetaValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMetaValuesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).ValidateConfig(ctx, in)
+		return srv.(ManagementServiceServer).ValidateMetaValues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_ValidateConfig_FullMethodName,
+		FullMethod: ManagementService_ValidateMetaValues_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+		return srv.(ManagementServiceServer).ValidateMetaValues(ctx, req.(*ValidateMetaValuesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateServiceAccountRequest)
+func _ManagementService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).CreateServiceAccount(ctx, in)
+		return srv.(ManagementServiceServer).WhoAmI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_CreateServiceAccount_FullMethodName,
+		FullMethod: ManagementService_WhoAmI_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+		return srv.(ManagementServiceServer).WhoAmI(ctx, req.(*WhoAmIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_RenewServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewServiceAccountRequest)
+func _ManagementService_GetExtensionUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).RenewServiceAccount(ctx, in)
+		return srv.(ManagementServiceServer).GetExtensionUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_RenewServiceAccount_FullMethodName,
+		FullMethod: ManagementService_GetExtensionUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).RenewServiceAccount(ctx, req.(*RenewServiceAccountRequest))
+		return srv.(ManagementServiceServer).GetExtensionUsage(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ManagementService_GetSchematicISOURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchematicISOURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).ListServiceAccounts(ctx, in)
+		return srv.(ManagementServiceServer).GetSchematicISOURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_ListServiceAccounts_FullMethodName,
+		FullMethod: ManagementService_GetSchematicISOURL_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).ListServiceAccounts(ctx, req.(*emptypb.Empty))
+		return srv.(ManagementServiceServer).GetSchematicISOURL(ctx, req.(*GetSchematicISOURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_DestroyServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DestroyServiceAccountRequest)
+func _ManagementService_ListMachinesWithHardwareChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachinesWithHardwareChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).DestroyServiceAccount(ctx, in)
+		return srv.(ManagementServiceServer).ListMachinesWithHardwareChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_DestroyServiceAccount_FullMethodName,
+		FullMethod: ManagementService_ListMachinesWithHardwareChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).DestroyServiceAccount(ctx, req.(*DestroyServiceAccountRequest))
+		return srv.(ManagementServiceServer).ListMachinesWithHardwareChanges(ctx, req.(*ListMachinesWithHardwareChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_KubernetesUpgradePreChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KubernetesUpgradePreChecksRequest)
+func _ManagementService_TestServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestServiceAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).KubernetesUpgradePreChecks(ctx, in)
+		return srv.(ManagementServiceServer).TestServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_KubernetesUpgradePreChecks_FullMethodName,
+		FullMethod: ManagementService_TestServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).KubernetesUpgradePreChecks(ctx, req.(*KubernetesUpgradePreChecksRequest))
+		return srv.(ManagementServiceServer).TestServiceAccount(ctx, req.(*TestServiceAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ManagementService_KubernetesSyncManifests_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(KubernetesSyncManifestRequest)
+func _ManagementService_WatchUpgradeProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUpgradeProgressRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ManagementServiceServer).KubernetesSyncManifests(m, &managementServiceKubernetesSyncManifestsServer{stream})
+	return srv.(ManagementServiceServer).WatchUpgradeProgress(m, &managementServiceWatchUpgradeProgressServer{stream})
 }
 
-type ManagementService_KubernetesSyncManifestsServer interface {
-	Send(*KubernetesSyncManifestResponse) error
+type ManagementService_WatchUpgradeProgressServer interface {
+	Send(*WatchUpgradeProgressResponse) error
 	grpc.ServerStream
 }
 
-type managementServiceKubernetesSyncManifestsServer struct {
+type managementServiceWatchUpgradeProgressServer struct {
 	grpc.ServerStream
 }
 
-func (x *managementServiceKubernetesSyncManifestsServer) Send(m *KubernetesSyncManifestResponse) error {
+func (x *managementServiceWatchUpgradeProgressServer) Send(m *WatchUpgradeProgressResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ManagementService_CreateSchematic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSchematicRequest)
+func _ManagementService_GetMachineStatusTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineStatusTimeSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ManagementServiceServer).CreateSchematic(ctx, in)
+		return srv.(ManagementServiceServer).GetMachineStatusTimeSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ManagementService_CreateSchematic_FullMethodName,
+		FullMethod: ManagementService_GetMachineStatusTimeSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServiceServer).CreateSchematic(ctx, req.(*CreateSchematicRequest))
+		return srv.(ManagementServiceServer).GetMachineStatusTimeSeries(ctx, req.(*GetMachineStatusTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListMachinesPendingReboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListMachinesPendingReboot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListMachinesPendingReboot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListMachinesPendingReboot(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagementService_ValidateClusterConfigs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateClusterConfigsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ValidateClusterConfigs(m, &managementServiceValidateClusterConfigsServer{stream})
+}
+
+type ManagementService_ValidateClusterConfigsServer interface {
+	Send(*ValidateClusterConfigsResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceValidateClusterConfigsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceValidateClusterConfigsServer) Send(m *ValidateClusterConfigsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -541,6 +3000,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenewServiceAccount",
 			Handler:    _ManagementService_RenewServiceAccount_Handler,
 		},
+		{
+			MethodName: "RotateServiceAccount",
+			Handler:    _ManagementService_RotateServiceAccount_Handler,
+		},
 		{
 			MethodName: "ListServiceAccounts",
 			Handler:    _ManagementService_ListServiceAccounts_Handler,
@@ -549,6 +3012,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyServiceAccount",
 			Handler:    _ManagementService_DestroyServiceAccount_Handler,
 		},
+		{
+			MethodName: "GetServiceAccountKey",
+			Handler:    _ManagementService_GetServiceAccountKey_Handler,
+		},
 		{
 			MethodName: "KubernetesUpgradePreChecks",
 			Handler:    _ManagementService_KubernetesUpgradePreChecks_Handler,
@@ -557,6 +3024,246 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSchematic",
 			Handler:    _ManagementService_CreateSchematic_Handler,
 		},
+		{
+			MethodName: "ImportExternalNodes",
+			Handler:    _ManagementService_ImportExternalNodes_Handler,
+		},
+		{
+			MethodName: "ValidateServiceAccountKey",
+			Handler:    _ManagementService_ValidateServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "CompareKernelArgs",
+			Handler:    _ManagementService_CompareKernelArgs_Handler,
+		},
+		{
+			MethodName: "GetSchematicPXEURL",
+			Handler:    _ManagementService_GetSchematicPXEURL_Handler,
+		},
+		{
+			MethodName: "GetInstallerImageURL",
+			Handler:    _ManagementService_GetInstallerImageURL_Handler,
+		},
+		{
+			MethodName: "CreateMachineGroup",
+			Handler:    _ManagementService_CreateMachineGroup_Handler,
+		},
+		{
+			MethodName: "LabelMachineGroup",
+			Handler:    _ManagementService_LabelMachineGroup_Handler,
+		},
+		{
+			MethodName: "RebootMachineGroup",
+			Handler:    _ManagementService_RebootMachineGroup_Handler,
+		},
+		{
+			MethodName: "GetMachinePatchOrder",
+			Handler:    _ManagementService_GetMachinePatchOrder_Handler,
+		},
+		{
+			MethodName: "SetKubernetesUpgradeWindow",
+			Handler:    _ManagementService_SetKubernetesUpgradeWindow_Handler,
+		},
+		{
+			MethodName: "DeleteKubernetesUpgradeWindow",
+			Handler:    _ManagementService_DeleteKubernetesUpgradeWindow_Handler,
+		},
+		{
+			MethodName: "ListMachinesByTalosVersion",
+			Handler:    _ManagementService_ListMachinesByTalosVersion_Handler,
+		},
+		{
+			MethodName: "SimulateAccessPolicy",
+			Handler:    _ManagementService_SimulateAccessPolicy_Handler,
+		},
+		{
+			MethodName: "GetKubeletStatus",
+			Handler:    _ManagementService_GetKubeletStatus_Handler,
+		},
+		{
+			MethodName: "GetInstallDiskSelection",
+			Handler:    _ManagementService_GetInstallDiskSelection_Handler,
+		},
+		{
+			MethodName: "CreateSchematics",
+			Handler:    _ManagementService_CreateSchematics_Handler,
+		},
+		{
+			MethodName: "CompareSchematicToBaseline",
+			Handler:    _ManagementService_CompareSchematicToBaseline_Handler,
+		},
+		{
+			MethodName: "GetMachineStatusAt",
+			Handler:    _ManagementService_GetMachineStatusAt_Handler,
+		},
+		{
+			MethodName: "ValidateUpgradeImageReachability",
+			Handler:    _ManagementService_ValidateUpgradeImageReachability_Handler,
+		},
+		{
+			MethodName: "ExportServiceAccounts",
+			Handler:    _ManagementService_ExportServiceAccounts_Handler,
+		},
+		{
+			MethodName: "ImportServiceAccounts",
+			Handler:    _ManagementService_ImportServiceAccounts_Handler,
+		},
+		{
+			MethodName: "ListUnhealthyMachines",
+			Handler:    _ManagementService_ListUnhealthyMachines_Handler,
+		},
+		{
+			MethodName: "GetClusterCapacity",
+			Handler:    _ManagementService_GetClusterCapacity_Handler,
+		},
+		{
+			MethodName: "GetClockDrift",
+			Handler:    _ManagementService_GetClockDrift_Handler,
+		},
+		{
+			MethodName: "SetMachineReserved",
+			Handler:    _ManagementService_SetMachineReserved_Handler,
+		},
+		{
+			MethodName: "ListUnallocatedMachines",
+			Handler:    _ManagementService_ListUnallocatedMachines_Handler,
+		},
+		{
+			MethodName: "GetMachineResource",
+			Handler:    _ManagementService_GetMachineResource_Handler,
+		},
+		{
+			MethodName: "OmniconfigFor",
+			Handler:    _ManagementService_OmniconfigFor_Handler,
+		},
+		{
+			MethodName: "ReapplyMachineConfig",
+			Handler:    _ManagementService_ReapplyMachineConfig_Handler,
+		},
+		{
+			MethodName: "ListKubernetesUpgrades",
+			Handler:    _ManagementService_ListKubernetesUpgrades_Handler,
+		},
+		{
+			MethodName: "ValidateClusterComposition",
+			Handler:    _ManagementService_ValidateClusterComposition_Handler,
+		},
+		{
+			MethodName: "SetSchematicName",
+			Handler:    _ManagementService_SetSchematicName_Handler,
+		},
+		{
+			MethodName: "ValidateOmniEndpoint",
+			Handler:    _ManagementService_ValidateOmniEndpoint_Handler,
+		},
+		{
+			MethodName: "DiffSchematics",
+			Handler:    _ManagementService_DiffSchematics_Handler,
+		},
+		{
+			MethodName: "ListInvalidSchematicMachines",
+			Handler:    _ManagementService_ListInvalidSchematicMachines_Handler,
+		},
+		{
+			MethodName: "GetMachineStatusJSON",
+			Handler:    _ManagementService_GetMachineStatusJSON_Handler,
+		},
+		{
+			MethodName: "ConfirmServiceAccountKey",
+			Handler:    _ManagementService_ConfirmServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "QueryMachineStatuses",
+			Handler:    _ManagementService_QueryMachineStatuses_Handler,
+		},
+		{
+			MethodName: "GetPendingMachineConfig",
+			Handler:    _ManagementService_GetPendingMachineConfig_Handler,
+		},
+		{
+			MethodName: "ListAllServiceAccountKeys",
+			Handler:    _ManagementService_ListAllServiceAccountKeys_Handler,
+		},
+		{
+			MethodName: "SetMachineLogRetention",
+			Handler:    _ManagementService_SetMachineLogRetention_Handler,
+		},
+		{
+			MethodName: "ValidateClusterMembership",
+			Handler:    _ManagementService_ValidateClusterMembership_Handler,
+		},
+		{
+			MethodName: "GetJoinKernelArgs",
+			Handler:    _ManagementService_GetJoinKernelArgs_Handler,
+		},
+		{
+			MethodName: "GetAvailableExtensionUpdates",
+			Handler:    _ManagementService_GetAvailableExtensionUpdates_Handler,
+		},
+		{
+			MethodName: "ValidateSchematicUpgrade",
+			Handler:    _ManagementService_ValidateSchematicUpgrade_Handler,
+		},
+		{
+			MethodName: "GetClusterTopology",
+			Handler:    _ManagementService_GetClusterTopology_Handler,
+		},
+		{
+			MethodName: "ListMachinesByUptime",
+			Handler:    _ManagementService_ListMachinesByUptime_Handler,
+		},
+		{
+			MethodName: "ApplyMaintenanceConfig",
+			Handler:    _ManagementService_ApplyMaintenanceConfig_Handler,
+		},
+		{
+			MethodName: "ListSchematicsWithStaleArgs",
+			Handler:    _ManagementService_ListSchematicsWithStaleArgs_Handler,
+		},
+		{
+			MethodName: "DestroyServiceAccounts",
+			Handler:    _ManagementService_DestroyServiceAccounts_Handler,
+		},
+		{
+			MethodName: "GetConfigSchema",
+			Handler:    _ManagementService_GetConfigSchema_Handler,
+		},
+		{
+			MethodName: "GetMachineEvents",
+			Handler:    _ManagementService_GetMachineEvents_Handler,
+		},
+		{
+			MethodName: "ValidateMetaValues",
+			Handler:    _ManagementService_ValidateMetaValues_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _ManagementService_WhoAmI_Handler,
+		},
+		{
+			MethodName: "GetExtensionUsage",
+			Handler:    _ManagementService_GetExtensionUsage_Handler,
+		},
+		{
+			MethodName: "GetSchematicISOURL",
+			Handler:    _ManagementService_GetSchematicISOURL_Handler,
+		},
+		{
+			MethodName: "ListMachinesWithHardwareChanges",
+			Handler:    _ManagementService_ListMachinesWithHardwareChanges_Handler,
+		},
+		{
+			MethodName: "TestServiceAccount",
+			Handler:    _ManagementService_TestServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetMachineStatusTimeSeries",
+			Handler:    _ManagementService_GetMachineStatusTimeSeries_Handler,
+		},
+		{
+			MethodName: "ListMachinesPendingReboot",
+			Handler:    _ManagementService_ListMachinesPendingReboot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -569,6 +3276,41 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManagementService_KubernetesSyncManifests_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchKubernetesEvents",
+			Handler:       _ManagementService_WatchKubernetesEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DecommissionMachine",
+			Handler:       _ManagementService_DecommissionMachine_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetMachineDmesg",
+			Handler:       _ManagementService_GetMachineDmesg_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateSchematicWithProgress",
+			Handler:       _ManagementService_CreateSchematicWithProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamClusterUtilization",
+			Handler:       _ManagementService_StreamClusterUtilization_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchUpgradeProgress",
+			Handler:       _ManagementService_WatchUpgradeProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateClusterConfigs",
+			Handler:       _ManagementService_ValidateClusterConfigs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "omni/management/management.proto",
 }
@@ -13,14 +13,17 @@ import (
 	"io"
 	"time"
 
+	"github.com/siderolabs/gen/xslices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
 )
 
 // TalosconfigOption is a functional option for Talosconfig.
@@ -46,120 +49,1126 @@ func WithServiceAccount(ttl time.Duration, user string, groups ...string) Kubeco
 	}
 }
 
+// WithTTL limits the lifetime of the user (OIDC) kubeconfig.
+func WithTTL(ttl time.Duration) KubeconfigOption {
+	return func(request *management.KubeconfigRequest) {
+		request.Ttl = durationpb.New(ttl)
+	}
+}
+
+// ListServiceAccountsOption is a functional option for ListServiceAccounts.
+type ListServiceAccountsOption func(*management.ListServiceAccountsRequest)
+
+// WithInternalServiceAccounts sets whether the service accounts created by Omni for the internal use should be listed.
+func WithInternalServiceAccounts(includeInternal bool) ListServiceAccountsOption {
+	return func(req *management.ListServiceAccountsRequest) {
+		req.IncludeInternal = includeInternal
+	}
+}
+
+// WithoutExpiredServiceAccounts sets whether the service accounts which have no unexpired public keys should be skipped.
+func WithoutExpiredServiceAccounts(excludeExpired bool) ListServiceAccountsOption {
+	return func(req *management.ListServiceAccountsRequest) {
+		req.ExcludeExpired = excludeExpired
+	}
+}
+
+// CreateServiceAccountOption is a functional option for CreateServiceAccount.
+type CreateServiceAccountOption func(*management.CreateServiceAccountRequest)
+
+// WithClusterScope limits the created service account to the given cluster.
+func WithClusterScope(clusterName string) CreateServiceAccountOption {
+	return func(req *management.CreateServiceAccountRequest) {
+		req.ClusterScope = clusterName
+	}
+}
+
 // Client for Management API .
 type Client struct {
 	conn management.ManagementServiceClient
 }
 
-// NewClient builds a client out of gRPC connection.
-func NewClient(conn *grpc.ClientConn) *Client {
-	return &Client{
-		conn: management.NewManagementServiceClient(conn),
+// NewClient builds a client out of gRPC connection.
+func NewClient(conn *grpc.ClientConn) *Client {
+	return &Client{
+		conn: management.NewManagementServiceClient(conn),
+	}
+}
+
+// WithCluster sets the cluster name context.
+func (client *Client) WithCluster(clusterName string) *ClusterClient {
+	return &ClusterClient{
+		client:      client,
+		clusterName: clusterName,
+	}
+}
+
+// Talosconfig retrieves Talos client configuration for the whole instance.
+func (client *Client) Talosconfig(ctx context.Context, opts ...TalosconfigOption) ([]byte, error) {
+	request := management.TalosconfigRequest{}
+
+	for _, opt := range opts {
+		opt(&request)
+	}
+
+	talosconfigResp, err := client.conn.Talosconfig(ctx, &request)
+
+	return talosconfigResp.GetTalosconfig(), err
+}
+
+// Omniconfig retrieves Omni configuration for the clients.
+func (client *Client) Omniconfig(ctx context.Context) ([]byte, error) {
+	omniconfig, err := client.conn.Omniconfig(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get omniconfig: %w", err)
+	}
+
+	return omniconfig.Omniconfig, nil
+}
+
+// OmniconfigFor retrieves Omni configuration for the given identity.
+func (client *Client) OmniconfigFor(ctx context.Context, identity string) ([]byte, error) {
+	omniconfig, err := client.conn.OmniconfigFor(ctx, &management.OmniconfigForRequest{
+		Identity: identity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get omniconfig: %w", err)
+	}
+
+	return omniconfig.Omniconfig, nil
+}
+
+// ValidateOmniEndpoint validates the URL to be used as the omniconfig destination, and optionally checks that the endpoint responds.
+func (client *Client) ValidateOmniEndpoint(ctx context.Context, url string, checkConnectivity bool) (*management.ValidateOmniEndpointResponse, error) {
+	return client.conn.ValidateOmniEndpoint(ctx, &management.ValidateOmniEndpointRequest{
+		Url:               url,
+		CheckConnectivity: checkConnectivity,
+	})
+}
+
+// LogsReader returns the io.Reader for the logs with each message separated by '\n'.
+func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32) (io.Reader, error) {
+	logStream, err := client.conn.MachineLogs(ctx, &management.MachineLogsRequest{
+		MachineId: machineID,
+		Follow:    follow,
+		TailLines: tailLines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogReader{
+		ctx:    ctx,
+		client: logStream,
+	}, nil
+}
+
+// DmesgReader returns the io.Reader for the machine kernel logs with each message separated by '\n'.
+func (client *Client) DmesgReader(ctx context.Context, machineID string) (io.Reader, error) {
+	dmesgStream, err := client.conn.GetMachineDmesg(ctx, &management.GetMachineDmesgRequest{
+		MachineId: machineID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogReader{
+		ctx:    ctx,
+		client: dmesgStream,
+	}, nil
+}
+
+// ValidateConfig validates the config patch and returns the lint warnings for it.
+//
+// The lint rules with the IDs in suppressedLintRules are not checked.
+func (client *Client) ValidateConfig(ctx context.Context, config string, suppressedLintRules ...string) ([]*management.ValidateConfigResponse_LintWarning, error) {
+	resp, err := client.conn.ValidateConfig(ctx, &management.ValidateConfigRequest{
+		Config:              config,
+		SuppressedLintRules: suppressedLintRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetLintWarnings(), nil
+}
+
+// GetConfigSchema returns the machine config JSON schema for the Talos version.
+func (client *Client) GetConfigSchema(ctx context.Context, talosVersion string) (string, error) {
+	resp, err := client.conn.GetConfigSchema(ctx, &management.GetConfigSchemaRequest{
+		TalosVersion: talosVersion,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetSchema(), nil
+}
+
+// CreateSchematic using the image factory.
+func (client *Client) CreateSchematic(ctx context.Context, req *management.CreateSchematicRequest) (*management.CreateSchematicResponse, error) {
+	schematic, err := client.conn.CreateSchematic(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return schematic, nil
+}
+
+// GetJoinKernelArgs returns the kernel args which connect the machine to Omni.
+func (client *Client) GetJoinKernelArgs(ctx context.Context) (string, error) {
+	resp, err := client.conn.GetJoinKernelArgs(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetKernelArgs(), nil
+}
+
+// GetAvailableExtensionUpdates returns the installed extensions of the machine which have newer versions in the image factory.
+func (client *Client) GetAvailableExtensionUpdates(ctx context.Context, machineID string) (*management.GetAvailableExtensionUpdatesResponse, error) {
+	return client.conn.GetAvailableExtensionUpdates(ctx, &management.GetAvailableExtensionUpdatesRequest{
+		MachineId: machineID,
+	})
+}
+
+// ValidateSchematicUpgrade checks whether the machine can be upgraded in-place to the target schematic installer image.
+func (client *Client) ValidateSchematicUpgrade(ctx context.Context, machineID, targetSchematicID string) (*management.ValidateSchematicUpgradeResponse, error) {
+	return client.conn.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{
+		MachineId:         machineID,
+		TargetSchematicId: targetSchematicID,
+	})
+}
+
+// GetClusterTopology returns the cluster machine sets and machines with the relationships between them.
+func (client *Client) GetClusterTopology(ctx context.Context, clusterName string) (*management.GetClusterTopologyResponse, error) {
+	return client.conn.GetClusterTopology(ctx, &management.GetClusterTopologyRequest{
+		ClusterName: clusterName,
+	})
+}
+
+// ListMachinesByUptime returns the machines connected for at least the given duration, the longest connected first.
+func (client *Client) ListMachinesByUptime(ctx context.Context, minConnectedDuration time.Duration) ([]*management.ListMachinesByUptimeResponse_Machine, error) {
+	resp, err := client.conn.ListMachinesByUptime(ctx, &management.ListMachinesByUptimeRequest{
+		MinConnectedDuration: durationpb.New(minConnectedDuration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachines(), nil
+}
+
+// ListMachinesWithHardwareChanges returns the machines which hardware changed since the given time, the most recently changed first.
+//
+// The zero time returns all machines with the recorded hardware changes.
+func (client *Client) ListMachinesWithHardwareChanges(ctx context.Context, since time.Time) ([]*management.ListMachinesWithHardwareChangesResponse_Machine, error) {
+	req := &management.ListMachinesWithHardwareChangesRequest{}
+
+	if !since.IsZero() {
+		req.Since = timestamppb.New(since)
+	}
+
+	resp, err := client.conn.ListMachinesWithHardwareChanges(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachines(), nil
+}
+
+// ApplyMaintenanceConfig applies the machine config to the machine running in the maintenance mode.
+func (client *Client) ApplyMaintenanceConfig(ctx context.Context, machineID, config string) (*management.ApplyMaintenanceConfigResponse, error) {
+	return client.conn.ApplyMaintenanceConfig(ctx, &management.ApplyMaintenanceConfigRequest{
+		MachineId: machineID,
+		Config:    config,
+	})
+}
+
+// ValidateMetaValues checks the meta values the same way CreateSchematic does, without creating the schematic.
+func (client *Client) ValidateMetaValues(ctx context.Context, metaValues map[uint32]string) (*management.ValidateMetaValuesResponse, error) {
+	return client.conn.ValidateMetaValues(ctx, &management.ValidateMetaValuesRequest{
+		MetaValues: metaValues,
+	})
+}
+
+// CreateSchematicProgressHandler is called for each schematic creation stage.
+type CreateSchematicProgressHandler func(management.CreateSchematicProgress_Stage) error
+
+// CreateSchematicWithProgress creates a schematic the same way CreateSchematic does, and reports the progress to the handler.
+func (client *Client) CreateSchematicWithProgress(ctx context.Context, req *management.CreateSchematicRequest,
+	handler CreateSchematicProgressHandler,
+) (*management.CreateSchematicResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cli, err := client.conn.CreateSchematicWithProgress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("schematic creation finished without a result")
+			}
+
+			return nil, err
+		}
+
+		if handler != nil {
+			if err = handler(msg.GetStage()); err != nil {
+				return nil, err
+			}
+		}
+
+		if msg.GetStage() == management.CreateSchematicProgress_DONE {
+			return msg.GetSchematic(), nil
+		}
+	}
+}
+
+// CreateSchematics creates multiple schematics using the image factory, the responses are returned in the same order as the requests.
+func (client *Client) CreateSchematics(ctx context.Context, reqs ...*management.CreateSchematicRequest) ([]*management.CreateSchematicResponse, error) {
+	resp, err := client.conn.CreateSchematics(ctx, &management.CreateSchematicsRequest{
+		Schematics: reqs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSchematics(), nil
+}
+
+// GetSchematicPXEURL returns the PXE URL for the schematic ID.
+func (client *Client) GetSchematicPXEURL(ctx context.Context, schematicID string) (string, error) {
+	resp, err := client.conn.GetSchematicPXEURL(ctx, &management.GetSchematicPXEURLRequest{
+		SchematicId: schematicID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetPxeUrl(), nil
+}
+
+// GetInstallerImageURL returns the installer image reference for the schematic ID and Talos version.
+func (client *Client) GetInstallerImageURL(ctx context.Context, schematicID, talosVersion string) (string, error) {
+	resp, err := client.conn.GetInstallerImageURL(ctx, &management.GetInstallerImageURLRequest{
+		SchematicId:  schematicID,
+		TalosVersion: talosVersion,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetImage(), nil
+}
+
+// GetSchematicISOURL returns the image factory URL of the ISO built from the schematic for the Talos version and architecture.
+func (client *Client) GetSchematicISOURL(ctx context.Context, schematicID, talosVersion, arch string) (string, error) {
+	resp, err := client.conn.GetSchematicISOURL(ctx, &management.GetSchematicISOURLRequest{
+		SchematicId:  schematicID,
+		TalosVersion: talosVersion,
+		Arch:         arch,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetUrl(), nil
+}
+
+// CompareKernelArgs compares the kernel args the machine booted with against the ones from its schematic.
+func (client *Client) CompareKernelArgs(ctx context.Context, machineID string) (*management.CompareKernelArgsResponse, error) {
+	return client.conn.CompareKernelArgs(ctx, &management.CompareKernelArgsRequest{
+		MachineId: machineID,
+	})
+}
+
+// CompareSchematicToBaseline reports the extensions which differ between the machine schematic and the baseline schematic.
+func (client *Client) CompareSchematicToBaseline(ctx context.Context, machineID, baselineSchematicID string) (*management.CompareSchematicToBaselineResponse, error) {
+	return client.conn.CompareSchematicToBaseline(ctx, &management.CompareSchematicToBaselineRequest{
+		MachineId:           machineID,
+		BaselineSchematicId: baselineSchematicID,
+	})
+}
+
+// DiffSchematics reports the extensions and the kernel args added and removed from the schematic A to the schematic B.
+func (client *Client) DiffSchematics(ctx context.Context, schematicIDA, schematicIDB string) (*management.DiffSchematicsResponse, error) {
+	return client.conn.DiffSchematics(ctx, &management.DiffSchematicsRequest{
+		SchematicIdA: schematicIDA,
+		SchematicIdB: schematicIDB,
+	})
+}
+
+// SetSchematicName sets the human-readable name and description of the schematic, empty values clear them.
+func (client *Client) SetSchematicName(ctx context.Context, schematicID, name, description string) error {
+	_, err := client.conn.SetSchematicName(ctx, &management.SetSchematicNameRequest{
+		SchematicId: schematicID,
+		Name:        name,
+		Description: description,
+	})
+
+	return err
+}
+
+// ImportExternalNodes registers the nodes of a cluster not managed by Omni as machines, and returns their IDs.
+func (client *Client) ImportExternalNodes(ctx context.Context, name string, talosconfig []byte, endpoints ...string) ([]string, error) {
+	resp, err := client.conn.ImportExternalNodes(ctx, &management.ImportExternalNodesRequest{
+		Name:        name,
+		Talosconfig: talosconfig,
+		Endpoints:   endpoints,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachineIds(), nil
+}
+
+// CreateServiceAccount creates a service account and returns the public key ID.
+func (client *Client) CreateServiceAccount(ctx context.Context, armoredPGPPublicKey string, role string, useUserRole bool, opts ...CreateServiceAccountOption) (string, error) {
+	request := management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredPGPPublicKey,
+		Role:                role,
+		UseUserRole:         useUserRole,
+	}
+
+	for _, opt := range opts {
+		opt(&request)
+	}
+
+	resp, err := client.conn.CreateServiceAccount(ctx, &request)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.PublicKeyId, nil
+}
+
+// ValidateServiceAccountKey validates the service account PGP public key without creating the service account.
+func (client *Client) ValidateServiceAccountKey(ctx context.Context, armoredPGPPublicKey string) (*management.ValidateServiceAccountKeyResponse, error) {
+	return client.conn.ValidateServiceAccountKey(ctx, &management.ValidateServiceAccountKeyRequest{
+		ArmoredPgpPublicKey: armoredPGPPublicKey,
+	})
+}
+
+// RenewServiceAccount renews a service account and returns the public key ID.
+func (client *Client) RenewServiceAccount(ctx context.Context, name, armoredPGPPublicKey string) (string, error) {
+	resp, err := client.conn.RenewServiceAccount(ctx, &management.RenewServiceAccountRequest{
+		Name:                name,
+		ArmoredPgpPublicKey: armoredPGPPublicKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.PublicKeyId, nil
+}
+
+// RenewServiceAccountWithGracePeriod renews a service account and retires its old public keys,
+// they stay valid until the end of the grace period or until they expire, whichever is later.
+func (client *Client) RenewServiceAccountWithGracePeriod(ctx context.Context, name, armoredPGPPublicKey string,
+	gracePeriod time.Duration,
+) (*management.RenewServiceAccountResponse, error) {
+	return client.conn.RenewServiceAccount(ctx, &management.RenewServiceAccountRequest{
+		Name:                name,
+		ArmoredPgpPublicKey: armoredPGPPublicKey,
+		GracePeriod:         durationpb.New(gracePeriod),
+	})
+}
+
+// RotateServiceAccount replaces all public keys of the service account with the new one.
+//
+// If some of the old keys fail to be removed, the error contains the partial result, see RotateServiceAccountPartialResult.
+func (client *Client) RotateServiceAccount(ctx context.Context, name, armoredPGPPublicKey string) (*management.RotateServiceAccountResponse, error) {
+	return client.conn.RotateServiceAccount(ctx, &management.RotateServiceAccountRequest{
+		Name:                name,
+		ArmoredPgpPublicKey: armoredPGPPublicKey,
+	})
+}
+
+// RotateServiceAccountPartialResult extracts the partial result from the error returned by RotateServiceAccount.
+func RotateServiceAccountPartialResult(err error) (*management.RotateServiceAccountResponse, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		if resp, ok := detail.(*management.RotateServiceAccountResponse); ok {
+			return resp, true
+		}
+	}
+
+	return nil, false
+}
+
+// ListServiceAccounts lists service accounts.
+func (client *Client) ListServiceAccounts(ctx context.Context, opts ...ListServiceAccountsOption) ([]*management.ListServiceAccountsResponse_ServiceAccount, error) {
+	request := management.ListServiceAccountsRequest{}
+
+	for _, opt := range opts {
+		opt(&request)
+	}
+
+	response, err := client.conn.ListServiceAccounts(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetServiceAccounts(), nil
+}
+
+// ListAllServiceAccountKeys returns a page of the public keys of all service accounts.
+//
+// The zero limit returns all keys starting from the offset.
+func (client *Client) ListAllServiceAccountKeys(ctx context.Context, offset, limit int32) (*management.ListAllServiceAccountKeysResponse, error) {
+	return client.conn.ListAllServiceAccountKeys(ctx, &management.ListAllServiceAccountKeysRequest{
+		Offset: offset,
+		Limit:  limit,
+	})
+}
+
+// DestroyServiceAccount deletes a service account.
+func (client *Client) DestroyServiceAccount(ctx context.Context, name string) error {
+	_, err := client.conn.DestroyServiceAccount(ctx, &management.DestroyServiceAccountRequest{
+		Name: name,
+	})
+
+	return err
+}
+
+// DestroyServiceAccountPartialResult extracts the partial result from the error returned by DestroyServiceAccount.
+//
+// The result lists the resources which were destroyed and the ones which failed to be destroyed.
+func DestroyServiceAccountPartialResult(err error) (*management.DestroyServiceAccountResponse, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		if resp, ok := detail.(*management.DestroyServiceAccountResponse); ok {
+			return resp, true
+		}
+	}
+
+	return nil, false
+}
+
+// DestroyServiceAccountDryRun returns the resources which would be destroyed along with the service account, without destroying anything.
+func (client *Client) DestroyServiceAccountDryRun(ctx context.Context, name string) ([]*management.DestroyServiceAccountResponse_Resource, error) {
+	resp, err := client.conn.DestroyServiceAccount(ctx, &management.DestroyServiceAccountRequest{
+		Name:   name,
+		DryRun: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetResources(), nil
+}
+
+// DestroyServiceAccounts deletes the service accounts matching the filter, in the dry run mode it only returns the service accounts which would be deleted.
+func (client *Client) DestroyServiceAccounts(ctx context.Context, filter *management.DestroyServiceAccountsRequest_Filter, dryRun bool) ([]*management.DestroyServiceAccountsResponse_Result, error) {
+	resp, err := client.conn.DestroyServiceAccounts(ctx, &management.DestroyServiceAccountsRequest{
+		Filter: filter,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetResults(), nil
+}
+
+// DestroyServiceAccountsPartialResult extracts the partial result from the error returned by DestroyServiceAccounts.
+func DestroyServiceAccountsPartialResult(err error) (*management.DestroyServiceAccountsResponse, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	for _, detail := range st.Details() {
+		if resp, ok := detail.(*management.DestroyServiceAccountsResponse); ok {
+			return resp, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetServiceAccountKey returns a single public key of a service account.
+func (client *Client) GetServiceAccountKey(ctx context.Context, name, keyID string) (*management.GetServiceAccountKeyResponse, error) {
+	return client.conn.GetServiceAccountKey(ctx, &management.GetServiceAccountKeyRequest{
+		Name:  name,
+		KeyId: keyID,
+	})
+}
+
+// TestServiceAccount verifies that the service account can authenticate with the key pair.
+//
+// The signed proof is the detached signature of the service account name made with the service account private key.
+func (client *Client) TestServiceAccount(ctx context.Context, name string, signedProof []byte) (*management.TestServiceAccountResponse, error) {
+	return client.conn.TestServiceAccount(ctx, &management.TestServiceAccountRequest{
+		Name:        name,
+		SignedProof: signedProof,
+	})
+}
+
+// ConfirmServiceAccountKey marks the public key of a service account as confirmed.
+func (client *Client) ConfirmServiceAccountKey(ctx context.Context, name, keyID string) error {
+	_, err := client.conn.ConfirmServiceAccountKey(ctx, &management.ConfirmServiceAccountKeyRequest{
+		Name:  name,
+		KeyId: keyID,
+	})
+
+	return err
+}
+
+// KubernetesEventHandler is called for each Kubernetes event.
+type KubernetesEventHandler func(*management.WatchKubernetesEventsResponse) error
+
+// WatchKubernetesEvents streams the Kubernetes events of the cluster until the context is canceled.
+func (client *Client) WatchKubernetesEvents(ctx context.Context, clusterName string, handler KubernetesEventHandler) error {
+	cli, err := client.conn.WatchKubernetesEvents(ctx, &management.WatchKubernetesEventsRequest{
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if expectedErr(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// UpgradeProgressHandler is called for each node upgrade state transition.
+type UpgradeProgressHandler func(*management.WatchUpgradeProgressResponse) error
+
+// WatchUpgradeProgress streams the per-node Talos and Kubernetes upgrade states of the cluster until the context is canceled.
+//
+// The current state of each node is sent first, then only the state transitions are sent.
+func (client *Client) WatchUpgradeProgress(ctx context.Context, clusterName string, handler UpgradeProgressHandler) error {
+	cli, err := client.conn.WatchUpgradeProgress(ctx, &management.WatchUpgradeProgressRequest{
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if expectedErr(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// ClusterConfigValidationHandler is called for the config validation result of each cluster machine.
+type ClusterConfigValidationHandler func(*management.ValidateClusterConfigsResponse) error
+
+// ValidateClusterConfigs validates the configs of all cluster machines, the result is streamed for each machine.
+func (client *Client) ValidateClusterConfigs(ctx context.Context, clusterName string, handler ClusterConfigValidationHandler) error {
+	cli, err := client.conn.ValidateClusterConfigs(ctx, &management.ValidateClusterConfigsRequest{
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if expectedErr(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// ClusterUtilizationHandler is called for each cluster utilization sample.
+type ClusterUtilizationHandler func(*management.StreamClusterUtilizationResponse) error
+
+// StreamClusterUtilization streams the CPU and memory utilization of the cluster nodes until the stream duration passes.
+//
+// The zero interval and duration are replaced with the server defaults.
+func (client *Client) StreamClusterUtilization(ctx context.Context, clusterName string, interval, duration time.Duration, handler ClusterUtilizationHandler) error {
+	request := &management.StreamClusterUtilizationRequest{
+		ClusterName: clusterName,
+	}
+
+	if interval != 0 {
+		request.Interval = durationpb.New(interval)
+	}
+
+	if duration != 0 {
+		request.Duration = durationpb.New(duration)
+	}
+
+	cli, err := client.conn.StreamClusterUtilization(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if expectedErr(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
 	}
 }
 
-// WithCluster sets the cluster name context.
-func (client *Client) WithCluster(clusterName string) *ClusterClient {
-	return &ClusterClient{
-		client:      client,
-		clusterName: clusterName,
+// CreateMachineGroup creates or updates the machine group and returns the IDs of the machines currently in the group.
+func (client *Client) CreateMachineGroup(ctx context.Context, name string, matchLabels []string) ([]string, error) {
+	resp, err := client.conn.CreateMachineGroup(ctx, &management.CreateMachineGroupRequest{
+		Name:        name,
+		MatchLabels: matchLabels,
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return resp.GetMachineIds(), nil
 }
 
-// Talosconfig retrieves Talos client configuration for the whole instance.
-func (client *Client) Talosconfig(ctx context.Context, opts ...TalosconfigOption) ([]byte, error) {
-	request := management.TalosconfigRequest{}
+// LabelMachineGroup sets the labels on all machines of the machine group.
+//
+// If the operation fails on some of the machines, the error contains the partial result, see MachineGroupOperationPartialResult.
+func (client *Client) LabelMachineGroup(ctx context.Context, name string, labels map[string]string) (*management.MachineGroupOperationResponse, error) {
+	return client.conn.LabelMachineGroup(ctx, &management.LabelMachineGroupRequest{
+		Name:   name,
+		Labels: labels,
+	})
+}
 
-	for _, opt := range opts {
-		opt(&request)
+// RebootMachineGroup reboots all machines of the machine group.
+//
+// If the operation fails on some of the machines, the error contains the partial result, see MachineGroupOperationPartialResult.
+func (client *Client) RebootMachineGroup(ctx context.Context, name string) (*management.MachineGroupOperationResponse, error) {
+	return client.conn.RebootMachineGroup(ctx, &management.RebootMachineGroupRequest{
+		Name: name,
+	})
+}
+
+// MachineGroupOperationPartialResult extracts the partial result from the error returned by the machine group operations.
+func MachineGroupOperationPartialResult(err error) (*management.MachineGroupOperationResponse, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
 	}
 
-	talosconfigResp, err := client.conn.Talosconfig(ctx, &request)
+	for _, detail := range st.Details() {
+		if resp, ok := detail.(*management.MachineGroupOperationResponse); ok {
+			return resp, true
+		}
+	}
 
-	return talosconfigResp.GetTalosconfig(), err
+	return nil, false
 }
 
-// Omniconfig retrieves Omni configuration for the clients.
-func (client *Client) Omniconfig(ctx context.Context) ([]byte, error) {
-	omniconfig, err := client.conn.Omniconfig(ctx, &emptypb.Empty{})
+// GetMachinePatchOrder returns the config patches of the machine in the order they are applied.
+func (client *Client) GetMachinePatchOrder(ctx context.Context, machineID string) ([]*management.GetMachinePatchOrderResponse_Patch, error) {
+	resp, err := client.conn.GetMachinePatchOrder(ctx, &management.GetMachinePatchOrderRequest{
+		MachineId: machineID,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get omniconfig: %w", err)
+		return nil, err
 	}
 
-	return omniconfig.Omniconfig, nil
+	return resp.GetPatches(), nil
 }
 
-// LogsReader returns the io.Reader for the logs with each message separated by '\n'.
-func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32) (io.Reader, error) {
-	logStream, err := client.conn.MachineLogs(ctx, &management.MachineLogsRequest{
+// SetKubernetesUpgradeWindow restricts the Kubernetes upgrades of the cluster to roll out only in the given time window.
+//
+// The start is the time of the day in the HH:MM format, UTC. If no days are given, the window opens every day.
+func (client *Client) SetKubernetesUpgradeWindow(ctx context.Context, clusterName, start string, duration time.Duration, days ...time.Weekday) error {
+	_, err := client.conn.SetKubernetesUpgradeWindow(ctx, &management.SetKubernetesUpgradeWindowRequest{
+		ClusterName: clusterName,
+		Days:        xslices.Map(days, func(day time.Weekday) uint32 { return uint32(day) }),
+		Start:       start,
+		Duration:    durationpb.New(duration),
+	})
+
+	return err
+}
+
+// DeleteKubernetesUpgradeWindow removes the Kubernetes upgrade window of the cluster.
+func (client *Client) DeleteKubernetesUpgradeWindow(ctx context.Context, clusterName string) error {
+	_, err := client.conn.DeleteKubernetesUpgradeWindow(ctx, &management.DeleteKubernetesUpgradeWindowRequest{
+		ClusterName: clusterName,
+	})
+
+	return err
+}
+
+// DecommissionStepHandler is called for each decommission step.
+type DecommissionStepHandler func(*management.DecommissionMachineResponse) error
+
+// DecommissionMachine removes the machine from its cluster, wipes it and removes it from Omni.
+//
+// Control plane nodes are decommissioned only if confirmControlPlane is set.
+func (client *Client) DecommissionMachine(ctx context.Context, machineID string, confirmControlPlane bool, handler DecommissionStepHandler) error {
+	cli, err := client.conn.DecommissionMachine(ctx, &management.DecommissionMachineRequest{
+		MachineId:           machineID,
+		ConfirmControlPlane: confirmControlPlane,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// ListMachinesByTalosVersion returns the machines grouped by the Talos version matching the semver constraint.
+//
+// The machines with unknown Talos version are always returned in the separate list.
+func (client *Client) ListMachinesByTalosVersion(ctx context.Context, constraint string) (*management.ListMachinesByTalosVersionResponse, error) {
+	return client.conn.ListMachinesByTalosVersion(ctx, &management.ListMachinesByTalosVersionRequest{
+		Constraint: constraint,
+	})
+}
+
+// ListUnhealthyMachines returns the machines which are disconnected or failed their last poll.
+func (client *Client) ListUnhealthyMachines(ctx context.Context) ([]*management.ListUnhealthyMachinesResponse_Machine, error) {
+	resp, err := client.conn.ListUnhealthyMachines(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachines(), nil
+}
+
+// ListMachinesPendingReboot returns the machines which need a reboot to apply the staged configuration, and the machines which are rebooting now.
+func (client *Client) ListMachinesPendingReboot(ctx context.Context) ([]*management.ListMachinesPendingRebootResponse_Machine, error) {
+	resp, err := client.conn.ListMachinesPendingReboot(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachines(), nil
+}
+
+// QueryMachineStatuses returns a page of the machine statuses matching the label selector.
+//
+// The zero limit returns all machines starting from the offset.
+func (client *Client) QueryMachineStatuses(ctx context.Context, labelSelector string, offset, limit int32) (*management.QueryMachineStatusesResponse, error) {
+	return client.conn.QueryMachineStatuses(ctx, &management.QueryMachineStatusesRequest{
+		LabelSelector: labelSelector,
+		Offset:        offset,
+		Limit:         limit,
+	})
+}
+
+// ListInvalidSchematicMachines returns the machines which have the extensions installed bypassing the image factory.
+func (client *Client) ListInvalidSchematicMachines(ctx context.Context) ([]*management.ListInvalidSchematicMachinesResponse_Machine, error) {
+	resp, err := client.conn.ListInvalidSchematicMachines(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetMachines(), nil
+}
+
+// ListSchematicsWithStaleArgs returns the schematics which don't embed the current connection kernel args with the machines running them.
+func (client *Client) ListSchematicsWithStaleArgs(ctx context.Context) ([]*management.ListSchematicsWithStaleArgsResponse_Schematic, error) {
+	resp, err := client.conn.ListSchematicsWithStaleArgs(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSchematics(), nil
+}
+
+// SetMachineReserved reserves the machine, so it is not allocated to the clusters, or releases the reservation.
+func (client *Client) SetMachineReserved(ctx context.Context, machineID string, reserved bool) error {
+	_, err := client.conn.SetMachineReserved(ctx, &management.SetMachineReservedRequest{
 		MachineId: machineID,
-		Follow:    follow,
-		TailLines: tailLines,
+		Reserved:  reserved,
+	})
+
+	return err
+}
+
+// SetMachineLogRetention sets the size of the log buffer kept for the machine, zero resets it to the default.
+func (client *Client) SetMachineLogRetention(ctx context.Context, machineID string, maxSizeBytes uint64) error {
+	_, err := client.conn.SetMachineLogRetention(ctx, &management.SetMachineLogRetentionRequest{
+		MachineId:    machineID,
+		MaxSizeBytes: maxSizeBytes,
+	})
+
+	return err
+}
+
+// ValidateClusterMembership reports the inconsistencies between the cluster membership recorded in Omni and the actual etcd and Kubernetes membership.
+func (client *Client) ValidateClusterMembership(ctx context.Context, clusterName string) (*management.ValidateClusterMembershipResponse, error) {
+	return client.conn.ValidateClusterMembership(ctx, &management.ValidateClusterMembershipRequest{
+		ClusterName: clusterName,
+	})
+}
+
+// ListUnallocatedMachines returns the machines which are not part of any cluster.
+func (client *Client) ListUnallocatedMachines(ctx context.Context, excludeReserved bool) ([]*management.ListUnallocatedMachinesResponse_Machine, error) {
+	resp, err := client.conn.ListUnallocatedMachines(ctx, &management.ListUnallocatedMachinesRequest{
+		ExcludeReserved: excludeReserved,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &LogReader{
-		ctx:    ctx,
-		client: logStream,
-	}, nil
+	return resp.GetMachines(), nil
 }
 
-// CreateSchematic using the image factory.
-func (client *Client) CreateSchematic(ctx context.Context, req *management.CreateSchematicRequest) (*management.CreateSchematicResponse, error) {
-	schematic, err := client.conn.CreateSchematic(ctx, req)
+// SimulateAccessPolicy returns the role the identity would get on the cluster with the candidate access policy, without storing the policy.
+//
+// If the policy is nil, the stored access policy is checked.
+func (client *Client) SimulateAccessPolicy(ctx context.Context, identity, clusterName string, policy *specs.AccessPolicySpec) (*management.SimulateAccessPolicyResponse, error) {
+	return client.conn.SimulateAccessPolicy(ctx, &management.SimulateAccessPolicyRequest{
+		Identity:       identity,
+		ClusterName:    clusterName,
+		PolicyOverride: policy,
+	})
+}
+
+// GetExtensionUsage returns the number of machines which have each extension installed.
+func (client *Client) GetExtensionUsage(ctx context.Context) (*management.GetExtensionUsageResponse, error) {
+	return client.conn.GetExtensionUsage(ctx, &emptypb.Empty{})
+}
+
+// WhoAmI returns the identity and the role of the caller.
+//
+// If includeClusterRoles is set, the effective role on each cluster the caller can access is returned as well.
+func (client *Client) WhoAmI(ctx context.Context, includeClusterRoles bool) (*management.WhoAmIResponse, error) {
+	return client.conn.WhoAmI(ctx, &management.WhoAmIRequest{
+		IncludeClusterRoles: includeClusterRoles,
+	})
+}
+
+// GetKubeletStatus returns the kubelet service status and the effective kubelet configuration of the cluster machine.
+func (client *Client) GetKubeletStatus(ctx context.Context, machineID string) (*management.GetKubeletStatusResponse, error) {
+	return client.conn.GetKubeletStatus(ctx, &management.GetKubeletStatusRequest{
+		MachineId: machineID,
+	})
+}
+
+// GetMachineEvents returns the recent machine status events, the newest first.
+//
+// If the limit is zero, all recorded events are returned.
+func (client *Client) GetMachineEvents(ctx context.Context, machineID string, limit int32) ([]*management.GetMachineEventsResponse_Event, error) {
+	resp, err := client.conn.GetMachineEvents(ctx, &management.GetMachineEventsRequest{
+		MachineId: machineID,
+		Limit:     limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return schematic, nil
+	return resp.GetEvents(), nil
 }
 
-// CreateServiceAccount creates a service account and returns the public key ID.
-func (client *Client) CreateServiceAccount(ctx context.Context, armoredPGPPublicKey string, role string, useUserRole bool) (string, error) {
-	resp, err := client.conn.CreateServiceAccount(ctx, &management.CreateServiceAccountRequest{
-		ArmoredPgpPublicKey: armoredPGPPublicKey,
-		Role:                role,
-		UseUserRole:         useUserRole,
+// GetMachineResource returns the Talos resource read from the machine in YAML format.
+func (client *Client) GetMachineResource(ctx context.Context, machineID, namespace, resourceType, id string) (string, error) {
+	resp, err := client.conn.GetMachineResource(ctx, &management.GetMachineResourceRequest{
+		MachineId: machineID,
+		Namespace: namespace,
+		Type:      resourceType,
+		Id:        id,
 	})
 	if err != nil {
 		return "", err
 	}
 
-	return resp.PublicKeyId, nil
+	return resp.GetBody(), nil
 }
 
-// RenewServiceAccount renews a service account and returns the public key ID.
-func (client *Client) RenewServiceAccount(ctx context.Context, name, armoredPGPPublicKey string) (string, error) {
-	resp, err := client.conn.RenewServiceAccount(ctx, &management.RenewServiceAccountRequest{
-		Name:                name,
-		ArmoredPgpPublicKey: armoredPGPPublicKey,
+// ReapplyMachineConfig pushes the machine config generated by Omni to the cluster machine once.
+func (client *Client) ReapplyMachineConfig(ctx context.Context, machineID string) (*management.ReapplyMachineConfigResponse, error) {
+	return client.conn.ReapplyMachineConfig(ctx, &management.ReapplyMachineConfigRequest{
+		MachineId: machineID,
+	})
+}
+
+// GetPendingMachineConfig reports if the config generated by Omni is not applied to the cluster machine yet.
+func (client *Client) GetPendingMachineConfig(ctx context.Context, machineID string) (*management.GetPendingMachineConfigResponse, error) {
+	return client.conn.GetPendingMachineConfig(ctx, &management.GetPendingMachineConfigRequest{
+		MachineId: machineID,
+	})
+}
+
+// GetMachineStatusJSON returns the machine status as the flat JSON document.
+func (client *Client) GetMachineStatusJSON(ctx context.Context, machineID string) ([]byte, error) {
+	resp, err := client.conn.GetMachineStatusJSON(ctx, &management.GetMachineStatusJSONRequest{
+		MachineId: machineID,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return resp.PublicKeyId, nil
+	return []byte(resp.GetBody()), nil
 }
 
-// ListServiceAccounts lists service accounts.
-func (client *Client) ListServiceAccounts(ctx context.Context) ([]*management.ListServiceAccountsResponse_ServiceAccount, error) {
-	response, err := client.conn.ListServiceAccounts(ctx, &emptypb.Empty{})
+// GetInstallDiskSelection returns the disk the machine installs Talos to, and explains how it was picked.
+func (client *Client) GetInstallDiskSelection(ctx context.Context, machineID string) (*management.GetInstallDiskSelectionResponse, error) {
+	return client.conn.GetInstallDiskSelection(ctx, &management.GetInstallDiskSelectionRequest{
+		MachineId: machineID,
+	})
+}
+
+// GetMachineStatusAt returns the machine status snapshot closest to the given time, taken at or before it.
+func (client *Client) GetMachineStatusAt(ctx context.Context, machineID string, timestamp time.Time) (*management.GetMachineStatusAtResponse, error) {
+	return client.conn.GetMachineStatusAt(ctx, &management.GetMachineStatusAtRequest{
+		MachineId: machineID,
+		Timestamp: timestamppb.New(timestamp),
+	})
+}
+
+// GetMachineStatusTimeSeries samples the metric of the machine status history between the given times.
+//
+// The zero to time and resolution are replaced with the server defaults.
+func (client *Client) GetMachineStatusTimeSeries(ctx context.Context, machineID string, metric management.GetMachineStatusTimeSeriesRequest_Metric,
+	from, to time.Time, resolution time.Duration,
+) ([]*management.GetMachineStatusTimeSeriesResponse_Sample, error) {
+	req := &management.GetMachineStatusTimeSeriesRequest{
+		MachineId: machineID,
+		Metric:    metric,
+		From:      timestamppb.New(from),
+	}
+
+	if !to.IsZero() {
+		req.To = timestamppb.New(to)
+	}
+
+	if resolution != 0 {
+		req.Resolution = durationpb.New(resolution)
+	}
+
+	resp, err := client.conn.GetMachineStatusTimeSeries(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.GetServiceAccounts(), nil
+	return resp.GetSamples(), nil
 }
 
-// DestroyServiceAccount deletes a service account.
-func (client *Client) DestroyServiceAccount(ctx context.Context, name string) error {
-	_, err := client.conn.DestroyServiceAccount(ctx, &management.DestroyServiceAccountRequest{
-		Name: name,
+// GetClockDrift returns the difference between the machine clock and the Omni clock.
+//
+// The drift is flagged if it exceeds the threshold, the server default is used if the threshold is zero.
+func (client *Client) GetClockDrift(ctx context.Context, machineID string, threshold time.Duration) (*management.GetClockDriftResponse, error) {
+	req := &management.GetClockDriftRequest{
+		MachineId: machineID,
+	}
+
+	if threshold != 0 {
+		req.Threshold = durationpb.New(threshold)
+	}
+
+	return client.conn.GetClockDrift(ctx, req)
+}
+
+// GetClusterCapacity returns the total hardware capacity of the cluster machines.
+func (client *Client) GetClusterCapacity(ctx context.Context, clusterName string) (*management.GetClusterCapacityResponse, error) {
+	return client.conn.GetClusterCapacity(ctx, &management.GetClusterCapacityRequest{
+		ClusterName: clusterName,
 	})
+}
 
-	return err
+// ValidateClusterComposition checks that the cluster can be created from the machines with the given number of the control plane nodes.
+func (client *Client) ValidateClusterComposition(ctx context.Context, machineIDs []string, controlPlaneCount uint32) (*management.ValidateClusterCompositionResponse, error) {
+	return client.conn.ValidateClusterComposition(ctx, &management.ValidateClusterCompositionRequest{
+		MachineIds:        machineIDs,
+		ControlPlaneCount: controlPlaneCount,
+	})
+}
+
+// ListKubernetesUpgrades returns the past Kubernetes upgrades of the cluster, from the oldest to the newest.
+func (client *Client) ListKubernetesUpgrades(ctx context.Context, clusterName string) ([]*specs.KubernetesUpgradeHistorySpec_Upgrade, error) {
+	resp, err := client.conn.ListKubernetesUpgrades(ctx, &management.ListKubernetesUpgradesRequest{
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetUpgrades(), nil
+}
+
+// ValidateUpgradeImageReachability checks that each cluster machine can pull the installer image of the Talos version.
+func (client *Client) ValidateUpgradeImageReachability(ctx context.Context, clusterName, version string) ([]*management.ValidateUpgradeImageReachabilityResponse_Node, error) {
+	resp, err := client.conn.ValidateUpgradeImageReachability(ctx, &management.ValidateUpgradeImageReachabilityRequest{
+		ClusterName: clusterName,
+		Version:     version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetNodes(), nil
+}
+
+// ExportServiceAccounts exports the service accounts with their public keys.
+func (client *Client) ExportServiceAccounts(ctx context.Context) ([]*management.ServiceAccountExport, error) {
+	resp, err := client.conn.ExportServiceAccounts(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetServiceAccounts(), nil
+}
+
+// ImportServiceAccounts recreates the exported service accounts.
+func (client *Client) ImportServiceAccounts(ctx context.Context, serviceAccounts []*management.ServiceAccountExport) (*management.ImportServiceAccountsResponse, error) {
+	return client.conn.ImportServiceAccounts(ctx, &management.ImportServiceAccountsRequest{
+		ServiceAccounts: serviceAccounts,
+	})
 }
 
 // LogReader is a log client reader which implements io.Reader.
@@ -281,19 +1290,49 @@ func (client *ClusterClient) KubernetesUpgradePreChecks(ctx context.Context, new
 	return fmt.Errorf("%s", resp.GetReason())
 }
 
+// KubernetesSyncManifestOption is a functional option for KubernetesSyncManifests.
+type KubernetesSyncManifestOption func(*management.KubernetesSyncManifestRequest)
+
+// WithIncrementalSync skips the manifests which didn't change since the last successful sync.
+func WithIncrementalSync(incremental bool) KubernetesSyncManifestOption {
+	return func(req *management.KubernetesSyncManifestRequest) {
+		req.IncrementalOnly = incremental
+	}
+}
+
+// WithRetryFailedSync skips the manifests which were applied by the failed syncs since the last successful sync.
+func WithRetryFailedSync(retry bool) KubernetesSyncManifestOption {
+	return func(req *management.KubernetesSyncManifestRequest) {
+		req.RetryFailed = retry
+	}
+}
+
+// WithSyncReason records the reason of the sync along with the identity which triggered it.
+func WithSyncReason(reason string) KubernetesSyncManifestOption {
+	return func(req *management.KubernetesSyncManifestRequest) {
+		req.Reason = reason
+	}
+}
+
 // KubernetesSyncManifestHandler is called for each sync event.
 type KubernetesSyncManifestHandler func(*management.KubernetesSyncManifestResponse) error
 
 // KubernetesSyncManifests syncs the bootstrap Kubernetes manifests.
-func (client *ClusterClient) KubernetesSyncManifests(ctx context.Context, dryRun bool, handler KubernetesSyncManifestHandler) error {
+func (client *ClusterClient) KubernetesSyncManifests(ctx context.Context, dryRun bool, handler KubernetesSyncManifestHandler, opts ...KubernetesSyncManifestOption) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	ctx = metadata.AppendToOutgoingContext(ctx, "context", client.clusterName)
 
-	cli, err := client.client.conn.KubernetesSyncManifests(ctx, &management.KubernetesSyncManifestRequest{
+	req := &management.KubernetesSyncManifestRequest{
 		DryRun: dryRun,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	cli, err := client.client.conn.KubernetesSyncManifests(ctx, req)
 	if err != nil {
 		return err
 	}
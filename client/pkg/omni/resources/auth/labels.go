@@ -21,6 +21,13 @@ const (
 	// LabelIdentityTypeServiceAccount is set when the type of the identity is service account.
 	// tsgen:LabelIdentityTypeServiceAccount
 	LabelIdentityTypeServiceAccount = "type-service-account"
+
+	// LabelIdentityInternal is set on the service accounts created by Omni for the internal use.
+	// tsgen:LabelIdentityInternal
+	LabelIdentityInternal = "internal"
+
+	// LabelClusterScope is set on the identity and the user of the service account which is limited to a single cluster.
+	LabelClusterScope = "cluster-scope"
 )
 
 const (
@@ -5,6 +5,8 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -47,3 +49,16 @@ func (PublicKeyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 		PrintColumns:     []meta.PrintColumn{},
 	}
 }
+
+// PublicKeyValidUntil returns the time until which the public key can be used.
+//
+// The keys retired by the service account renewal stay valid until they are retired, even if they expire earlier.
+func PublicKeyValidUntil(spec *specs.PublicKeySpec) time.Time {
+	validUntil := spec.GetExpiration().AsTime()
+
+	if retireAt := spec.GetRetireAt(); retireAt != nil && retireAt.AsTime().After(validUntil) {
+		return retireAt.AsTime()
+	}
+
+	return validUntil
+}
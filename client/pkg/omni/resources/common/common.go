@@ -21,8 +21,12 @@ var UserManagedResourceTypes = []resource.Type{
 	omni.ClusterType,
 	omni.ConfigPatchType,
 	omni.EtcdManualBackupType,
+	omni.KubernetesUpgradeWindowType,
 	omni.MachineClassType,
+	omni.MachineGroupType,
 	omni.MachineLabelsType,
+	omni.MachineLogRetentionType,
+	omni.MachineReservationType,
 	omni.MachineSetType,
 	omni.MachineSetNodeType,
 	omni.EtcdBackupS3ConfType,
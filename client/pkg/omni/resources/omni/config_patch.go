@@ -6,6 +6,7 @@ package omni
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -15,6 +16,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/pair"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	talosruntime "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/siderolink"
+	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
 	talosrole "github.com/siderolabs/talos/pkg/machinery/role"
 	"gopkg.in/yaml.v3"
 
@@ -42,6 +46,19 @@ var forbiddenSliceElements = map[string]map[any]struct{}{
 	},
 }
 
+// omniManagedDocuments are the config documents which connect the machine to Omni.
+var omniManagedDocuments = []string{
+	siderolink.Kind,
+	talosruntime.EventSinkKind,
+}
+
+// omniManagedKernelArgs are the kernel args which connect the machine to Omni, they are set or removed by the extra kernel args.
+var omniManagedKernelArgs = []string{
+	talosconstants.KernelParamSideroLink,
+	talosconstants.KernelParamEventsSink,
+	talosconstants.KernelParamLoggingKernel,
+}
+
 // NewConfigPatch creates new ConfigPatch resource.
 func NewConfigPatch(ns string, id resource.ID, labels ...pair.Pair[string, string]) *ConfigPatch {
 	res := typed.NewResource[ConfigPatchSpec, ConfigPatchExtension](
@@ -84,8 +101,10 @@ func (ConfigPatchExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 
 // ValidateConfigPatch parses the config patch data using Talos config loader,
 // then validates that the config patch doesn't have fields that are controlled by omni.
+//
+//nolint:gocognit
 func ValidateConfigPatch(data string) error {
-	_, err := configloader.NewFromBytes([]byte(data))
+	cfg, err := configloader.NewFromBytes([]byte(data))
 	if err != nil {
 		return err
 	}
@@ -99,6 +118,31 @@ func ValidateConfigPatch(data string) error {
 
 	var multiErr error
 
+	// the patch overriding the Omni connection settings cuts the machine off from Omni
+	for _, doc := range cfg.Documents() {
+		if slices.Contains(omniManagedDocuments, doc.Kind()) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("overriding the Omni-managed %q document is not allowed in the config patch", doc.Kind()))
+		}
+	}
+
+	if val, ok := getField(config, "machine.install.extraKernelArgs"); ok {
+		if args, ok := val.([]any); ok {
+			for _, arg := range args {
+				argString, ok := arg.(string)
+				if !ok {
+					continue
+				}
+
+				// the kernel args prefixed with "-" are removed
+				key, _, _ := strings.Cut(strings.TrimPrefix(argString, "-"), "=")
+
+				if slices.Contains(omniManagedKernelArgs, key) {
+					multiErr = multierror.Append(multiErr, fmt.Errorf("overriding the Omni-managed kernel argument %q is not allowed in the config patch", key))
+				}
+			}
+		}
+	}
+
 	for _, field := range forbiddenFields {
 		if _, ok := getField(config, field); ok {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("overriding %q is not allowed in the config patch", field))
@@ -69,6 +69,41 @@ machine:
 `),
 			expectedError: "1 error occurred:\n\t* element \"os:admin\" is not allowed in field \"machine.features.kubernetesTalosAPIAccess.allowedRoles\"\n\n",
 		},
+		{
+			name: "siderolink kernel args",
+			config: strings.TrimSpace(`
+machine:
+  install:
+    extraKernelArgs:
+      - console=ttyS0
+      - siderolink.api=https://example.com
+      - -talos.events.sink
+`),
+			expectedError: "2 errors occurred:\n\t* overriding the Omni-managed kernel argument \"siderolink.api\" is not allowed in the config patch\n\t* " +
+				"overriding the Omni-managed kernel argument \"talos.events.sink\" is not allowed in the config patch\n\n",
+		},
+		{
+			name: "siderolink document",
+			config: strings.TrimSpace(`
+machine:
+  network:
+    hostname: abcd
+---
+apiVersion: v1alpha1
+kind: SideroLinkConfig
+apiUrl: https://example.com
+`),
+			expectedError: "1 error occurred:\n\t* overriding the Omni-managed \"SideroLinkConfig\" document is not allowed in the config patch\n\n",
+		},
+		{
+			name: "kmsg log document",
+			config: strings.TrimSpace(`
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote-log
+url: tcp://[fdae:41e4:649b:9303::1]:4001/
+`),
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			err := omni.ValidateConfigPatch(tt.config)
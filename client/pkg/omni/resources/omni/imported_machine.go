@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewImportedMachine creates new ImportedMachine resource.
+func NewImportedMachine(ns resource.Namespace, id resource.ID) *ImportedMachine {
+	return typed.NewResource[ImportedMachineSpec, ImportedMachineExtension](
+		resource.NewMetadata(ns, ImportedMachineType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ImportedMachineSpec{}),
+	)
+}
+
+const (
+	// ImportedMachineType is the type of the ImportedMachine resource.
+	ImportedMachineType = resource.Type("ImportedMachines.omni.sidero.dev")
+)
+
+// ImportedMachine describes the machine imported from the cluster which is not managed by Omni.
+//
+// ImportedMachine has the same ID as the machine, the import name is kept in the LabelExternallyManaged label.
+type ImportedMachine = typed.Resource[ImportedMachineSpec, ImportedMachineExtension]
+
+// ImportedMachineSpec wraps specs.ImportedMachineSpec.
+type ImportedMachineSpec = protobuf.ResourceSpec[specs.ImportedMachineSpec, *specs.ImportedMachineSpec]
+
+// ImportedMachineExtension provides auxiliary methods for ImportedMachine resource.
+type ImportedMachineExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ImportedMachineExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ImportedMachineType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
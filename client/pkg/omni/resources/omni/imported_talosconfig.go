@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewImportedTalosConfig creates new ImportedTalosConfig resource.
+func NewImportedTalosConfig(ns resource.Namespace, id resource.ID) *ImportedTalosConfig {
+	return typed.NewResource[ImportedTalosConfigSpec, ImportedTalosConfigExtension](
+		resource.NewMetadata(ns, ImportedTalosConfigType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.TalosConfigSpec{}),
+	)
+}
+
+const (
+	// ImportedTalosConfigType is the type of the ImportedTalosConfig resource.
+	ImportedTalosConfigType = resource.Type("ImportedTalosConfigs.omni.sidero.dev")
+)
+
+// ImportedTalosConfig keeps the Talos client config the imported machines are accessed with.
+//
+// ImportedTalosConfig ID is the import name.
+type ImportedTalosConfig = typed.Resource[ImportedTalosConfigSpec, ImportedTalosConfigExtension]
+
+// ImportedTalosConfigSpec wraps specs.TalosConfigSpec.
+type ImportedTalosConfigSpec = protobuf.ResourceSpec[specs.TalosConfigSpec, *specs.TalosConfigSpec]
+
+// ImportedTalosConfigExtension provides auxiliary methods for ImportedTalosConfig resource.
+type ImportedTalosConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ImportedTalosConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ImportedTalosConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+// ImportedTalosConfigIDSuffix is appended to the import name to get the ID of the TalosConfig of the imported machines.
+//
+// The cluster names can't have the suffix, so the ID never collides with the TalosConfig of a cluster.
+const ImportedTalosConfigIDSuffix = ".imported"
+
+// ImportedTalosConfigID returns the ID of the TalosConfig the machines imported under the given name are accessed with.
+func ImportedTalosConfigID(name string) resource.ID {
+	return name + ImportedTalosConfigIDSuffix
+}
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewKubernetesManifestSyncStatus creates new KubernetesManifestSyncStatus resource.
+func NewKubernetesManifestSyncStatus(ns, id string) *KubernetesManifestSyncStatus {
+	return typed.NewResource[KubernetesManifestSyncStatusSpec, KubernetesManifestSyncStatusExtension](
+		resource.NewMetadata(ns, KubernetesManifestSyncStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.KubernetesManifestSyncStatusSpec{}),
+	)
+}
+
+// KubernetesManifestSyncStatusType is the type of the KubernetesManifestSyncStatus resource.
+//
+// tsgen:KubernetesManifestSyncStatusType
+const KubernetesManifestSyncStatusType = resource.Type("KubernetesManifestSyncStatuses.omni.sidero.dev")
+
+// KubernetesManifestSyncStatus keeps the manifest hashes of the last successful manifest sync of the cluster.
+//
+// KubernetesManifestSyncStatus has the same ID as the cluster.
+type KubernetesManifestSyncStatus = typed.Resource[KubernetesManifestSyncStatusSpec, KubernetesManifestSyncStatusExtension]
+
+// KubernetesManifestSyncStatusSpec wraps specs.KubernetesManifestSyncStatusSpec.
+type KubernetesManifestSyncStatusSpec = protobuf.ResourceSpec[specs.KubernetesManifestSyncStatusSpec, *specs.KubernetesManifestSyncStatusSpec]
+
+// KubernetesManifestSyncStatusExtension provides auxiliary methods for KubernetesManifestSyncStatus resource.
+type KubernetesManifestSyncStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KubernetesManifestSyncStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesManifestSyncStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
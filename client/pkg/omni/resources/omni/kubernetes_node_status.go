@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewKubernetesNodeStatus creates new Kubernetes node status of the cluster machine.
+func NewKubernetesNodeStatus(ns string, id resource.ID) *KubernetesNodeStatus {
+	return typed.NewResource[KubernetesNodeStatusSpec, KubernetesNodeStatusExtension](
+		resource.NewMetadata(ns, KubernetesNodeStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.KubernetesNodeStatusSpec{}),
+	)
+}
+
+// KubernetesNodeStatusType is a resource type that contains the state of the Kubernetes node of the cluster machine.
+//
+// tsgen:KubernetesNodeStatusType
+const KubernetesNodeStatusType = resource.Type("KubernetesNodeStatuses.omni.sidero.dev")
+
+// KubernetesNodeStatus is a resource type that contains the state of the Kubernetes node of the cluster machine.
+//
+// It is split from KubernetesStatus per machine, so that the machine status is updated only when the state of its node changes.
+type KubernetesNodeStatus = typed.Resource[KubernetesNodeStatusSpec, KubernetesNodeStatusExtension]
+
+// KubernetesNodeStatusSpec wraps specs.KubernetesNodeStatusSpec.
+type KubernetesNodeStatusSpec = protobuf.ResourceSpec[specs.KubernetesNodeStatusSpec, *specs.KubernetesNodeStatusSpec]
+
+// KubernetesNodeStatusExtension provides auxiliary methods for KubernetesNodeStatus resource.
+type KubernetesNodeStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KubernetesNodeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesNodeStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewKubernetesUpgradeHistory creates new KubernetesUpgradeHistory resource.
+func NewKubernetesUpgradeHistory(ns, id string) *KubernetesUpgradeHistory {
+	return typed.NewResource[KubernetesUpgradeHistorySpec, KubernetesUpgradeHistoryExtension](
+		resource.NewMetadata(ns, KubernetesUpgradeHistoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.KubernetesUpgradeHistorySpec{}),
+	)
+}
+
+// KubernetesUpgradeHistoryType is the type of the KubernetesUpgradeHistory resource.
+//
+// tsgen:KubernetesUpgradeHistoryType
+const KubernetesUpgradeHistoryType = resource.Type("KubernetesUpgradeHistories.omni.sidero.dev")
+
+// KubernetesUpgradeHistory keeps the past Kubernetes upgrades of the cluster.
+//
+// KubernetesUpgradeHistory has the same ID as the cluster.
+type KubernetesUpgradeHistory = typed.Resource[KubernetesUpgradeHistorySpec, KubernetesUpgradeHistoryExtension]
+
+// KubernetesUpgradeHistorySpec wraps specs.KubernetesUpgradeHistorySpec.
+type KubernetesUpgradeHistorySpec = protobuf.ResourceSpec[specs.KubernetesUpgradeHistorySpec, *specs.KubernetesUpgradeHistorySpec]
+
+// KubernetesUpgradeHistoryExtension provides auxiliary methods for KubernetesUpgradeHistory resource.
+type KubernetesUpgradeHistoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KubernetesUpgradeHistoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesUpgradeHistoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
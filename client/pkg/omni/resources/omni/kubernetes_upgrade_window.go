@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewKubernetesUpgradeWindow creates new KubernetesUpgradeWindow resource.
+func NewKubernetesUpgradeWindow(ns, id string) *KubernetesUpgradeWindow {
+	return typed.NewResource[KubernetesUpgradeWindowSpec, KubernetesUpgradeWindowExtension](
+		resource.NewMetadata(ns, KubernetesUpgradeWindowType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.KubernetesUpgradeWindowSpec{}),
+	)
+}
+
+// KubernetesUpgradeWindowType is the type of the KubernetesUpgradeWindow resource.
+//
+// tsgen:KubernetesUpgradeWindowType
+const KubernetesUpgradeWindowType = resource.Type("KubernetesUpgradeWindows.omni.sidero.dev")
+
+// KubernetesUpgradeWindow describes the time window the Kubernetes upgrades of the cluster are allowed in.
+//
+// KubernetesUpgradeWindow has the same ID as the cluster.
+type KubernetesUpgradeWindow = typed.Resource[KubernetesUpgradeWindowSpec, KubernetesUpgradeWindowExtension]
+
+// KubernetesUpgradeWindowSpec wraps specs.KubernetesUpgradeWindowSpec.
+type KubernetesUpgradeWindowSpec = protobuf.ResourceSpec[specs.KubernetesUpgradeWindowSpec, *specs.KubernetesUpgradeWindowSpec]
+
+// KubernetesUpgradeWindowExtension provides auxiliary methods for KubernetesUpgradeWindow resource.
+type KubernetesUpgradeWindowExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KubernetesUpgradeWindowExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KubernetesUpgradeWindowType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+// kubernetesUpgradeWindowStartLayout is the layout of the upgrade window start time.
+const kubernetesUpgradeWindowStartLayout = "15:04"
+
+// ValidateKubernetesUpgradeWindow checks that the upgrade window spec is well-formed.
+func ValidateKubernetesUpgradeWindow(spec *specs.KubernetesUpgradeWindowSpec) error {
+	if _, err := time.Parse(kubernetesUpgradeWindowStartLayout, spec.GetStart()); err != nil {
+		return fmt.Errorf("invalid window start %q, expected HH:MM format", spec.GetStart())
+	}
+
+	duration := spec.GetDuration().AsDuration()
+	if duration <= 0 || duration > 24*time.Hour {
+		return fmt.Errorf("window duration should be positive and not exceed 24h, got %s", duration)
+	}
+
+	for _, day := range spec.GetDays() {
+		if day > uint32(time.Saturday) {
+			return fmt.Errorf("invalid day of the week %d, expected 0 (Sunday) to 6 (Saturday)", day)
+		}
+	}
+
+	return nil
+}
+
+// KubernetesUpgradeWindowOpen checks if the upgrade window is open at the given time.
+//
+// If the window is closed, the returned duration is the time left until it opens.
+func KubernetesUpgradeWindowOpen(spec *specs.KubernetesUpgradeWindowSpec, now time.Time) (bool, time.Duration, error) {
+	if err := ValidateKubernetesUpgradeWindow(spec); err != nil {
+		return false, 0, err
+	}
+
+	start, _ := time.Parse(kubernetesUpgradeWindowStartLayout, spec.GetStart()) //nolint:errcheck
+	duration := spec.GetDuration().AsDuration()
+
+	allowedDays := map[time.Weekday]struct{}{}
+
+	for _, day := range spec.GetDays() {
+		allowedDays[time.Weekday(day)] = struct{}{}
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+
+	// the window which opened yesterday might still be open, the next one opens at most in a week
+	for offset := -1; offset <= 7; offset++ {
+		opensAt := today.AddDate(0, 0, offset)
+
+		if _, ok := allowedDays[opensAt.Weekday()]; len(allowedDays) > 0 && !ok {
+			continue
+		}
+
+		if !now.Before(opensAt) && now.Before(opensAt.Add(duration)) {
+			return true, 0, nil
+		}
+
+		if opensAt.After(now) {
+			return false, opensAt.Sub(now), nil
+		}
+	}
+
+	return false, 0, fmt.Errorf("failed to find the next window opening")
+}
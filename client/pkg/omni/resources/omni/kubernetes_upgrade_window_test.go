@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestKubernetesUpgradeWindowOpen(t *testing.T) {
+	t.Parallel()
+
+	// 2024-05-01 is Wednesday
+	wednesday := func(hour, minute int) time.Time {
+		return time.Date(2024, time.May, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, test := range []struct { //nolint:govet
+		name        string
+		spec        *specs.KubernetesUpgradeWindowSpec
+		now         time.Time
+		wantOpen    bool
+		wantOpensIn time.Duration
+	}{
+		{
+			name: "every day open",
+			spec: &specs.KubernetesUpgradeWindowSpec{
+				Start:    "01:00",
+				Duration: durationpb.New(4 * time.Hour),
+			},
+			now:      wednesday(3, 0),
+			wantOpen: true,
+		},
+		{
+			name: "every day closed",
+			spec: &specs.KubernetesUpgradeWindowSpec{
+				Start:    "01:00",
+				Duration: durationpb.New(4 * time.Hour),
+			},
+			now:         wednesday(5, 0),
+			wantOpensIn: 20 * time.Hour,
+		},
+		{
+			name: "open since the previous day",
+			spec: &specs.KubernetesUpgradeWindowSpec{
+				Start:    "22:00",
+				Duration: durationpb.New(4 * time.Hour),
+				Days:     []uint32{uint32(time.Tuesday)},
+			},
+			now:      wednesday(1, 30),
+			wantOpen: true,
+		},
+		{
+			name: "next week",
+			spec: &specs.KubernetesUpgradeWindowSpec{
+				Start:    "02:00",
+				Duration: durationpb.New(time.Hour),
+				Days:     []uint32{uint32(time.Wednesday)},
+			},
+			now:         wednesday(3, 0),
+			wantOpensIn: 7*24*time.Hour - time.Hour,
+		},
+		{
+			name: "weekend",
+			spec: &specs.KubernetesUpgradeWindowSpec{
+				Start:    "00:00",
+				Duration: durationpb.New(24 * time.Hour),
+				Days:     []uint32{uint32(time.Saturday), uint32(time.Sunday)},
+			},
+			now:         wednesday(12, 0),
+			wantOpensIn: 2*24*time.Hour + 12*time.Hour,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, opensIn, err := omni.KubernetesUpgradeWindowOpen(test.spec, test.now)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantOpen, open)
+			assert.Equal(t, test.wantOpensIn, opensIn)
+		})
+	}
+}
+
+func TestValidateKubernetesUpgradeWindow(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []*specs.KubernetesUpgradeWindowSpec{
+		{Start: "25:00", Duration: durationpb.New(time.Hour)},
+		{Start: "01:00"},
+		{Start: "01:00", Duration: durationpb.New(25 * time.Hour)},
+		{Start: "01:00", Duration: durationpb.New(time.Hour), Days: []uint32{7}},
+	} {
+		assert.Error(t, omni.ValidateKubernetesUpgradeWindow(spec), "spec %v", spec)
+	}
+
+	assert.NoError(t, omni.ValidateKubernetesUpgradeWindow(&specs.KubernetesUpgradeWindowSpec{Start: "23:30", Duration: durationpb.New(time.Hour)}))
+}
@@ -53,6 +53,15 @@ const (
 	// LabelExposedServiceAlias is the alias of the exposed service.
 	// tsgen:LabelExposedServiceAlias
 	LabelExposedServiceAlias = SystemLabelPrefix + "exposed-service-alias"
+
+	// LabelExternallyManaged marks the machine as imported from the cluster which is not managed by Omni.
+	// The value is the ID of the TalosConfig used to access the machine.
+	// tsgen:LabelExternallyManaged
+	LabelExternallyManaged = SystemLabelPrefix + "externally-managed"
+
+	// LabelReserved marks the machine status of the machine reserved with the MachineReservation, so it is not allocated to the clusters.
+	// tsgen:LabelReserved
+	LabelReserved = SystemLabelPrefix + "reserved"
 )
 
 const (
@@ -77,6 +86,10 @@ const (
 	// tsgen:MachineStatusLabelDisconnected
 	MachineStatusLabelDisconnected = SystemLabelPrefix + "disconnected"
 
+	// MachineStatusLabelLongDisconnected is set if the machine is disconnected for longer than the configured threshold.
+	// tsgen:MachineStatusLabelLongDisconnected
+	MachineStatusLabelLongDisconnected = SystemLabelPrefix + "long-disconnected"
+
 	// MachineStatusLabelInvalidState is set if Omni can access Talos apid, but has no access.
 	// tsgen:MachineStatusLabelInvalidState
 	MachineStatusLabelInvalidState = SystemLabelPrefix + "invalid-state"
@@ -128,6 +141,33 @@ const (
 	// MachineStatusLabelInstance describes the machine instance type (for machines running in the clouds).
 	// tsgen:MachineStatusLabelInstance
 	MachineStatusLabelInstance = SystemLabelPrefix + "instance"
+
+	// MachineStatusLabelInstanceID describes the machine instance ID (for machines running in the clouds).
+	// tsgen:MachineStatusLabelInstanceID
+	MachineStatusLabelInstanceID = SystemLabelPrefix + "instance-id"
+
+	// MachineStatusLabelProviderID describes the machine provider ID used by the Kubernetes Node (for machines running in the clouds).
+	// tsgen:MachineStatusLabelProviderID
+	MachineStatusLabelProviderID = SystemLabelPrefix + "provider-id"
+
+	// MachineStatusLabelSpot is set on the spot instances (for machines running in the clouds).
+	// tsgen:MachineStatusLabelSpot
+	MachineStatusLabelSpot = SystemLabelPrefix + "spot"
+
+	// MachineStatusLabelUnencryptedVolume is set to the comma separated list of the system volumes which are not encrypted.
+	// It is not set if the machine doesn't report the volume encryption status.
+	// tsgen:MachineStatusLabelUnencryptedVolume
+	MachineStatusLabelUnencryptedVolume = SystemLabelPrefix + "unencrypted-volume"
+
+	// MachineStatusLabelLinkDown is set to the comma separated list of the physical links which are administratively up,
+	// but have no carrier for longer than the debounce interval.
+	// tsgen:MachineStatusLabelLinkDown
+	MachineStatusLabelLinkDown = SystemLabelPrefix + "link-down"
+
+	// MachineStatusLabelKubernetesNodeReady is set to "true" or "false" depending on the Kubernetes node Ready condition.
+	// It is not set if the machine is not in a cluster or its node is not known to the Kubernetes API yet.
+	// tsgen:MachineStatusLabelKubernetesNodeReady
+	MachineStatusLabelKubernetesNodeReady = SystemLabelPrefix + "kubernetes-node-ready"
 )
 
 const (
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineGroup creates new MachineGroup resource.
+func NewMachineGroup(ns string, id resource.ID) *MachineGroup {
+	return typed.NewResource[MachineGroupSpec, MachineGroupExtension](
+		resource.NewMetadata(ns, MachineGroupType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineGroupSpec{}),
+	)
+}
+
+const (
+	// MachineGroupType is the type of the MachineGroup resource.
+	// tsgen:MachineGroupType
+	MachineGroupType = resource.Type("MachineGroupes.omni.sidero.dev")
+)
+
+// MachineGroup describes a named group of machines selected by labels.
+type MachineGroup = typed.Resource[MachineGroupSpec, MachineGroupExtension]
+
+// MachineGroupSpec wraps specs.MachineGroupSpec.
+type MachineGroupSpec = protobuf.ResourceSpec[specs.MachineGroupSpec, *specs.MachineGroupSpec]
+
+// MachineGroupExtension provides auxiliary methods for MachineGroup resource.
+type MachineGroupExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineGroupExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineGroupType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineLogRetention creates new MachineLogRetention resource.
+func NewMachineLogRetention(ns string, id resource.ID) *MachineLogRetention {
+	return typed.NewResource[MachineLogRetentionSpec, MachineLogRetentionExtension](
+		resource.NewMetadata(ns, MachineLogRetentionType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineLogRetentionSpec{}),
+	)
+}
+
+const (
+	// MachineLogRetentionType is the type of the MachineLogRetention resource.
+	// tsgen:MachineLogRetentionType
+	MachineLogRetentionType = resource.Type("MachineLogRetentions.omni.sidero.dev")
+)
+
+// MachineLogRetention overrides the size of the log buffer kept for the machine with the same ID.
+type MachineLogRetention = typed.Resource[MachineLogRetentionSpec, MachineLogRetentionExtension]
+
+// MachineLogRetentionSpec wraps specs.MachineLogRetentionSpec.
+type MachineLogRetentionSpec = protobuf.ResourceSpec[specs.MachineLogRetentionSpec, *specs.MachineLogRetentionSpec]
+
+// MachineLogRetentionExtension provides auxiliary methods for MachineLogRetention resource.
+type MachineLogRetentionExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineLogRetentionExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineLogRetentionType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Max Size",
+				JSONPath: "{.maxsizebytes}",
+			},
+		},
+	}
+}
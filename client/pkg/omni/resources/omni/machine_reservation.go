@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineReservation creates new MachineReservation resource.
+func NewMachineReservation(ns string, id resource.ID) *MachineReservation {
+	return typed.NewResource[MachineReservationSpec, MachineReservationExtension](
+		resource.NewMetadata(ns, MachineReservationType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineReservationSpec{}),
+	)
+}
+
+const (
+	// MachineReservationType is the type of the MachineReservation resource.
+	// tsgen:MachineReservationType
+	MachineReservationType = resource.Type("MachineReservations.omni.sidero.dev")
+)
+
+// MachineReservation reserves the machine with the same ID, so it is not allocated to the clusters.
+type MachineReservation = typed.Resource[MachineReservationSpec, MachineReservationExtension]
+
+// MachineReservationSpec wraps specs.MachineReservationSpec.
+type MachineReservationSpec = protobuf.ResourceSpec[specs.MachineReservationSpec, *specs.MachineReservationSpec]
+
+// MachineReservationExtension provides auxiliary methods for MachineReservation resource.
+type MachineReservationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineReservationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineReservationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
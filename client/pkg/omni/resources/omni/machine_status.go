@@ -6,13 +6,16 @@ package omni
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -104,6 +107,8 @@ func setLabel(labels *resource.Labels, key string, valueFunc func() string) {
 }
 
 // MachineStatusReconcileLabels builds a set of labels based on hardware/meta information.
+//
+// The default platform metadata fields are projected as the labels, use MachineStatusReconcilePlatformLabels to select the other ones.
 func MachineStatusReconcileLabels(machineStatus *MachineStatus) {
 	labels := machineStatus.Metadata().Labels()
 
@@ -193,23 +198,122 @@ func MachineStatusReconcileLabels(machineStatus *MachineStatus) {
 		}
 	})
 
-	setLabel(labels, MachineStatusLabelPlatform, func() string {
-		return machineStatus.TypedSpec().Value.PlatformMetadata.GetPlatform()
-	})
+	setLabel(labels, MachineStatusLabelUnencryptedVolume, func() string {
+		var unencrypted []string
 
-	setLabel(labels, MachineStatusLabelRegion, func() string {
-		return machineStatus.TypedSpec().Value.PlatformMetadata.GetRegion()
-	})
+		for _, volume := range machineStatus.TypedSpec().Value.GetEncryptionStatus().GetVolumes() {
+			if !volume.GetEncrypted() {
+				unencrypted = append(unencrypted, volume.GetName())
+			}
+		}
 
-	setLabel(labels, MachineStatusLabelZone, func() string {
-		return machineStatus.TypedSpec().Value.PlatformMetadata.GetZone()
+		return strings.Join(unencrypted, ",")
 	})
 
-	setLabel(labels, MachineStatusLabelInstance, func() string {
-		return machineStatus.TypedSpec().Value.PlatformMetadata.GetInstanceType()
+	MachineStatusReconcilePlatformLabels(machineStatus, DefaultPlatformLabelFields)
+}
+
+// MachineStatusReconcileLinkDownLabel lists the physical links which are expected to be up, but are down for longer than the debounce interval.
+//
+// The administratively down links are not expected to be up, so they are never listed.
+func MachineStatusReconcileLinkDownLabel(machineStatus *MachineStatus, now time.Time, debounce time.Duration) {
+	setLabel(machineStatus.Metadata().Labels(), MachineStatusLabelLinkDown, func() string {
+		var down []string
+
+		for _, link := range machineStatus.TypedSpec().Value.GetNetwork().GetNetworkLinks() {
+			if !link.GetAdminUp() || link.GetLinkUp() || link.GetDownSince() == nil {
+				continue
+			}
+
+			if now.Sub(link.GetDownSince().AsTime()) >= debounce {
+				down = append(down, link.GetLinuxName())
+			}
+		}
+
+		slices.Sort(down)
+
+		return strings.Join(down, ",")
 	})
 }
 
+// MachineStatusReconcileLongDisconnected updates the disconnect duration of the machine,
+// and labels the machine if it is disconnected for at least the threshold.
+//
+// The zero threshold disables the label.
+func MachineStatusReconcileLongDisconnected(machineStatus *MachineStatus, now time.Time, threshold time.Duration) {
+	spec := machineStatus.TypedSpec().Value
+
+	if spec.GetConnected() || spec.GetConnectivityChangedAt() == nil {
+		spec.DisconnectDuration = nil
+
+		machineStatus.Metadata().Labels().Delete(MachineStatusLabelLongDisconnected)
+
+		return
+	}
+
+	disconnectDuration := now.Sub(spec.GetConnectivityChangedAt().AsTime())
+
+	// the duration is rounded to avoid updating the machine status on every reconcile
+	spec.DisconnectDuration = durationpb.New(disconnectDuration.Truncate(time.Minute))
+
+	if threshold > 0 && disconnectDuration >= threshold {
+		machineStatus.Metadata().Labels().Set(MachineStatusLabelLongDisconnected, "")
+	} else {
+		machineStatus.Metadata().Labels().Delete(MachineStatusLabelLongDisconnected)
+	}
+}
+
+// PlatformLabelFields maps the platform metadata fields which can be projected as the machine status labels to the label keys.
+var PlatformLabelFields = map[string]string{
+	"platform":      MachineStatusLabelPlatform,
+	"region":        MachineStatusLabelRegion,
+	"zone":          MachineStatusLabelZone,
+	"instance_type": MachineStatusLabelInstance,
+	"instance_id":   MachineStatusLabelInstanceID,
+	"provider_id":   MachineStatusLabelProviderID,
+	"spot":          MachineStatusLabelSpot,
+}
+
+// DefaultPlatformLabelFields are the platform metadata fields projected as the machine status labels by default.
+var DefaultPlatformLabelFields = []string{"platform", "region", "zone", "instance_type"}
+
+// MachineStatusReconcilePlatformLabels projects the given platform metadata fields as the machine status labels.
+//
+// The labels of the fields which are not in the list are removed.
+func MachineStatusReconcilePlatformLabels(machineStatus *MachineStatus, fields []string) {
+	labels := machineStatus.Metadata().Labels()
+	platformMetadata := machineStatus.TypedSpec().Value.GetPlatformMetadata()
+
+	for field, key := range PlatformLabelFields {
+		setLabel(labels, key, func() string {
+			if !slices.Contains(fields, field) {
+				return ""
+			}
+
+			switch field {
+			case "platform":
+				return platformMetadata.GetPlatform()
+			case "region":
+				return platformMetadata.GetRegion()
+			case "zone":
+				return platformMetadata.GetZone()
+			case "instance_type":
+				return platformMetadata.GetInstanceType()
+			case "instance_id":
+				return platformMetadata.GetInstanceId()
+			case "provider_id":
+				return platformMetadata.GetProviderId()
+			case "spot":
+				if platformMetadata.GetSpot() {
+					return "true"
+				}
+			}
+
+			return ""
+		})
+	}
+}
+
 // GetMachineStatusSystemDisk looks up a system disk for the Talos machine.
 func GetMachineStatusSystemDisk(res *MachineStatus) string {
 	for _, disk := range res.TypedSpec().Value.Hardware.Blockdevices {
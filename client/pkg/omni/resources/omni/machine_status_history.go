@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineStatusHistory creates new MachineStatusHistory resource.
+func NewMachineStatusHistory(ns, id string) *MachineStatusHistory {
+	return typed.NewResource[MachineStatusHistorySpec, MachineStatusHistoryExtension](
+		resource.NewMetadata(ns, MachineStatusHistoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineStatusHistorySpec{}),
+	)
+}
+
+// MachineStatusHistoryType is the type of the MachineStatusHistory resource.
+//
+// tsgen:MachineStatusHistoryType
+const MachineStatusHistoryType = resource.Type("MachineStatusHistories.omni.sidero.dev")
+
+// MachineStatusHistory keeps the past snapshots of the machine status.
+//
+// MachineStatusHistory has the same ID as the machine.
+type MachineStatusHistory = typed.Resource[MachineStatusHistorySpec, MachineStatusHistoryExtension]
+
+// MachineStatusHistorySpec wraps specs.MachineStatusHistorySpec.
+type MachineStatusHistorySpec = protobuf.ResourceSpec[specs.MachineStatusHistorySpec, *specs.MachineStatusHistorySpec]
+
+// MachineStatusHistoryExtension provides auxiliary methods for MachineStatusHistory resource.
+type MachineStatusHistoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineStatusHistoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineStatusHistoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -6,9 +6,11 @@ package omni_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource/typed"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -125,6 +127,45 @@ func TestMachineStatusReconcileLabels(t *testing.T) {
 				omni.MachineStatusLabelNet:   "1Gbps",
 			},
 		},
+		{
+			name: "encrypted",
+			spec: &specs.MachineStatusSpec{
+				EncryptionStatus: &specs.MachineStatusSpec_EncryptionStatus{
+					Volumes: []*specs.MachineStatusSpec_EncryptionStatus_Volume{
+						{
+							Name:      "EPHEMERAL",
+							Encrypted: true,
+							Providers: []string{"luks2"},
+						},
+						{
+							Name:      "STATE",
+							Encrypted: true,
+							Providers: []string{"luks2"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unencrypted",
+			spec: &specs.MachineStatusSpec{
+				EncryptionStatus: &specs.MachineStatusSpec_EncryptionStatus{
+					Volumes: []*specs.MachineStatusSpec_EncryptionStatus_Volume{
+						{
+							Name: "EPHEMERAL",
+						},
+						{
+							Name:      "STATE",
+							Encrypted: true,
+							Providers: []string{"luks2"},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				omni.MachineStatusLabelUnencryptedVolume: "EPHEMERAL",
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
@@ -140,6 +181,136 @@ func TestMachineStatusReconcileLabels(t *testing.T) {
 	}
 }
 
+func TestMachineStatusReconcilePlatformLabels(t *testing.T) {
+	t.Parallel()
+
+	ms := omni.NewMachineStatus("", "")
+	ms.TypedSpec().Value = &specs.MachineStatusSpec{
+		PlatformMetadata: &specs.MachineStatusSpec_PlatformMetadata{
+			Platform:     "aws",
+			Region:       "us-east-1",
+			Zone:         "us-east-1a",
+			InstanceType: "m5.large",
+			InstanceId:   "i-0123456789",
+			Spot:         true,
+		},
+	}
+
+	omni.MachineStatusReconcileLabels(ms)
+
+	assert.Equal(t, map[string]string{
+		omni.MachineStatusLabelPlatform: "aws",
+		omni.MachineStatusLabelRegion:   "us-east-1",
+		omni.MachineStatusLabelZone:     "us-east-1a",
+		omni.MachineStatusLabelInstance: "m5.large",
+	}, ms.Metadata().Labels().Raw())
+
+	omni.MachineStatusReconcilePlatformLabels(ms, []string{"zone", "instance_id", "provider_id", "spot"})
+
+	assert.Equal(t, map[string]string{
+		omni.MachineStatusLabelZone:       "us-east-1a",
+		omni.MachineStatusLabelInstanceID: "i-0123456789",
+		omni.MachineStatusLabelSpot:       "true",
+	}, ms.Metadata().Labels().Raw())
+
+	ms.TypedSpec().Value.PlatformMetadata.Zone = "us-east-1b"
+	ms.TypedSpec().Value.PlatformMetadata.Spot = false
+
+	omni.MachineStatusReconcilePlatformLabels(ms, []string{"zone", "instance_id", "provider_id", "spot"})
+
+	assert.Equal(t, map[string]string{
+		omni.MachineStatusLabelZone:       "us-east-1b",
+		omni.MachineStatusLabelInstanceID: "i-0123456789",
+	}, ms.Metadata().Labels().Raw())
+
+	// the platform is projected by default, and the label follows the platform changes
+	ms.TypedSpec().Value.PlatformMetadata.Platform = "metal"
+
+	omni.MachineStatusReconcilePlatformLabels(ms, omni.DefaultPlatformLabelFields)
+
+	assert.Equal(t, map[string]string{
+		omni.MachineStatusLabelPlatform: "metal",
+		omni.MachineStatusLabelRegion:   "us-east-1",
+		omni.MachineStatusLabelZone:     "us-east-1b",
+		omni.MachineStatusLabelInstance: "m5.large",
+	}, ms.Metadata().Labels().Raw())
+
+	ms.TypedSpec().Value.PlatformMetadata = nil
+
+	omni.MachineStatusReconcilePlatformLabels(ms, omni.DefaultPlatformLabelFields)
+
+	assert.Empty(t, ms.Metadata().Labels().Raw())
+}
+
+func TestMachineStatusReconcileLinkDownLabel(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	ms := omni.NewMachineStatus("", "")
+	ms.TypedSpec().Value = &specs.MachineStatusSpec{
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+				{LinuxName: "eth2", AdminUp: true, DownSince: timestamppb.New(now.Add(-time.Hour))},
+				{LinuxName: "eth0", AdminUp: true, LinkUp: true},
+				{LinuxName: "eth1", AdminUp: true, DownSince: timestamppb.New(now.Add(-10 * time.Minute))},
+				{LinuxName: "eth3", AdminUp: true, DownSince: timestamppb.New(now.Add(-time.Second))},
+				{LinuxName: "eth4"},
+			},
+		},
+	}
+
+	omni.MachineStatusReconcileLinkDownLabel(ms, now, time.Minute)
+
+	down, ok := ms.Metadata().Labels().Get(omni.MachineStatusLabelLinkDown)
+	assert.True(t, ok)
+	assert.Equal(t, "eth1,eth2", down)
+
+	ms.TypedSpec().Value.Network.NetworkLinks = ms.TypedSpec().Value.Network.NetworkLinks[1:2]
+
+	omni.MachineStatusReconcileLinkDownLabel(ms, now, time.Minute)
+
+	_, ok = ms.Metadata().Labels().Get(omni.MachineStatusLabelLinkDown)
+	assert.False(t, ok)
+}
+
+func TestMachineStatusReconcileLongDisconnected(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	ms := omni.NewMachineStatus("", "")
+	ms.TypedSpec().Value = &specs.MachineStatusSpec{
+		ConnectivityChangedAt: timestamppb.New(now.Add(-90 * time.Second)),
+	}
+
+	omni.MachineStatusReconcileLongDisconnected(ms, now, time.Hour)
+
+	_, ok := ms.Metadata().Labels().Get(omni.MachineStatusLabelLongDisconnected)
+	assert.False(t, ok)
+	assert.Equal(t, time.Minute, ms.TypedSpec().Value.DisconnectDuration.AsDuration())
+
+	omni.MachineStatusReconcileLongDisconnected(ms, now.Add(time.Hour), time.Hour)
+
+	_, ok = ms.Metadata().Labels().Get(omni.MachineStatusLabelLongDisconnected)
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour+time.Minute, ms.TypedSpec().Value.DisconnectDuration.AsDuration())
+
+	// the zero threshold disables the label
+	omni.MachineStatusReconcileLongDisconnected(ms, now.Add(time.Hour), 0)
+
+	_, ok = ms.Metadata().Labels().Get(omni.MachineStatusLabelLongDisconnected)
+	assert.False(t, ok)
+
+	ms.TypedSpec().Value.Connected = true
+
+	omni.MachineStatusReconcileLongDisconnected(ms, now.Add(time.Hour), time.Hour)
+
+	_, ok = ms.Metadata().Labels().Get(omni.MachineStatusLabelLongDisconnected)
+	assert.False(t, ok)
+	assert.Nil(t, ms.TypedSpec().Value.DisconnectDuration)
+}
+
 func TestLookup(t *testing.T) {
 	ms := omni.NewMachineStatus("", "")
 	ms.TypedSpec().Value = &specs.MachineStatusSpec{
@@ -39,16 +39,25 @@ func init() {
 	registry.MustRegisterResource(FeaturesConfigType, &FeaturesConfig{})
 	registry.MustRegisterResource(ImagePullRequestType, &ImagePullRequest{})
 	registry.MustRegisterResource(ImagePullStatusType, &ImagePullStatus{})
+	registry.MustRegisterResource(ImportedMachineType, &ImportedMachine{})
+	registry.MustRegisterResource(ImportedTalosConfigType, &ImportedTalosConfig{})
 	registry.MustRegisterResource(InstallationMediaType, &InstallationMedia{})
 	registry.MustRegisterResource(ControlPlaneStatusType, &ControlPlaneStatus{})
 	registry.MustRegisterResource(KubeconfigType, &Kubeconfig{})
+	registry.MustRegisterResource(KubernetesManifestSyncStatusType, &KubernetesManifestSyncStatus{})
+	registry.MustRegisterResource(KubernetesNodeStatusType, &KubernetesNodeStatus{})
 	registry.MustRegisterResource(KubernetesStatusType, &KubernetesStatus{})
 	registry.MustRegisterResource(KubernetesUpgradeManifestStatusType, &KubernetesUpgradeManifestStatus{})
 	registry.MustRegisterResource(KubernetesUpgradeStatusType, &KubernetesUpgradeStatus{})
+	registry.MustRegisterResource(KubernetesUpgradeHistoryType, &KubernetesUpgradeHistory{})
+	registry.MustRegisterResource(KubernetesUpgradeWindowType, &KubernetesUpgradeWindow{})
 	registry.MustRegisterResource(KubernetesVersionType, &KubernetesVersion{})
 	registry.MustRegisterResource(MachineLabelsType, &MachineLabels{})
+	registry.MustRegisterResource(MachineLogRetentionType, &MachineLogRetention{})
+	registry.MustRegisterResource(MachineReservationType, &MachineReservation{})
 	registry.MustRegisterResource(MachineType, &Machine{})
 	registry.MustRegisterResource(MachineClassType, &MachineClass{})
+	registry.MustRegisterResource(MachineGroupType, &MachineGroup{})
 	registry.MustRegisterResource(MachineConfigGenOptionsType, &MachineConfigGenOptions{})
 	registry.MustRegisterResource(MachineSetType, &MachineSet{})
 	registry.MustRegisterResource(MachineSetDestroyStatusType, &MachineSetDestroyStatus{})
@@ -56,6 +65,7 @@ func init() {
 	registry.MustRegisterResource(MachineSetStatusType, &MachineSetStatus{})
 	registry.MustRegisterResource(MachineStatusType, &MachineStatus{})
 	registry.MustRegisterResource(MachineStatusSnapshotType, &MachineStatusSnapshot{})
+	registry.MustRegisterResource(MachineStatusHistoryType, &MachineStatusHistory{})
 	registry.MustRegisterResource(MachineStatusLinkType, &MachineStatusLink{})
 	registry.MustRegisterResource(LoadBalancerConfigType, &LoadBalancerConfig{})
 	registry.MustRegisterResource(LoadBalancerStatusType, &LoadBalancerStatus{})
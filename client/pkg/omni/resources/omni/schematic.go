@@ -43,6 +43,11 @@ func (SchematicExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 		Type:             SchematicType,
 		Aliases:          []resource.Type{},
 		DefaultNamespace: resources.DefaultNamespace,
-		PrintColumns:     []meta.PrintColumn{},
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: "{.name}",
+			},
+		},
 	}
 }
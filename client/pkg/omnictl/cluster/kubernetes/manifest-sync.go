@@ -12,11 +12,15 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/management"
 	"github.com/siderolabs/omni/client/pkg/client"
+	managementcli "github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 )
 
 var manifestSyncCmdFlags struct {
-	dryRun bool
+	reason      string
+	dryRun      bool
+	incremental bool
+	retryFailed bool
 }
 
 // manifestSyncCmd represents the cluster kubernetes manifest-sync command.
@@ -58,11 +62,18 @@ func manifestSync(clusterName string) func(ctx context.Context, client *client.C
 			return nil
 		}
 
-		return client.Management().WithCluster(clusterName).KubernetesSyncManifests(ctx, manifestSyncCmdFlags.dryRun, handler)
+		return client.Management().WithCluster(clusterName).KubernetesSyncManifests(ctx, manifestSyncCmdFlags.dryRun, handler,
+			managementcli.WithIncrementalSync(manifestSyncCmdFlags.incremental),
+			managementcli.WithRetryFailedSync(manifestSyncCmdFlags.retryFailed),
+			managementcli.WithSyncReason(manifestSyncCmdFlags.reason),
+		)
 	}
 }
 
 func init() {
 	manifestSyncCmd.Flags().BoolVar(&manifestSyncCmdFlags.dryRun, "dry-run", true, "don't actually sync manifests, just print what would be done")
+	manifestSyncCmd.Flags().BoolVar(&manifestSyncCmdFlags.incremental, "incremental", false, "skip the manifests which didn't change since the last successful sync")
+	manifestSyncCmd.Flags().BoolVar(&manifestSyncCmdFlags.retryFailed, "retry-failed", false, "skip the manifests which were applied by the failed syncs since the last successful sync")
+	manifestSyncCmd.Flags().StringVar(&manifestSyncCmdFlags.reason, "reason", "", "reason of the sync, recorded along with the identity which triggered it")
 	kubernetesCmd.AddCommand(manifestSyncCmd)
 }
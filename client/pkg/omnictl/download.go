@@ -186,6 +186,7 @@ func createSchematic(ctx context.Context, client *client.Client) (*management.Cr
 		MetaValues:      metaValues,
 		ExtraKernelArgs: downloadCmdFlags.extraKernelArgs,
 		Extensions:      extensions,
+		SecureBoot:      downloadCmdFlags.secureBoot,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create schematic: %w", err)
@@ -29,6 +29,7 @@ import (
 const (
 	serviceAccountFlag     = "service-account"
 	serviceAccountUserFlag = "user"
+	ttlFlag                = "ttl"
 )
 
 var kubeconfigCmdFlags struct {
@@ -50,13 +51,13 @@ var kubeconfigCmd = &cobra.Command{
 If merge flag is defined, config will be merged with ~/.kube/config or [local-path] if specified.
 Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 	Args: cobra.MaximumNArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		return access.WithClient(getKubeconfig(args))
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return access.WithClient(getKubeconfig(args, cmd.Flags().Changed(ttlFlag)))
 	},
 }
 
 //nolint:gocognit
-func getKubeconfig(args []string) func(ctx context.Context, client *client.Client) error {
+func getKubeconfig(args []string, ttlSet bool) func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
 		var localPath string
 
@@ -120,6 +121,8 @@ func getKubeconfig(args []string) func(ctx context.Context, client *client.Clien
 				kubeconfigCmdFlags.serviceAccountUser,
 				kubeconfigCmdFlags.serviceAccountGroups...,
 			))
+		} else if ttlSet {
+			opts = append(opts, management.WithTTL(kubeconfigCmdFlags.serviceAccountTTL))
 		}
 
 		data, err := client.Management().WithCluster(kubeconfigCmdFlags.cluster).Kubeconfig(ctx, opts...)
@@ -203,8 +206,8 @@ func init() {
 
 	kubeconfigCmd.Flags().BoolVar(&kubeconfigCmdFlags.serviceAccount, serviceAccountFlag, false,
 		"create a service account type kubeconfig instead of a OIDC-authenticated user type")
-	kubeconfigCmd.Flags().DurationVar(&kubeconfigCmdFlags.serviceAccountTTL, "ttl", 365*24*time.Hour,
-		fmt.Sprintf("ttl for the service account token. only used when --%s is set to true", serviceAccountFlag))
+	kubeconfigCmd.Flags().DurationVar(&kubeconfigCmdFlags.serviceAccountTTL, ttlFlag, 365*24*time.Hour,
+		fmt.Sprintf("ttl for the service account token if --%s is set to true, otherwise limits the lifetime of the user kubeconfig", serviceAccountFlag))
 	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.serviceAccountUser, serviceAccountUserFlag, "",
 		fmt.Sprintf("user to be used in the service account token (sub). required when --%s is set to true", serviceAccountFlag))
 	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.serviceAccountGroups, "groups", []string{constants.DefaultAccessGroup},
@@ -16,21 +16,34 @@ import (
 	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
 	"github.com/spf13/cobra"
 
+	managementpb "github.com/siderolabs/omni/client/api/omni/management"
 	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 )
 
 var (
 	serviceAccountCreateFlags struct {
-		role string
+		role         string
+		clusterScope string
 
 		useUserRole bool
 		ttl         time.Duration
 	}
 
 	serviceAccountRenewFlags struct {
-		ttl time.Duration
+		ttl         time.Duration
+		gracePeriod time.Duration
+	}
+
+	serviceAccountDestroyFlags struct {
+		dryRun bool
+	}
+
+	serviceAccountListFlags struct {
+		includeInternal bool
+		excludeExpired  bool
 	}
 
 	// serviceAccountCmd represents the serviceaccount command.
@@ -59,7 +72,9 @@ var (
 					return err
 				}
 
-				publicKeyID, err := client.Management().CreateServiceAccount(ctx, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole)
+				publicKeyID, err := client.Management().CreateServiceAccount(ctx, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole,
+					management.WithClusterScope(serviceAccountCreateFlags.clusterScope),
+				)
 				if err != nil {
 					return err
 				}
@@ -101,17 +116,94 @@ var (
 					return err
 				}
 
-				publicKeyID, err := client.Management().RenewServiceAccount(ctx, name, armoredPublicKey)
+				var (
+					publicKeyID string
+					retiredKeys []*managementpb.RenewServiceAccountResponse_RetiredKey
+				)
+
+				if serviceAccountRenewFlags.gracePeriod > 0 {
+					resp, renewErr := client.Management().RenewServiceAccountWithGracePeriod(ctx, name, armoredPublicKey, serviceAccountRenewFlags.gracePeriod)
+					if renewErr != nil {
+						return renewErr
+					}
+
+					publicKeyID, retiredKeys = resp.PublicKeyId, resp.RetiredKeys
+				} else {
+					publicKeyID, err = client.Management().RenewServiceAccount(ctx, name, armoredPublicKey)
+					if err != nil {
+						return err
+					}
+				}
+
+				encodedKey, err := serviceaccount.Encode(name, key)
 				if err != nil {
 					return err
 				}
 
+				fmt.Printf("Renewed service account %q by adding a public key with ID %q\n", name, publicKeyID)
+
+				for _, retiredKey := range retiredKeys {
+					fmt.Printf("Public key %q is retired at %s\n", retiredKey.PublicKeyId, retiredKey.RetireAt.AsTime().Local().Format(time.RFC3339))
+				}
+
+				fmt.Printf("\n")
+				fmt.Printf("Set the following environment variables to use the service account:\n")
+				fmt.Printf("%s=%s\n", access.EndpointEnvVar, client.Endpoint())
+				fmt.Printf("%s=%s\n", serviceaccount.OmniServiceAccountKeyEnvVar, encodedKey)
+				fmt.Printf("\n")
+				fmt.Printf("Note: Store the service account key securely, it will not be displayed again\n")
+
+				return nil
+			})
+		},
+	}
+
+	serviceAccountRotateCmd = &cobra.Command{
+		Use:   "rotate <name>",
+		Short: "Rotate a service account by replacing all of its public keys with a new one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name := args[0]
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				key, err := generateServiceAccountPGPKey(name)
+				if err != nil {
+					return err
+				}
+
+				armoredPublicKey, err := key.ArmorPublic()
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.Management().RotateServiceAccount(ctx, name, armoredPublicKey)
+				if err != nil {
+					result, ok := management.RotateServiceAccountPartialResult(err)
+					if !ok {
+						return err
+					}
+
+					// the new key is registered, so it should be printed even if some of the old keys are still valid
+					fmt.Printf("failed to revoke some of the old public keys: %s\n", err)
+
+					resp = result
+				}
+
 				encodedKey, err := serviceaccount.Encode(name, key)
 				if err != nil {
 					return err
 				}
 
-				fmt.Printf("Renewed service account %q by adding a public key with ID %q\n", name, publicKeyID)
+				fmt.Printf("Rotated service account %q, the new public key ID is %q\n", name, resp.GetPublicKeyId())
+
+				for _, keyID := range resp.GetRevokedPublicKeyIds() {
+					fmt.Printf("revoked public key: %s\n", keyID)
+				}
+
+				for _, keyID := range resp.GetFailedPublicKeyIds() {
+					fmt.Printf("failed to revoke public key: %s\n", keyID)
+				}
+
 				fmt.Printf("\n")
 				fmt.Printf("Set the following environment variables to use the service account:\n")
 				fmt.Printf("%s=%s\n", access.EndpointEnvVar, client.Endpoint())
@@ -131,7 +223,10 @@ var (
 		Args:    cobra.NoArgs,
 		RunE: func(*cobra.Command, []string) error {
 			return access.WithClient(func(ctx context.Context, client *client.Client) error {
-				serviceAccounts, err := client.Management().ListServiceAccounts(ctx)
+				serviceAccounts, err := client.Management().ListServiceAccounts(ctx,
+					management.WithInternalServiceAccounts(serviceAccountListFlags.includeInternal),
+					management.WithoutExpiredServiceAccounts(serviceAccountListFlags.excludeExpired),
+				)
 				if err != nil {
 					return err
 				}
@@ -142,10 +237,15 @@ var (
 
 				for _, sa := range serviceAccounts {
 					for i, publicKey := range sa.PgpPublicKeys {
+						expiration := publicKey.Expiration.AsTime().String()
+						if publicKey.GetExpired() {
+							expiration += " (expired)"
+						}
+
 						if i == 0 {
-							fmt.Fprintf(writer, "%s\t%q\t%s\t%s\n", sa.Name, sa.GetRole(), publicKey.Id, publicKey.Expiration.AsTime().String())
+							fmt.Fprintf(writer, "%s\t%q\t%s\t%s\n", sa.Name, sa.GetRole(), publicKey.Id, expiration)
 						} else {
-							fmt.Fprintf(writer, "\t\t%s\t%s\n", publicKey.Id, publicKey.Expiration.AsTime().String())
+							fmt.Fprintf(writer, "\t\t%s\t%s\n", publicKey.Id, expiration)
 						}
 					}
 				}
@@ -164,8 +264,31 @@ var (
 			name := args[0]
 
 			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				if serviceAccountDestroyFlags.dryRun {
+					resources, err := client.Management().DestroyServiceAccountDryRun(ctx, name)
+					if err != nil {
+						return fmt.Errorf("failed to resolve service account resources: %w", err)
+					}
+
+					writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+					fmt.Fprintf(writer, "TYPE\tID\n")
+
+					for _, res := range resources {
+						fmt.Fprintf(writer, "%s\t%s\n", res.GetType(), res.GetId())
+					}
+
+					return writer.Flush()
+				}
+
 				err := client.Management().DestroyServiceAccount(ctx, name)
 				if err != nil {
+					if result, ok := management.DestroyServiceAccountPartialResult(err); ok {
+						for _, failure := range result.GetFailures() {
+							fmt.Printf("failed to destroy %s %s: %s\n", failure.GetResource().GetType(), failure.GetResource().GetId(), failure.GetError())
+						}
+					}
+
 					return fmt.Errorf("failed to destroy service account: %w", err)
 				}
 
@@ -191,13 +314,22 @@ func init() {
 	serviceAccountCmd.AddCommand(serviceAccountListCmd)
 	serviceAccountCmd.AddCommand(serviceAccountDestroyCmd)
 	serviceAccountCmd.AddCommand(serviceAccountRenewCmd)
+	serviceAccountCmd.AddCommand(serviceAccountRotateCmd)
 
 	roleFlag := "role"
 	useUserRoleFlag := "use-user-role"
 
 	serviceAccountCreateCmd.Flags().DurationVarP(&serviceAccountCreateFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
-	serviceAccountCreateCmd.Flags().StringVarP(&serviceAccountCreateFlags.role, roleFlag, "r", "", "role of the service account. only used when --"+useUserRoleFlag+"=false")
+	serviceAccountCreateCmd.Flags().StringVarP(&serviceAccountCreateFlags.role, roleFlag, "r", "", "role of the service account, the server default is used if empty. only used when --"+useUserRoleFlag+"=false")
 	serviceAccountCreateCmd.Flags().BoolVarP(&serviceAccountCreateFlags.useUserRole, useUserRoleFlag, "u", true, "use the role of the creating user. if true, --"+roleFlag+" is ignored")
+	serviceAccountCreateCmd.Flags().StringVar(&serviceAccountCreateFlags.clusterScope, "cluster-scope", "", "limit the service account to the given cluster. if empty, the role applies to all clusters")
 
 	serviceAccountRenewCmd.Flags().DurationVarP(&serviceAccountRenewFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
+	serviceAccountRenewCmd.Flags().DurationVar(&serviceAccountRenewFlags.gracePeriod, "grace-period", 0,
+		"keep the old keys of the service account valid for at least the given period, then retire them. if zero, the old keys are kept until they expire")
+
+	serviceAccountDestroyCmd.Flags().BoolVar(&serviceAccountDestroyFlags.dryRun, "dry-run", false, "only print the resources which would be destroyed")
+
+	serviceAccountListCmd.Flags().BoolVar(&serviceAccountListFlags.includeInternal, "include-internal", false, "include the service accounts created by Omni for the internal use")
+	serviceAccountListCmd.Flags().BoolVar(&serviceAccountListFlags.excludeExpired, "exclude-expired", false, "skip the service accounts which have no unexpired public keys")
 }
@@ -69,6 +69,22 @@ var rootCmd = &cobra.Command{
 			return errors.New("flags --auth-saml-url and --auth-saml-metadata are mutually exclusive")
 		}
 
+		if err := config.Config.Auth.Validate(); err != nil {
+			return err
+		}
+
+		if err := config.Config.MachineStatusHistory.Validate(); err != nil {
+			return err
+		}
+
+		if err := config.Config.ValidateMachineStatusPlatformLabels(); err != nil {
+			return err
+		}
+
+		if err := config.Config.ApprovalWebhook.Validate(); err != nil {
+			return err
+		}
+
 		var loggerConfig zap.Config
 
 		if constants.IsDebugBuild {
@@ -290,11 +306,24 @@ func init() {
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MinPort, "lb-min-port", config.Config.LoadBalancer.MinPort, "cluster load balancer port range min value.")
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MaxPort, "lb-max-port", config.Config.LoadBalancer.MaxPort, "cluster load balancer port range max value.")
 	rootCmd.Flags().IntVar(&config.Config.LogServerPort, "log-server-port", config.Config.LogServerPort, "port for TCP log server")
+	rootCmd.Flags().IntVar(&config.Config.MachineLogsMaxLineSize, "machine-logs-max-line-size", config.Config.MachineLogsMaxLineSize,
+		"maximum size of a single machine log line sent to the clients, longer lines are truncated")
 
 	rootCmd.Flags().BoolVar(&config.Config.LogStorage.Enabled, "log-storage-enabled", config.Config.LogStorage.Enabled, "enable log storage")
 	rootCmd.Flags().StringVar(&config.Config.LogStorage.Path, "log-storage-path", config.Config.LogStorage.Path, "path of the directory for storing logs")
 	rootCmd.Flags().DurationVar(&config.Config.LogStorage.FlushPeriod, "log-storage-flush-period", config.Config.LogStorage.FlushPeriod, "period for flushing logs to disk")
 
+	rootCmd.Flags().StringVar(&config.Config.LogForwarding.URL, "log-forwarding-url", config.Config.LogForwarding.URL,
+		"syslog (tcp://, udp://) or Loki push API (http://, https://) URL to forward the machine logs to (disabled if empty)")
+	rootCmd.Flags().IntVar(&config.Config.LogForwarding.BatchSize, "log-forwarding-batch-size", config.Config.LogForwarding.BatchSize,
+		"maximum number of the machine log lines forwarded at once")
+	rootCmd.Flags().DurationVar(&config.Config.LogForwarding.FlushPeriod, "log-forwarding-flush-period", config.Config.LogForwarding.FlushPeriod,
+		"period for forwarding the incomplete batch of the machine log lines")
+	rootCmd.Flags().DurationVar(&config.Config.LogForwarding.Timeout, "log-forwarding-timeout", config.Config.LogForwarding.Timeout,
+		"timeout for a single log forwarding request")
+	rootCmd.Flags().DurationVar(&config.Config.LogForwarding.RetryDuration, "log-forwarding-retry-duration", config.Config.LogForwarding.RetryDuration,
+		"how long to retry forwarding a batch of the machine log lines before dropping it")
+
 	rootCmd.Flags().BoolVar(&config.Config.Auth.Auth0.Enabled, "auth-auth0-enabled", config.Config.Auth.Auth0.Enabled,
 		"enable Auth0 authentication. Once set to true, it cannot be set back to false.")
 	rootCmd.Flags().StringVar(&config.Config.Auth.Auth0.ClientID, "auth-auth0-client-id", config.Config.Auth.Auth0.ClientID, "Auth0 application client ID.")
@@ -313,6 +342,10 @@ func init() {
 		"SAML identity provider metadata file path (mutually exclusive with --auth-saml-url).",
 	)
 	rootCmd.Flags().Var(&config.Config.Auth.SAML.LabelRules, "auth-saml-label-rules", "defines mapping of SAML assertion attributes into Omni identity labels")
+	rootCmd.Flags().Var(&config.Config.Auth.MethodRoleOverrides, "auth-method-role-overrides",
+		"overrides the role required to call the management API methods, in the format {\"<method>\": \"<role>\"}, e.g. {\"CreateSchematic\": \"Reader\"}")
+	rootCmd.Flags().StringVar(&config.Config.Auth.ServiceAccountDefaultRole, "auth-service-account-default-role", config.Config.Auth.ServiceAccountDefaultRole,
+		"role of the service accounts created without an explicit role, capped by the role of the creating user")
 
 	rootCmd.Flags().StringSliceVar(&config.Config.InitialUsers, "initial-users", config.Config.InitialUsers, "initial set of user emails. these users will be created on startup.")
 
@@ -421,4 +454,118 @@ func init() {
 		config.Config.DisableControllerRuntimeCache,
 		"disable watch-based cache for controller-runtime (affects performance)",
 	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.ConnectivityWebhook.URL,
+		"connectivity-webhook-url",
+		config.Config.ConnectivityWebhook.URL,
+		"URL to POST the machine connectivity changes to (disabled if empty)",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ConnectivityWebhook.Timeout,
+		"connectivity-webhook-timeout",
+		config.Config.ConnectivityWebhook.Timeout,
+		"timeout for a single connectivity webhook request",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ConnectivityWebhook.RetryDuration,
+		"connectivity-webhook-retry-duration",
+		config.Config.ConnectivityWebhook.RetryDuration,
+		"how long to retry delivering a connectivity webhook event before dropping it",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.KeyExpiryNotification.Threshold,
+		"key-expiry-notification-threshold",
+		config.Config.KeyExpiryNotification.Threshold,
+		"notify when a service account key expires within this duration (disabled if zero)",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.KeyExpiryNotification.Interval,
+		"key-expiry-notification-interval",
+		config.Config.KeyExpiryNotification.Interval,
+		"interval between service account key expiration checks",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.KeyExpiryNotification.WebhookURL,
+		"key-expiry-notification-webhook-url",
+		config.Config.KeyExpiryNotification.WebhookURL,
+		"URL to POST the service account key expiry notifications to (notifications are only logged if empty)",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.KeyExpiryNotification.Timeout,
+		"key-expiry-notification-webhook-timeout",
+		config.Config.KeyExpiryNotification.Timeout,
+		"timeout for a single key expiry webhook request",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.KeyExpiryNotification.RetryDuration,
+		"key-expiry-notification-webhook-retry-duration",
+		config.Config.KeyExpiryNotification.RetryDuration,
+		"how long to retry delivering a key expiry webhook event before dropping it",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.ApprovalWebhook.URL,
+		"approval-webhook-url",
+		config.Config.ApprovalWebhook.URL,
+		"URL to POST the destructive operations to for the approval",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.ApprovalWebhook.Operations,
+		"approval-webhook-operations",
+		config.Config.ApprovalWebhook.Operations,
+		fmt.Sprintf("operations which require the approval of the webhook (disabled if empty), supported: %s, %s",
+			config.ApprovalOperationDestroyServiceAccount, config.ApprovalOperationDecommissionMachine),
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ApprovalWebhook.Timeout,
+		"approval-webhook-timeout",
+		config.Config.ApprovalWebhook.Timeout,
+		"timeout for a single approval webhook request",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.MachineStatusHistory.Depth,
+		"machine-status-history-depth",
+		config.Config.MachineStatusHistory.Depth,
+		fmt.Sprintf("number of the machine status snapshots kept per machine (disabled if zero, at most %d)", config.MaxMachineStatusHistoryDepth),
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.MachineStatusHistory.Interval,
+		"machine-status-history-interval",
+		config.Config.MachineStatusHistory.Interval,
+		"minimum interval between the machine status snapshots of the same machine",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.MachineStatusPlatformLabels,
+		"machine-status-platform-labels",
+		config.Config.MachineStatusPlatformLabels,
+		"platform metadata fields projected as the machine status labels, the supported fields are: "+
+			"platform, region, zone, instance_type, instance_id, provider_id, spot",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.MachineStatusSideroLinkAddress,
+		"machine-status-siderolink-address",
+		config.Config.MachineStatusSideroLinkAddress,
+		"report the SideroLink address of the machines in the machine status, used to troubleshoot the SideroLink connectivity",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.MachineStatusLongDisconnectThreshold,
+		"machine-status-long-disconnect-threshold",
+		config.Config.MachineStatusLongDisconnectThreshold,
+		"time the machine should stay disconnected to be labeled as long disconnected (disabled if zero)",
+	)
 }
@@ -9,6 +9,8 @@ import * as fm from "../../fetch.pb"
 import * as GoogleProtobufDuration from "../../google/protobuf/duration.pb"
 import * as GoogleProtobufEmpty from "../../google/protobuf/empty.pb"
 import * as GoogleProtobufTimestamp from "../../google/protobuf/timestamp.pb"
+import * as SpecsAuth from "../specs/auth.pb"
+import * as SpecsOmni from "../specs/omni.pb"
 
 export enum KubernetesSyncManifestResponseResponseType {
   UNKNOWN = 0,
@@ -16,6 +18,53 @@ export enum KubernetesSyncManifestResponseResponseType {
   ROLLOUT = 2,
 }
 
+export enum CreateSchematicProgressStage {
+  UNKNOWN = 0,
+  VALIDATING = 1,
+  CONTACTING_FACTORY = 2,
+  DONE = 3,
+}
+
+export enum GetMachineStatusTimeSeriesRequestMetric {
+  UNKNOWN = 0,
+  CONNECTED = 1,
+  KUBERNETES_NODE_READY = 2,
+  NETWORK_LINKS_UP = 3,
+  CLOCK_DRIFT_SECONDS = 4,
+}
+
+export enum GetMachinePatchOrderResponsePatchSource {
+  CLUSTER = 0,
+  MACHINE_SET = 1,
+  CLUSTER_MACHINE = 2,
+  MACHINE = 3,
+}
+
+export enum GetClusterTopologyResponseNodeKind {
+  UNKNOWN = 0,
+  CLUSTER = 1,
+  MACHINE_SET = 2,
+  MACHINE = 3,
+}
+
+export enum GetClusterTopologyResponseEdgeKind {
+  UNKNOWN = 0,
+  MEMBER_OF = 1,
+  CONNECTS_TO = 2,
+}
+
+export enum WatchUpgradeProgressResponseComponent {
+  TALOS = 0,
+  KUBERNETES = 1,
+}
+
+export enum WatchUpgradeProgressResponseState {
+  PENDING = 0,
+  UPGRADING = 1,
+  DONE = 2,
+  FAILED = 3,
+}
+
 export type KubeconfigResponse = {
   kubeconfig?: Uint8Array
 }
@@ -28,6 +77,21 @@ export type OmniconfigResponse = {
   omniconfig?: Uint8Array
 }
 
+export type OmniconfigForRequest = {
+  identity?: string
+}
+
+export type ValidateOmniEndpointRequest = {
+  url?: string
+  check_connectivity?: boolean
+}
+
+export type ValidateOmniEndpointResponse = {
+  destination?: string
+  reachable?: boolean
+  error?: string
+}
+
 export type MachineLogsRequest = {
   machine_id?: string
   follow?: boolean
@@ -36,6 +100,24 @@ export type MachineLogsRequest = {
 
 export type ValidateConfigRequest = {
   config?: string
+  suppressed_lint_rules?: string[]
+}
+
+export type ValidateConfigResponseLintWarning = {
+  rule_id?: string
+  message?: string
+}
+
+export type ValidateConfigResponse = {
+  lint_warnings?: ValidateConfigResponseLintWarning[]
+}
+
+export type GetConfigSchemaRequest = {
+  talos_version?: string
+}
+
+export type GetConfigSchemaResponse = {
+  schema?: string
 }
 
 export type TalosconfigRequest = {
@@ -46,6 +128,7 @@ export type CreateServiceAccountRequest = {
   armored_pgp_public_key?: string
   use_user_role?: boolean
   role?: string
+  cluster_scope?: string
 }
 
 export type CreateServiceAccountResponse = {
@@ -55,20 +138,82 @@ export type CreateServiceAccountResponse = {
 export type RenewServiceAccountRequest = {
   name?: string
   armored_pgp_public_key?: string
+  grace_period?: GoogleProtobufDuration.Duration
+}
+
+export type RenewServiceAccountResponseRetiredKey = {
+  public_key_id?: string
+  retire_at?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type RenewServiceAccountResponse = {
   public_key_id?: string
+  retired_keys?: RenewServiceAccountResponseRetiredKey[]
+}
+
+export type RotateServiceAccountRequest = {
+  name?: string
+  armored_pgp_public_key?: string
+}
+
+export type RotateServiceAccountResponse = {
+  public_key_id?: string
+  revoked_public_key_ids?: string[]
+  failed_public_key_ids?: string[]
 }
 
 export type DestroyServiceAccountRequest = {
   name?: string
+  dry_run?: boolean
+}
+
+export type DestroyServiceAccountResponseResource = {
+  type?: string
+  id?: string
+}
+
+export type DestroyServiceAccountResponseFailure = {
+  resource?: DestroyServiceAccountResponseResource
+  error?: string
+}
+
+export type DestroyServiceAccountResponse = {
+  resources?: DestroyServiceAccountResponseResource[]
+  failures?: DestroyServiceAccountResponseFailure[]
+}
+
+export type DestroyServiceAccountsRequestFilter = {
+  expired?: boolean
+  role?: string
+  label_selector?: string
+  names?: string[]
+}
+
+export type DestroyServiceAccountsRequest = {
+  filter?: DestroyServiceAccountsRequestFilter
+  dry_run?: boolean
+}
+
+export type DestroyServiceAccountsResponseResult = {
+  name?: string
+  result?: DestroyServiceAccountResponse
+  error?: string
+}
+
+export type DestroyServiceAccountsResponse = {
+  results?: DestroyServiceAccountsResponseResult[]
+}
+
+export type ListServiceAccountsRequest = {
+  include_internal?: boolean
+  exclude_expired?: boolean
 }
 
 export type ListServiceAccountsResponseServiceAccountPgpPublicKey = {
   id?: string
   armored?: string
   expiration?: GoogleProtobufTimestamp.Timestamp
+  expired?: boolean
 }
 
 export type ListServiceAccountsResponseServiceAccount = {
@@ -81,11 +226,68 @@ export type ListServiceAccountsResponse = {
   service_accounts?: ListServiceAccountsResponseServiceAccount[]
 }
 
+export type ListAllServiceAccountKeysRequest = {
+  offset?: number
+  limit?: number
+}
+
+export type ListAllServiceAccountKeysResponseKey = {
+  service_account?: string
+  id?: string
+  role?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  confirmed?: boolean
+  internal?: boolean
+}
+
+export type ListAllServiceAccountKeysResponse = {
+  keys?: ListAllServiceAccountKeysResponseKey[]
+  total?: number
+}
+
+export type GetServiceAccountKeyRequest = {
+  name?: string
+  key_id?: string
+}
+
+export type GetServiceAccountKeyResponse = {
+  id?: string
+  armored?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  role?: string
+}
+
+export type ConfirmServiceAccountKeyRequest = {
+  name?: string
+  key_id?: string
+}
+
+export type ServiceAccountExport = {
+  name?: string
+  role?: string
+  labels?: {[key: string]: string}
+  armored_pgp_public_keys?: string[]
+}
+
+export type ExportServiceAccountsResponse = {
+  service_accounts?: ServiceAccountExport[]
+}
+
+export type ImportServiceAccountsRequest = {
+  service_accounts?: ServiceAccountExport[]
+}
+
+export type ImportServiceAccountsResponse = {
+  imported?: string[]
+  skipped?: string[]
+}
+
 export type KubeconfigRequest = {
   service_account?: boolean
   service_account_ttl?: GoogleProtobufDuration.Duration
   service_account_user?: string
   service_account_groups?: string[]
+  ttl?: GoogleProtobufDuration.Duration
 }
 
 export type KubernetesUpgradePreChecksRequest = {
@@ -99,6 +301,9 @@ export type KubernetesUpgradePreChecksResponse = {
 
 export type KubernetesSyncManifestRequest = {
   dry_run?: boolean
+  incremental_only?: boolean
+  reason?: string
+  retry_failed?: boolean
 }
 
 export type KubernetesSyncManifestResponse = {
@@ -113,11 +318,702 @@ export type CreateSchematicRequest = {
   extensions?: string[]
   extra_kernel_args?: string[]
   meta_values?: {[key: number]: string}
+  secure_boot?: boolean
+  name?: string
+  description?: string
 }
 
 export type CreateSchematicResponse = {
   schematic_id?: string
   pxe_url?: string
+  secure_boot?: boolean
+}
+
+export type ValidateMetaValuesRequest = {
+  meta_values?: {[key: number]: string}
+}
+
+export type ValidateMetaValuesResponseResult = {
+  key?: number
+  key_id?: string
+  valid?: boolean
+  message?: string
+}
+
+export type ValidateMetaValuesResponse = {
+  valid?: boolean
+  results?: ValidateMetaValuesResponseResult[]
+}
+
+export type CreateSchematicProgress = {
+  stage?: CreateSchematicProgressStage
+  schematic?: CreateSchematicResponse
+}
+
+export type SetSchematicNameRequest = {
+  schematic_id?: string
+  name?: string
+  description?: string
+}
+
+export type CreateSchematicsRequest = {
+  schematics?: CreateSchematicRequest[]
+}
+
+export type CreateSchematicsResponse = {
+  schematics?: CreateSchematicResponse[]
+}
+
+export type ImportExternalNodesRequest = {
+  name?: string
+  talosconfig?: Uint8Array
+  endpoints?: string[]
+}
+
+export type ImportExternalNodesResponse = {
+  machine_ids?: string[]
+}
+
+export type ValidateServiceAccountKeyRequest = {
+  armored_pgp_public_key?: string
+}
+
+export type ValidateServiceAccountKeyResponse = {
+  username?: string
+  public_key_id?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type TestServiceAccountRequest = {
+  name?: string
+  signed_proof?: Uint8Array
+}
+
+export type TestServiceAccountResponse = {
+  public_key_id?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  role?: string
+}
+
+export type CompareKernelArgsRequest = {
+  machine_id?: string
+}
+
+export type CompareKernelArgsResponse = {
+  intended_args?: string[]
+  actual_args?: string[]
+  missing_args?: string[]
+  extra_args?: string[]
+}
+
+export type GetAvailableExtensionUpdatesRequest = {
+  machine_id?: string
+}
+
+export type GetAvailableExtensionUpdatesResponseUpdate = {
+  name?: string
+  installed_version?: string
+  available_version?: string
+  talos_version?: string
+}
+
+export type GetAvailableExtensionUpdatesResponse = {
+  updates?: GetAvailableExtensionUpdatesResponseUpdate[]
+  unresolvable?: boolean
+  unresolvable_reason?: string
+}
+
+export type GetExtensionUsageResponseExtension = {
+  name?: string
+  machines?: number
+}
+
+export type GetExtensionUsageResponse = {
+  extensions?: GetExtensionUsageResponseExtension[]
+  unknown_machines?: number
+}
+
+export type ValidateSchematicUpgradeRequest = {
+  machine_id?: string
+  target_schematic_id?: string
+}
+
+export type ValidateSchematicUpgradeResponse = {
+  feasible?: boolean
+  issues?: string[]
+  talos_version?: string
+  arch?: string
+}
+
+export type GetJoinKernelArgsResponse = {
+  kernel_args?: string
+}
+
+export type GetMachineStatusAtRequest = {
+  machine_id?: string
+  timestamp?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type GetMachineStatusAtResponse = {
+  taken_at?: GoogleProtobufTimestamp.Timestamp
+  status?: SpecsOmni.MachineStatusSpec
+}
+
+export type GetMachineStatusTimeSeriesRequest = {
+  machine_id?: string
+  from?: GoogleProtobufTimestamp.Timestamp
+  to?: GoogleProtobufTimestamp.Timestamp
+  metric?: GetMachineStatusTimeSeriesRequestMetric
+  resolution?: GoogleProtobufDuration.Duration
+}
+
+export type GetMachineStatusTimeSeriesResponseSample = {
+  timestamp?: GoogleProtobufTimestamp.Timestamp
+  value?: number
+}
+
+export type GetMachineStatusTimeSeriesResponse = {
+  samples?: GetMachineStatusTimeSeriesResponseSample[]
+}
+
+export type GetClockDriftRequest = {
+  machine_id?: string
+  threshold?: GoogleProtobufDuration.Duration
+}
+
+export type GetClockDriftResponse = {
+  drift?: GoogleProtobufDuration.Duration
+  threshold_exceeded?: boolean
+}
+
+export type ListMachinesByUptimeRequest = {
+  min_connected_duration?: GoogleProtobufDuration.Duration
+}
+
+export type ListMachinesByUptimeResponseMachine = {
+  machine_id?: string
+  cluster?: string
+  connected_since?: GoogleProtobufTimestamp.Timestamp
+  connected_duration?: GoogleProtobufDuration.Duration
+}
+
+export type ListMachinesByUptimeResponse = {
+  machines?: ListMachinesByUptimeResponseMachine[]
+}
+
+export type ListMachinesWithHardwareChangesRequest = {
+  since?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ListMachinesWithHardwareChangesResponseMachine = {
+  machine_id?: string
+  cluster?: string
+  changed_at?: GoogleProtobufTimestamp.Timestamp
+  changes?: string[]
+}
+
+export type ListMachinesWithHardwareChangesResponse = {
+  machines?: ListMachinesWithHardwareChangesResponseMachine[]
+}
+
+export type ListMachinesPendingRebootResponseMachine = {
+  machine_id?: string
+  cluster?: string
+  state?: SpecsOmni.MachineStatusSpecRebootStatusState
+  reason?: string
+}
+
+export type ListMachinesPendingRebootResponse = {
+  machines?: ListMachinesPendingRebootResponseMachine[]
+}
+
+export type ValidateUpgradeImageReachabilityRequest = {
+  cluster_name?: string
+  version?: string
+}
+
+export type ValidateUpgradeImageReachabilityResponseNode = {
+  machine_id?: string
+  image?: string
+  reachable?: boolean
+  error?: string
+}
+
+export type ValidateUpgradeImageReachabilityResponse = {
+  nodes?: ValidateUpgradeImageReachabilityResponseNode[]
+}
+
+export type CompareSchematicToBaselineRequest = {
+  machine_id?: string
+  baseline_schematic_id?: string
+}
+
+export type CompareSchematicToBaselineResponse = {
+  schematic_id?: string
+  invalid_schematic?: boolean
+  added_extensions?: string[]
+  removed_extensions?: string[]
+}
+
+export type DiffSchematicsRequest = {
+  schematic_id_a?: string
+  schematic_id_b?: string
+}
+
+export type DiffSchematicsResponse = {
+  added_extensions?: string[]
+  removed_extensions?: string[]
+  added_kernel_args?: string[]
+  removed_kernel_args?: string[]
+}
+
+export type GetSchematicPXEURLRequest = {
+  schematic_id?: string
+}
+
+export type GetSchematicPXEURLResponse = {
+  pxe_url?: string
+}
+
+export type GetInstallerImageURLRequest = {
+  schematic_id?: string
+  talos_version?: string
+}
+
+export type GetInstallerImageURLResponse = {
+  image?: string
+}
+
+export type GetSchematicISOURLRequest = {
+  schematic_id?: string
+  talos_version?: string
+  arch?: string
+}
+
+export type GetSchematicISOURLResponse = {
+  url?: string
+}
+
+export type WatchKubernetesEventsRequest = {
+  cluster_name?: string
+}
+
+export type WatchKubernetesEventsResponseInvolvedObject = {
+  kind?: string
+  namespace?: string
+  name?: string
+}
+
+export type WatchKubernetesEventsResponse = {
+  type?: string
+  reason?: string
+  message?: string
+  involved_object?: WatchKubernetesEventsResponseInvolvedObject
+  count?: number
+  last_timestamp?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type StreamClusterUtilizationRequest = {
+  cluster_name?: string
+  interval?: GoogleProtobufDuration.Duration
+  duration?: GoogleProtobufDuration.Duration
+}
+
+export type StreamClusterUtilizationResponseNode = {
+  machine_id?: string
+  cpu_percent?: number
+  memory_used_bytes?: string
+  memory_total_bytes?: string
+  error?: string
+}
+
+export type StreamClusterUtilizationResponse = {
+  sampled_at?: GoogleProtobufTimestamp.Timestamp
+  cpu_percent?: number
+  memory_used_bytes?: string
+  memory_total_bytes?: string
+  nodes?: StreamClusterUtilizationResponseNode[]
+}
+
+export type CreateMachineGroupRequest = {
+  name?: string
+  match_labels?: string[]
+}
+
+export type CreateMachineGroupResponse = {
+  machine_ids?: string[]
+}
+
+export type LabelMachineGroupRequest = {
+  name?: string
+  labels?: {[key: string]: string}
+}
+
+export type RebootMachineGroupRequest = {
+  name?: string
+}
+
+export type MachineGroupOperationResponseFailure = {
+  machine_id?: string
+  error?: string
+}
+
+export type MachineGroupOperationResponse = {
+  machine_ids?: string[]
+  failures?: MachineGroupOperationResponseFailure[]
+}
+
+export type GetMachinePatchOrderRequest = {
+  machine_id?: string
+}
+
+export type GetMachinePatchOrderResponsePatch = {
+  id?: string
+  name?: string
+  source?: GetMachinePatchOrderResponsePatchSource
+}
+
+export type GetMachinePatchOrderResponse = {
+  patches?: GetMachinePatchOrderResponsePatch[]
+}
+
+export type SetKubernetesUpgradeWindowRequest = {
+  cluster_name?: string
+  days?: number[]
+  start?: string
+  duration?: GoogleProtobufDuration.Duration
+}
+
+export type DeleteKubernetesUpgradeWindowRequest = {
+  cluster_name?: string
+}
+
+export type DecommissionMachineRequest = {
+  machine_id?: string
+  confirm_control_plane?: boolean
+}
+
+export type DecommissionMachineResponse = {
+  step?: string
+}
+
+export type ListMachinesByTalosVersionRequest = {
+  constraint?: string
+}
+
+export type ListMachinesByTalosVersionResponseGroup = {
+  version?: string
+  machine_ids?: string[]
+}
+
+export type ListMachinesByTalosVersionResponse = {
+  groups?: ListMachinesByTalosVersionResponseGroup[]
+  unknown_machine_ids?: string[]
+}
+
+export type ListUnhealthyMachinesResponseMachine = {
+  machine_id?: string
+  cluster?: string
+  connected?: boolean
+  failed_polls?: string[]
+  reason?: string
+}
+
+export type ListUnhealthyMachinesResponse = {
+  machines?: ListUnhealthyMachinesResponseMachine[]
+}
+
+export type QueryMachineStatusesRequest = {
+  label_selector?: string
+  offset?: number
+  limit?: number
+}
+
+export type QueryMachineStatusesResponseMachine = {
+  machine_id?: string
+  labels?: {[key: string]: string}
+  status?: SpecsOmni.MachineStatusSpec
+}
+
+export type QueryMachineStatusesResponse = {
+  machines?: QueryMachineStatusesResponseMachine[]
+  total?: number
+}
+
+export type ListInvalidSchematicMachinesResponseMachine = {
+  machine_id?: string
+  cluster?: string
+  extensions?: string[]
+}
+
+export type ListInvalidSchematicMachinesResponse = {
+  machines?: ListInvalidSchematicMachinesResponseMachine[]
+}
+
+export type ListSchematicsWithStaleArgsResponseSchematic = {
+  schematic_id?: string
+  name?: string
+  stale_args?: string[]
+  machine_ids?: string[]
+}
+
+export type ListSchematicsWithStaleArgsResponse = {
+  schematics?: ListSchematicsWithStaleArgsResponseSchematic[]
+}
+
+export type SetMachineReservedRequest = {
+  machine_id?: string
+  reserved?: boolean
+}
+
+export type ValidateClusterMembershipRequest = {
+  cluster_name?: string
+}
+
+export type ValidateClusterMembershipResponseIssue = {
+  machine_id?: string
+  description?: string
+}
+
+export type ValidateClusterMembershipResponse = {
+  issues?: ValidateClusterMembershipResponseIssue[]
+  skipped_checks?: string[]
+}
+
+export type ValidateClusterConfigsRequest = {
+  cluster_name?: string
+}
+
+export type ValidateClusterConfigsResponse = {
+  machine_id?: string
+  errors?: string[]
+}
+
+export type GetClusterTopologyRequest = {
+  cluster_name?: string
+}
+
+export type GetClusterTopologyResponseNode = {
+  id?: string
+  kind?: GetClusterTopologyResponseNodeKind
+  name?: string
+  role?: string
+  addresses?: string[]
+  connected?: boolean
+  talos_version?: string
+}
+
+export type GetClusterTopologyResponseEdge = {
+  from?: string
+  to?: string
+  kind?: GetClusterTopologyResponseEdgeKind
+}
+
+export type GetClusterTopologyResponse = {
+  nodes?: GetClusterTopologyResponseNode[]
+  edges?: GetClusterTopologyResponseEdge[]
+}
+
+export type SetMachineLogRetentionRequest = {
+  machine_id?: string
+  max_size_bytes?: string
+}
+
+export type ListUnallocatedMachinesRequest = {
+  exclude_reserved?: boolean
+}
+
+export type ListUnallocatedMachinesResponseMachine = {
+  machine_id?: string
+  connected?: boolean
+  reserved?: boolean
+}
+
+export type ListUnallocatedMachinesResponse = {
+  machines?: ListUnallocatedMachinesResponseMachine[]
+}
+
+export type GetClusterCapacityRequest = {
+  cluster_name?: string
+}
+
+export type GetClusterCapacityResponseCapacity = {
+  cpu_cores?: number
+  cpu_threads?: number
+  memory_mb?: string
+  disk_bytes?: string
+}
+
+export type GetClusterCapacityResponseNode = {
+  machine_id?: string
+  capacity?: GetClusterCapacityResponseCapacity
+  hardware_unknown?: boolean
+}
+
+export type GetClusterCapacityResponse = {
+  total?: GetClusterCapacityResponseCapacity
+  nodes?: GetClusterCapacityResponseNode[]
+}
+
+export type GetMachineDmesgRequest = {
+  machine_id?: string
+}
+
+export type GetMachineEventsRequest = {
+  machine_id?: string
+  limit?: number
+}
+
+export type GetMachineEventsResponseEvent = {
+  received_at?: GoogleProtobufTimestamp.Timestamp
+  stage?: string
+  ready?: boolean
+  unmet_conditions?: string[]
+}
+
+export type GetMachineEventsResponse = {
+  events?: GetMachineEventsResponseEvent[]
+}
+
+export type GetKubeletStatusRequest = {
+  machine_id?: string
+}
+
+export type GetKubeletStatusResponseService = {
+  state?: string
+  healthy?: boolean
+  health_unknown?: boolean
+  last_message?: string
+}
+
+export type GetKubeletStatusResponse = {
+  service?: GetKubeletStatusResponseService
+  image?: string
+  args?: string[]
+  expected_nodename?: string
+  node_ips?: string[]
+  cgroup_driver?: string
+  config?: string
+}
+
+export type GetMachineResourceRequest = {
+  machine_id?: string
+  namespace?: string
+  type?: string
+  id?: string
+}
+
+export type GetMachineResourceResponse = {
+  body?: string
+}
+
+export type ReapplyMachineConfigRequest = {
+  machine_id?: string
+}
+
+export type ReapplyMachineConfigResponse = {
+  mode?: string
+  mode_details?: string
+  reboot_required?: boolean
+}
+
+export type ApplyMaintenanceConfigRequest = {
+  machine_id?: string
+  config?: string
+}
+
+export type ApplyMaintenanceConfigResponse = {
+  mode_details?: string
+  warnings?: string[]
+}
+
+export type GetPendingMachineConfigRequest = {
+  machine_id?: string
+}
+
+export type GetPendingMachineConfigResponse = {
+  pending?: boolean
+  config_version?: string
+  applied_config_version?: string
+  reasons?: string[]
+  diff?: string
+}
+
+export type GetMachineStatusJSONRequest = {
+  machine_id?: string
+}
+
+export type GetMachineStatusJSONResponse = {
+  body?: string
+}
+
+export type ListKubernetesUpgradesRequest = {
+  cluster_name?: string
+}
+
+export type ListKubernetesUpgradesResponse = {
+  upgrades?: SpecsOmni.KubernetesUpgradeHistorySpecUpgrade[]
+}
+
+export type WatchUpgradeProgressRequest = {
+  cluster_name?: string
+}
+
+export type WatchUpgradeProgressResponse = {
+  component?: WatchUpgradeProgressResponseComponent
+  node?: string
+  state?: WatchUpgradeProgressResponseState
+  version?: string
+  error?: string
+}
+
+export type ValidateClusterCompositionRequest = {
+  machine_ids?: string[]
+  control_plane_count?: number
+}
+
+export type ValidateClusterCompositionResponse = {
+  valid?: boolean
+  reasons?: string[]
+}
+
+export type GetInstallDiskSelectionRequest = {
+  machine_id?: string
+}
+
+export type GetInstallDiskSelectionResponse = {
+  disk?: string
+  reason?: string
+  system_disk?: string
+  matching_disks?: string[]
+}
+
+export type SimulateAccessPolicyRequest = {
+  identity?: string
+  cluster_name?: string
+  policy_override?: SpecsAuth.AccessPolicySpec
+}
+
+export type SimulateAccessPolicyResponse = {
+  role?: string
+  matches_all_clusters?: boolean
+}
+
+export type WhoAmIRequest = {
+  include_cluster_roles?: boolean
+}
+
+export type WhoAmIResponseClusterRole = {
+  cluster?: string
+  role?: string
+}
+
+export type WhoAmIResponse = {
+  identity?: string
+  role?: string
+  service_account?: boolean
+  auth_enabled?: boolean
+  cluster_roles?: WhoAmIResponseClusterRole[]
 }
 
 export class ManagementService {
@@ -133,8 +1029,8 @@ export class ManagementService {
   static MachineLogs(req: MachineLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<CommonCommon.Data>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<MachineLogsRequest, CommonCommon.Data>("POST", `/management.ManagementService/MachineLogs`, req, entityNotifier, ...options)
   }
-  static ValidateConfig(req: ValidateConfigRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
-    return fm.fetchReq<ValidateConfigRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/ValidateConfig`, req, ...options)
+  static ValidateConfig(req: ValidateConfigRequest, ...options: fm.fetchOption[]): Promise<ValidateConfigResponse> {
+    return fm.fetchReq<ValidateConfigRequest, ValidateConfigResponse>("POST", `/management.ManagementService/ValidateConfig`, req, ...options)
   }
   static CreateServiceAccount(req: CreateServiceAccountRequest, ...options: fm.fetchOption[]): Promise<CreateServiceAccountResponse> {
     return fm.fetchReq<CreateServiceAccountRequest, CreateServiceAccountResponse>("POST", `/management.ManagementService/CreateServiceAccount`, req, ...options)
@@ -142,11 +1038,17 @@ export class ManagementService {
   static RenewServiceAccount(req: RenewServiceAccountRequest, ...options: fm.fetchOption[]): Promise<RenewServiceAccountResponse> {
     return fm.fetchReq<RenewServiceAccountRequest, RenewServiceAccountResponse>("POST", `/management.ManagementService/RenewServiceAccount`, req, ...options)
   }
-  static ListServiceAccounts(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListServiceAccountsResponse> {
-    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListServiceAccountsResponse>("POST", `/management.ManagementService/ListServiceAccounts`, req, ...options)
+  static RotateServiceAccount(req: RotateServiceAccountRequest, ...options: fm.fetchOption[]): Promise<RotateServiceAccountResponse> {
+    return fm.fetchReq<RotateServiceAccountRequest, RotateServiceAccountResponse>("POST", `/management.ManagementService/RotateServiceAccount`, req, ...options)
   }
-  static DestroyServiceAccount(req: DestroyServiceAccountRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
-    return fm.fetchReq<DestroyServiceAccountRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/DestroyServiceAccount`, req, ...options)
+  static ListServiceAccounts(req: ListServiceAccountsRequest, ...options: fm.fetchOption[]): Promise<ListServiceAccountsResponse> {
+    return fm.fetchReq<ListServiceAccountsRequest, ListServiceAccountsResponse>("POST", `/management.ManagementService/ListServiceAccounts`, req, ...options)
+  }
+  static DestroyServiceAccount(req: DestroyServiceAccountRequest, ...options: fm.fetchOption[]): Promise<DestroyServiceAccountResponse> {
+    return fm.fetchReq<DestroyServiceAccountRequest, DestroyServiceAccountResponse>("POST", `/management.ManagementService/DestroyServiceAccount`, req, ...options)
+  }
+  static GetServiceAccountKey(req: GetServiceAccountKeyRequest, ...options: fm.fetchOption[]): Promise<GetServiceAccountKeyResponse> {
+    return fm.fetchReq<GetServiceAccountKeyRequest, GetServiceAccountKeyResponse>("POST", `/management.ManagementService/GetServiceAccountKey`, req, ...options)
   }
   static KubernetesUpgradePreChecks(req: KubernetesUpgradePreChecksRequest, ...options: fm.fetchOption[]): Promise<KubernetesUpgradePreChecksResponse> {
     return fm.fetchReq<KubernetesUpgradePreChecksRequest, KubernetesUpgradePreChecksResponse>("POST", `/management.ManagementService/KubernetesUpgradePreChecks`, req, ...options)
@@ -157,4 +1059,205 @@ export class ManagementService {
   static CreateSchematic(req: CreateSchematicRequest, ...options: fm.fetchOption[]): Promise<CreateSchematicResponse> {
     return fm.fetchReq<CreateSchematicRequest, CreateSchematicResponse>("POST", `/management.ManagementService/CreateSchematic`, req, ...options)
   }
+  static ImportExternalNodes(req: ImportExternalNodesRequest, ...options: fm.fetchOption[]): Promise<ImportExternalNodesResponse> {
+    return fm.fetchReq<ImportExternalNodesRequest, ImportExternalNodesResponse>("POST", `/management.ManagementService/ImportExternalNodes`, req, ...options)
+  }
+  static ValidateServiceAccountKey(req: ValidateServiceAccountKeyRequest, ...options: fm.fetchOption[]): Promise<ValidateServiceAccountKeyResponse> {
+    return fm.fetchReq<ValidateServiceAccountKeyRequest, ValidateServiceAccountKeyResponse>("POST", `/management.ManagementService/ValidateServiceAccountKey`, req, ...options)
+  }
+  static CompareKernelArgs(req: CompareKernelArgsRequest, ...options: fm.fetchOption[]): Promise<CompareKernelArgsResponse> {
+    return fm.fetchReq<CompareKernelArgsRequest, CompareKernelArgsResponse>("POST", `/management.ManagementService/CompareKernelArgs`, req, ...options)
+  }
+  static GetSchematicPXEURL(req: GetSchematicPXEURLRequest, ...options: fm.fetchOption[]): Promise<GetSchematicPXEURLResponse> {
+    return fm.fetchReq<GetSchematicPXEURLRequest, GetSchematicPXEURLResponse>("POST", `/management.ManagementService/GetSchematicPXEURL`, req, ...options)
+  }
+  static GetInstallerImageURL(req: GetInstallerImageURLRequest, ...options: fm.fetchOption[]): Promise<GetInstallerImageURLResponse> {
+    return fm.fetchReq<GetInstallerImageURLRequest, GetInstallerImageURLResponse>("POST", `/management.ManagementService/GetInstallerImageURL`, req, ...options)
+  }
+  static WatchKubernetesEvents(req: WatchKubernetesEventsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchKubernetesEventsResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<WatchKubernetesEventsRequest, WatchKubernetesEventsResponse>("POST", `/management.ManagementService/WatchKubernetesEvents`, req, entityNotifier, ...options)
+  }
+  static CreateMachineGroup(req: CreateMachineGroupRequest, ...options: fm.fetchOption[]): Promise<CreateMachineGroupResponse> {
+    return fm.fetchReq<CreateMachineGroupRequest, CreateMachineGroupResponse>("POST", `/management.ManagementService/CreateMachineGroup`, req, ...options)
+  }
+  static LabelMachineGroup(req: LabelMachineGroupRequest, ...options: fm.fetchOption[]): Promise<MachineGroupOperationResponse> {
+    return fm.fetchReq<LabelMachineGroupRequest, MachineGroupOperationResponse>("POST", `/management.ManagementService/LabelMachineGroup`, req, ...options)
+  }
+  static RebootMachineGroup(req: RebootMachineGroupRequest, ...options: fm.fetchOption[]): Promise<MachineGroupOperationResponse> {
+    return fm.fetchReq<RebootMachineGroupRequest, MachineGroupOperationResponse>("POST", `/management.ManagementService/RebootMachineGroup`, req, ...options)
+  }
+  static GetMachinePatchOrder(req: GetMachinePatchOrderRequest, ...options: fm.fetchOption[]): Promise<GetMachinePatchOrderResponse> {
+    return fm.fetchReq<GetMachinePatchOrderRequest, GetMachinePatchOrderResponse>("POST", `/management.ManagementService/GetMachinePatchOrder`, req, ...options)
+  }
+  static SetKubernetesUpgradeWindow(req: SetKubernetesUpgradeWindowRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetKubernetesUpgradeWindowRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/SetKubernetesUpgradeWindow`, req, ...options)
+  }
+  static DeleteKubernetesUpgradeWindow(req: DeleteKubernetesUpgradeWindowRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<DeleteKubernetesUpgradeWindowRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/DeleteKubernetesUpgradeWindow`, req, ...options)
+  }
+  static DecommissionMachine(req: DecommissionMachineRequest, entityNotifier?: fm.NotifyStreamEntityArrival<DecommissionMachineResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<DecommissionMachineRequest, DecommissionMachineResponse>("POST", `/management.ManagementService/DecommissionMachine`, req, entityNotifier, ...options)
+  }
+  static ListMachinesByTalosVersion(req: ListMachinesByTalosVersionRequest, ...options: fm.fetchOption[]): Promise<ListMachinesByTalosVersionResponse> {
+    return fm.fetchReq<ListMachinesByTalosVersionRequest, ListMachinesByTalosVersionResponse>("POST", `/management.ManagementService/ListMachinesByTalosVersion`, req, ...options)
+  }
+  static GetMachineDmesg(req: GetMachineDmesgRequest, entityNotifier?: fm.NotifyStreamEntityArrival<CommonCommon.Data>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<GetMachineDmesgRequest, CommonCommon.Data>("POST", `/management.ManagementService/GetMachineDmesg`, req, entityNotifier, ...options)
+  }
+  static SimulateAccessPolicy(req: SimulateAccessPolicyRequest, ...options: fm.fetchOption[]): Promise<SimulateAccessPolicyResponse> {
+    return fm.fetchReq<SimulateAccessPolicyRequest, SimulateAccessPolicyResponse>("POST", `/management.ManagementService/SimulateAccessPolicy`, req, ...options)
+  }
+  static GetKubeletStatus(req: GetKubeletStatusRequest, ...options: fm.fetchOption[]): Promise<GetKubeletStatusResponse> {
+    return fm.fetchReq<GetKubeletStatusRequest, GetKubeletStatusResponse>("POST", `/management.ManagementService/GetKubeletStatus`, req, ...options)
+  }
+  static GetInstallDiskSelection(req: GetInstallDiskSelectionRequest, ...options: fm.fetchOption[]): Promise<GetInstallDiskSelectionResponse> {
+    return fm.fetchReq<GetInstallDiskSelectionRequest, GetInstallDiskSelectionResponse>("POST", `/management.ManagementService/GetInstallDiskSelection`, req, ...options)
+  }
+  static CreateSchematics(req: CreateSchematicsRequest, ...options: fm.fetchOption[]): Promise<CreateSchematicsResponse> {
+    return fm.fetchReq<CreateSchematicsRequest, CreateSchematicsResponse>("POST", `/management.ManagementService/CreateSchematics`, req, ...options)
+  }
+  static CompareSchematicToBaseline(req: CompareSchematicToBaselineRequest, ...options: fm.fetchOption[]): Promise<CompareSchematicToBaselineResponse> {
+    return fm.fetchReq<CompareSchematicToBaselineRequest, CompareSchematicToBaselineResponse>("POST", `/management.ManagementService/CompareSchematicToBaseline`, req, ...options)
+  }
+  static GetMachineStatusAt(req: GetMachineStatusAtRequest, ...options: fm.fetchOption[]): Promise<GetMachineStatusAtResponse> {
+    return fm.fetchReq<GetMachineStatusAtRequest, GetMachineStatusAtResponse>("POST", `/management.ManagementService/GetMachineStatusAt`, req, ...options)
+  }
+  static ValidateUpgradeImageReachability(req: ValidateUpgradeImageReachabilityRequest, ...options: fm.fetchOption[]): Promise<ValidateUpgradeImageReachabilityResponse> {
+    return fm.fetchReq<ValidateUpgradeImageReachabilityRequest, ValidateUpgradeImageReachabilityResponse>("POST", `/management.ManagementService/ValidateUpgradeImageReachability`, req, ...options)
+  }
+  static ExportServiceAccounts(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ExportServiceAccountsResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ExportServiceAccountsResponse>("POST", `/management.ManagementService/ExportServiceAccounts`, req, ...options)
+  }
+  static ImportServiceAccounts(req: ImportServiceAccountsRequest, ...options: fm.fetchOption[]): Promise<ImportServiceAccountsResponse> {
+    return fm.fetchReq<ImportServiceAccountsRequest, ImportServiceAccountsResponse>("POST", `/management.ManagementService/ImportServiceAccounts`, req, ...options)
+  }
+  static ListUnhealthyMachines(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListUnhealthyMachinesResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListUnhealthyMachinesResponse>("POST", `/management.ManagementService/ListUnhealthyMachines`, req, ...options)
+  }
+  static GetClusterCapacity(req: GetClusterCapacityRequest, ...options: fm.fetchOption[]): Promise<GetClusterCapacityResponse> {
+    return fm.fetchReq<GetClusterCapacityRequest, GetClusterCapacityResponse>("POST", `/management.ManagementService/GetClusterCapacity`, req, ...options)
+  }
+  static CreateSchematicWithProgress(req: CreateSchematicRequest, entityNotifier?: fm.NotifyStreamEntityArrival<CreateSchematicProgress>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<CreateSchematicRequest, CreateSchematicProgress>("POST", `/management.ManagementService/CreateSchematicWithProgress`, req, entityNotifier, ...options)
+  }
+  static GetClockDrift(req: GetClockDriftRequest, ...options: fm.fetchOption[]): Promise<GetClockDriftResponse> {
+    return fm.fetchReq<GetClockDriftRequest, GetClockDriftResponse>("POST", `/management.ManagementService/GetClockDrift`, req, ...options)
+  }
+  static SetMachineReserved(req: SetMachineReservedRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetMachineReservedRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/SetMachineReserved`, req, ...options)
+  }
+  static ListUnallocatedMachines(req: ListUnallocatedMachinesRequest, ...options: fm.fetchOption[]): Promise<ListUnallocatedMachinesResponse> {
+    return fm.fetchReq<ListUnallocatedMachinesRequest, ListUnallocatedMachinesResponse>("POST", `/management.ManagementService/ListUnallocatedMachines`, req, ...options)
+  }
+  static GetMachineResource(req: GetMachineResourceRequest, ...options: fm.fetchOption[]): Promise<GetMachineResourceResponse> {
+    return fm.fetchReq<GetMachineResourceRequest, GetMachineResourceResponse>("POST", `/management.ManagementService/GetMachineResource`, req, ...options)
+  }
+  static OmniconfigFor(req: OmniconfigForRequest, ...options: fm.fetchOption[]): Promise<OmniconfigResponse> {
+    return fm.fetchReq<OmniconfigForRequest, OmniconfigResponse>("POST", `/management.ManagementService/OmniconfigFor`, req, ...options)
+  }
+  static ReapplyMachineConfig(req: ReapplyMachineConfigRequest, ...options: fm.fetchOption[]): Promise<ReapplyMachineConfigResponse> {
+    return fm.fetchReq<ReapplyMachineConfigRequest, ReapplyMachineConfigResponse>("POST", `/management.ManagementService/ReapplyMachineConfig`, req, ...options)
+  }
+  static ListKubernetesUpgrades(req: ListKubernetesUpgradesRequest, ...options: fm.fetchOption[]): Promise<ListKubernetesUpgradesResponse> {
+    return fm.fetchReq<ListKubernetesUpgradesRequest, ListKubernetesUpgradesResponse>("POST", `/management.ManagementService/ListKubernetesUpgrades`, req, ...options)
+  }
+  static ValidateClusterComposition(req: ValidateClusterCompositionRequest, ...options: fm.fetchOption[]): Promise<ValidateClusterCompositionResponse> {
+    return fm.fetchReq<ValidateClusterCompositionRequest, ValidateClusterCompositionResponse>("POST", `/management.ManagementService/ValidateClusterComposition`, req, ...options)
+  }
+  static StreamClusterUtilization(req: StreamClusterUtilizationRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StreamClusterUtilizationResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<StreamClusterUtilizationRequest, StreamClusterUtilizationResponse>("POST", `/management.ManagementService/StreamClusterUtilization`, req, entityNotifier, ...options)
+  }
+  static SetSchematicName(req: SetSchematicNameRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetSchematicNameRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/SetSchematicName`, req, ...options)
+  }
+  static ValidateOmniEndpoint(req: ValidateOmniEndpointRequest, ...options: fm.fetchOption[]): Promise<ValidateOmniEndpointResponse> {
+    return fm.fetchReq<ValidateOmniEndpointRequest, ValidateOmniEndpointResponse>("POST", `/management.ManagementService/ValidateOmniEndpoint`, req, ...options)
+  }
+  static DiffSchematics(req: DiffSchematicsRequest, ...options: fm.fetchOption[]): Promise<DiffSchematicsResponse> {
+    return fm.fetchReq<DiffSchematicsRequest, DiffSchematicsResponse>("POST", `/management.ManagementService/DiffSchematics`, req, ...options)
+  }
+  static ListInvalidSchematicMachines(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListInvalidSchematicMachinesResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListInvalidSchematicMachinesResponse>("POST", `/management.ManagementService/ListInvalidSchematicMachines`, req, ...options)
+  }
+  static GetMachineStatusJSON(req: GetMachineStatusJSONRequest, ...options: fm.fetchOption[]): Promise<GetMachineStatusJSONResponse> {
+    return fm.fetchReq<GetMachineStatusJSONRequest, GetMachineStatusJSONResponse>("POST", `/management.ManagementService/GetMachineStatusJSON`, req, ...options)
+  }
+  static ConfirmServiceAccountKey(req: ConfirmServiceAccountKeyRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<ConfirmServiceAccountKeyRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/ConfirmServiceAccountKey`, req, ...options)
+  }
+  static QueryMachineStatuses(req: QueryMachineStatusesRequest, ...options: fm.fetchOption[]): Promise<QueryMachineStatusesResponse> {
+    return fm.fetchReq<QueryMachineStatusesRequest, QueryMachineStatusesResponse>("POST", `/management.ManagementService/QueryMachineStatuses`, req, ...options)
+  }
+  static GetPendingMachineConfig(req: GetPendingMachineConfigRequest, ...options: fm.fetchOption[]): Promise<GetPendingMachineConfigResponse> {
+    return fm.fetchReq<GetPendingMachineConfigRequest, GetPendingMachineConfigResponse>("POST", `/management.ManagementService/GetPendingMachineConfig`, req, ...options)
+  }
+  static ListAllServiceAccountKeys(req: ListAllServiceAccountKeysRequest, ...options: fm.fetchOption[]): Promise<ListAllServiceAccountKeysResponse> {
+    return fm.fetchReq<ListAllServiceAccountKeysRequest, ListAllServiceAccountKeysResponse>("POST", `/management.ManagementService/ListAllServiceAccountKeys`, req, ...options)
+  }
+  static SetMachineLogRetention(req: SetMachineLogRetentionRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<SetMachineLogRetentionRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/SetMachineLogRetention`, req, ...options)
+  }
+  static ValidateClusterMembership(req: ValidateClusterMembershipRequest, ...options: fm.fetchOption[]): Promise<ValidateClusterMembershipResponse> {
+    return fm.fetchReq<ValidateClusterMembershipRequest, ValidateClusterMembershipResponse>("POST", `/management.ManagementService/ValidateClusterMembership`, req, ...options)
+  }
+  static GetJoinKernelArgs(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<GetJoinKernelArgsResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, GetJoinKernelArgsResponse>("POST", `/management.ManagementService/GetJoinKernelArgs`, req, ...options)
+  }
+  static GetAvailableExtensionUpdates(req: GetAvailableExtensionUpdatesRequest, ...options: fm.fetchOption[]): Promise<GetAvailableExtensionUpdatesResponse> {
+    return fm.fetchReq<GetAvailableExtensionUpdatesRequest, GetAvailableExtensionUpdatesResponse>("POST", `/management.ManagementService/GetAvailableExtensionUpdates`, req, ...options)
+  }
+  static ValidateSchematicUpgrade(req: ValidateSchematicUpgradeRequest, ...options: fm.fetchOption[]): Promise<ValidateSchematicUpgradeResponse> {
+    return fm.fetchReq<ValidateSchematicUpgradeRequest, ValidateSchematicUpgradeResponse>("POST", `/management.ManagementService/ValidateSchematicUpgrade`, req, ...options)
+  }
+  static GetClusterTopology(req: GetClusterTopologyRequest, ...options: fm.fetchOption[]): Promise<GetClusterTopologyResponse> {
+    return fm.fetchReq<GetClusterTopologyRequest, GetClusterTopologyResponse>("POST", `/management.ManagementService/GetClusterTopology`, req, ...options)
+  }
+  static ListMachinesByUptime(req: ListMachinesByUptimeRequest, ...options: fm.fetchOption[]): Promise<ListMachinesByUptimeResponse> {
+    return fm.fetchReq<ListMachinesByUptimeRequest, ListMachinesByUptimeResponse>("POST", `/management.ManagementService/ListMachinesByUptime`, req, ...options)
+  }
+  static ApplyMaintenanceConfig(req: ApplyMaintenanceConfigRequest, ...options: fm.fetchOption[]): Promise<ApplyMaintenanceConfigResponse> {
+    return fm.fetchReq<ApplyMaintenanceConfigRequest, ApplyMaintenanceConfigResponse>("POST", `/management.ManagementService/ApplyMaintenanceConfig`, req, ...options)
+  }
+  static ListSchematicsWithStaleArgs(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListSchematicsWithStaleArgsResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListSchematicsWithStaleArgsResponse>("POST", `/management.ManagementService/ListSchematicsWithStaleArgs`, req, ...options)
+  }
+  static DestroyServiceAccounts(req: DestroyServiceAccountsRequest, ...options: fm.fetchOption[]): Promise<DestroyServiceAccountsResponse> {
+    return fm.fetchReq<DestroyServiceAccountsRequest, DestroyServiceAccountsResponse>("POST", `/management.ManagementService/DestroyServiceAccounts`, req, ...options)
+  }
+  static GetConfigSchema(req: GetConfigSchemaRequest, ...options: fm.fetchOption[]): Promise<GetConfigSchemaResponse> {
+    return fm.fetchReq<GetConfigSchemaRequest, GetConfigSchemaResponse>("POST", `/management.ManagementService/GetConfigSchema`, req, ...options)
+  }
+  static GetMachineEvents(req: GetMachineEventsRequest, ...options: fm.fetchOption[]): Promise<GetMachineEventsResponse> {
+    return fm.fetchReq<GetMachineEventsRequest, GetMachineEventsResponse>("POST", `/management.ManagementService/GetMachineEvents`, req, ...options)
+  }
+  static ValidateMetaValues(req: ValidateMetaValuesRequest, ...options: fm.fetchOption[]): Promise<ValidateMetaValuesResponse> {
+    return fm.fetchReq<ValidateMetaValuesRequest, ValidateMetaValuesResponse>("POST", `/management.ManagementService/ValidateMetaValues`, req, ...options)
+  }
+  static WhoAmI(req: WhoAmIRequest, ...options: fm.fetchOption[]): Promise<WhoAmIResponse> {
+    return fm.fetchReq<WhoAmIRequest, WhoAmIResponse>("POST", `/management.ManagementService/WhoAmI`, req, ...options)
+  }
+  static GetExtensionUsage(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<GetExtensionUsageResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, GetExtensionUsageResponse>("POST", `/management.ManagementService/GetExtensionUsage`, req, ...options)
+  }
+  static GetSchematicISOURL(req: GetSchematicISOURLRequest, ...options: fm.fetchOption[]): Promise<GetSchematicISOURLResponse> {
+    return fm.fetchReq<GetSchematicISOURLRequest, GetSchematicISOURLResponse>("POST", `/management.ManagementService/GetSchematicISOURL`, req, ...options)
+  }
+  static ListMachinesWithHardwareChanges(req: ListMachinesWithHardwareChangesRequest, ...options: fm.fetchOption[]): Promise<ListMachinesWithHardwareChangesResponse> {
+    return fm.fetchReq<ListMachinesWithHardwareChangesRequest, ListMachinesWithHardwareChangesResponse>("POST", `/management.ManagementService/ListMachinesWithHardwareChanges`, req, ...options)
+  }
+  static TestServiceAccount(req: TestServiceAccountRequest, ...options: fm.fetchOption[]): Promise<TestServiceAccountResponse> {
+    return fm.fetchReq<TestServiceAccountRequest, TestServiceAccountResponse>("POST", `/management.ManagementService/TestServiceAccount`, req, ...options)
+  }
+  static WatchUpgradeProgress(req: WatchUpgradeProgressRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchUpgradeProgressResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<WatchUpgradeProgressRequest, WatchUpgradeProgressResponse>("POST", `/management.ManagementService/WatchUpgradeProgress`, req, entityNotifier, ...options)
+  }
+  static GetMachineStatusTimeSeries(req: GetMachineStatusTimeSeriesRequest, ...options: fm.fetchOption[]): Promise<GetMachineStatusTimeSeriesResponse> {
+    return fm.fetchReq<GetMachineStatusTimeSeriesRequest, GetMachineStatusTimeSeriesResponse>("POST", `/management.ManagementService/GetMachineStatusTimeSeries`, req, ...options)
+  }
+  static ListMachinesPendingReboot(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListMachinesPendingRebootResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListMachinesPendingRebootResponse>("POST", `/management.ManagementService/ListMachinesPendingReboot`, req, ...options)
+  }
+  static ValidateClusterConfigs(req: ValidateClusterConfigsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ValidateClusterConfigsResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ValidateClusterConfigsRequest, ValidateClusterConfigsResponse>("POST", `/management.ManagementService/ValidateClusterConfigs`, req, entityNotifier, ...options)
+  }
 }
\ No newline at end of file
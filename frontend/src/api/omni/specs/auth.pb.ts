@@ -56,6 +56,7 @@ export type PublicKeySpec = {
   confirmed?: boolean
   identity?: Identity
   role?: string
+  retire_at?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type AccessPolicyUserGroupUser = {
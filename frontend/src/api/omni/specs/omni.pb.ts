@@ -46,6 +46,26 @@ export enum MachineStatusSpecRole {
   WORKER = 2,
 }
 
+export enum MachineStatusSpecPollStatus {
+  UNKNOWN = 0,
+  DATA = 1,
+  EMPTY = 2,
+  ERROR = 3,
+}
+
+export enum MachineStatusSpecBootStatusMethod {
+  UNKNOWN = 0,
+  DISK = 1,
+  PXE = 2,
+  ISO = 3,
+}
+
+export enum MachineStatusSpecRebootStatusState {
+  NONE = 0,
+  PENDING = 1,
+  REBOOTING = 2,
+}
+
 export enum EtcdBackupStatusSpecStatus {
   Unknown = 0,
   Ok = 1,
@@ -104,6 +124,12 @@ export enum ControlPlaneStatusSpecConditionSeverity {
   Error = 2,
 }
 
+export enum KubernetesStatusSpecDNSStatusHealth {
+  UNKNOWN = 0,
+  HEALTHY = 1,
+  UNHEALTHY = 2,
+}
+
 export enum KubernetesUpgradeStatusSpecPhase {
   Unknown = 0,
   Upgrading = 1,
@@ -112,6 +138,14 @@ export enum KubernetesUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum KubernetesUpgradeHistorySpecUpgradeOutcome {
+  IN_PROGRESS = 0,
+  DONE = 1,
+  FAILED = 2,
+  REVERTED = 3,
+  CANCELED = 4,
+}
+
 export enum SchematicConfigurationSpecTarget {
   Unknown = 0,
   ClusterMachine = 1,
@@ -150,11 +184,21 @@ export type MachineStatusSpecHardwareStatusBlockDevice = {
   system_disk?: boolean
 }
 
+export type MachineStatusSpecHardwareStatusFirmware = {
+  vendor?: string
+  product?: string
+  serial_number?: string
+  bios_vendor?: string
+  bios_version?: string
+  bios_date?: string
+}
+
 export type MachineStatusSpecHardwareStatus = {
   processors?: MachineStatusSpecHardwareStatusProcessor[]
   memory_modules?: MachineStatusSpecHardwareStatusMemoryModule[]
   blockdevices?: MachineStatusSpecHardwareStatusBlockDevice[]
   arch?: string
+  firmware?: MachineStatusSpecHardwareStatusFirmware
 }
 
 export type MachineStatusSpecNetworkStatusNetworkLinkStatus = {
@@ -163,6 +207,8 @@ export type MachineStatusSpecNetworkStatusNetworkLinkStatus = {
   speed_mbps?: number
   link_up?: boolean
   description?: string
+  admin_up?: boolean
+  down_since?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type MachineStatusSpecNetworkStatus = {
@@ -171,6 +217,7 @@ export type MachineStatusSpecNetworkStatus = {
   addresses?: string[]
   default_gateways?: string[]
   network_links?: MachineStatusSpecNetworkStatusNetworkLinkStatus[]
+  siderolink_address?: string
 }
 
 export type MachineStatusSpecPlatformMetadata = {
@@ -184,9 +231,55 @@ export type MachineStatusSpecPlatformMetadata = {
   spot?: boolean
 }
 
+export type MachineStatusSpecSchematicInstalledExtension = {
+  name?: string
+  version?: string
+}
+
 export type MachineStatusSpecSchematic = {
   id?: string
   invalid?: boolean
+  extensions?: string[]
+  installed_extensions?: MachineStatusSpecSchematicInstalledExtension[]
+}
+
+export type MachineStatusSpecBootStatus = {
+  method?: MachineStatusSpecBootStatusMethod
+  installed?: boolean
+}
+
+export type MachineStatusSpecClockStatus = {
+  drift?: GoogleProtobufDuration.Duration
+}
+
+export type MachineStatusSpecEncryptionStatusVolume = {
+  name?: string
+  encrypted?: boolean
+  providers?: string[]
+}
+
+export type MachineStatusSpecEncryptionStatus = {
+  volumes?: MachineStatusSpecEncryptionStatusVolume[]
+}
+
+export type MachineStatusSpecKubernetesNodeStatus = {
+  nodename?: string
+  ready?: boolean
+}
+
+export type MachineStatusSpecInstallImage = {
+  image?: string
+  installed?: boolean
+}
+
+export type MachineStatusSpecHardwareChange = {
+  changed_at?: GoogleProtobufTimestamp.Timestamp
+  changes?: string[]
+}
+
+export type MachineStatusSpecRebootStatus = {
+  state?: MachineStatusSpecRebootStatusState
+  reason?: string
 }
 
 export type MachineStatusSpec = {
@@ -202,6 +295,17 @@ export type MachineStatusSpec = {
   platform_metadata?: MachineStatusSpecPlatformMetadata
   image_labels?: {[key: string]: string}
   schematic?: MachineStatusSpecSchematic
+  kernel_cmdline?: string
+  poll_statuses?: {[key: string]: MachineStatusSpecPollStatus}
+  boot_status?: MachineStatusSpecBootStatus
+  clock_status?: MachineStatusSpecClockStatus
+  encryption_status?: MachineStatusSpecEncryptionStatus
+  connectivity_changed_at?: GoogleProtobufTimestamp.Timestamp
+  kubernetes_node_status?: MachineStatusSpecKubernetesNodeStatus
+  install_image?: MachineStatusSpecInstallImage
+  disconnect_duration?: GoogleProtobufDuration.Duration
+  hardware_change?: MachineStatusSpecHardwareChange
+  reboot_status?: MachineStatusSpecRebootStatus
 }
 
 export type TalosConfigSpec = {
@@ -213,6 +317,7 @@ export type TalosConfigSpec = {
 export type ClusterSpecFeatures = {
   enable_workload_proxy?: boolean
   disk_encryption?: boolean
+  require_kubernetes_upgrade_pre_checks?: boolean
 }
 
 export type ClusterSpec = {
@@ -441,6 +546,7 @@ export type TalosUpgradeStatusSpec = {
   last_upgrade_version?: string
   current_upgrade_version?: string
   upgrade_versions?: string[]
+  pre_checks_version?: string
 }
 
 export type MachineSetStatusSpec = {
@@ -458,8 +564,21 @@ export type MachineSetNodeSpec = {
 export type MachineLabelsSpec = {
 }
 
+export type MachineReservationSpec = {
+}
+
+export type MachineLogRetentionSpec = {
+  max_size_bytes?: string
+}
+
+export type MachineStatusSnapshotSpecEvent = {
+  received_at?: GoogleProtobufTimestamp.Timestamp
+  machine_status?: MachineMachine.MachineStatusEvent
+}
+
 export type MachineStatusSnapshotSpec = {
   machine_status?: MachineMachine.MachineStatusEvent
+  recent_events?: MachineStatusSnapshotSpecEvent[]
 }
 
 export type ControlPlaneStatusSpecCondition = {
@@ -494,9 +613,21 @@ export type KubernetesStatusSpecNodeStaticPods = {
   static_pods?: KubernetesStatusSpecStaticPodStatus[]
 }
 
+export type KubernetesStatusSpecDNSStatus = {
+  health?: KubernetesStatusSpecDNSStatusHealth
+  ready_pods?: number
+  total_pods?: number
+}
+
 export type KubernetesStatusSpec = {
   nodes?: KubernetesStatusSpecNodeStatus[]
   static_pods?: KubernetesStatusSpecNodeStaticPods[]
+  dns?: KubernetesStatusSpecDNSStatus
+}
+
+export type KubernetesNodeStatusSpec = {
+  nodename?: string
+  ready?: boolean
 }
 
 export type KubernetesUpgradeStatusSpec = {
@@ -509,11 +640,49 @@ export type KubernetesUpgradeStatusSpec = {
   upgrade_versions?: string[]
 }
 
+export type KubernetesUpgradeHistorySpecUpgrade = {
+  from_version?: string
+  to_version?: string
+  started_at?: GoogleProtobufTimestamp.Timestamp
+  finished_at?: GoogleProtobufTimestamp.Timestamp
+  outcome?: KubernetesUpgradeHistorySpecUpgradeOutcome
+  error?: string
+}
+
+export type KubernetesUpgradeHistorySpec = {
+  upgrades?: KubernetesUpgradeHistorySpecUpgrade[]
+}
+
+export type KubernetesUpgradeWindowSpec = {
+  days?: number[]
+  start?: string
+  duration?: GoogleProtobufDuration.Duration
+}
+
 export type KubernetesUpgradeManifestStatusSpec = {
   out_of_sync?: number
   last_fatal_error?: string
 }
 
+export type KubernetesManifestSyncStatusSpec = {
+  manifest_hashes?: {[key: string]: string}
+  last_sync_failed?: boolean
+  failed_sync_manifest_hashes?: {[key: string]: string}
+}
+
+export type MachineStatusHistorySpecSnapshot = {
+  taken_at?: GoogleProtobufTimestamp.Timestamp
+  status?: MachineStatusSpec
+}
+
+export type MachineStatusHistorySpec = {
+  snapshots?: MachineStatusHistorySpecSnapshot[]
+}
+
+export type ImportedMachineSpec = {
+  management_address?: string
+}
+
 export type DestroyStatusSpec = {
   phase?: string
 }
@@ -551,6 +720,10 @@ export type MachineClassSpec = {
   match_labels?: string[]
 }
 
+export type MachineGroupSpec = {
+  match_labels?: string[]
+}
+
 export type MachineConfigGenOptionsSpec = {
   install_disk?: string
 }
@@ -601,6 +774,9 @@ export type ImagePullStatusSpec = {
 
 export type SchematicSpec = {
   extensions?: string[]
+  extra_kernel_args?: string[]
+  name?: string
+  description?: string
 }
 
 export type TalosExtensionsSpecInfo = {
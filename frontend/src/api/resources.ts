@@ -41,6 +41,7 @@ export const IdentityType = "Identities.omni.sidero.dev";
 export const SAMLLabelPrefix = "saml.omni.sidero.dev/";
 export const LabelIdentityUserID = "user-id";
 export const LabelIdentityTypeServiceAccount = "type-service-account";
+export const LabelIdentityInternal = "internal";
 export const PublicKeyType = "PublicKeys.omni.sidero.dev";
 export const SAMLLabelRuleType = "SAMLLabelRules.omni.sidero.dev";
 export const UserType = "Users.omni.sidero.dev";
@@ -84,9 +85,13 @@ export const FeaturesConfigType = "FeaturesConfigs.omni.sidero.dev";
 export const ImagePullRequestType = "ImagePullRequests.omni.sidero.dev";
 export const ImagePullStatusType = "ImagePullStatuses.omni.sidero.dev";
 export const InstallationMediaType = "InstallationMedias.omni.sidero.dev";
+export const KubernetesManifestSyncStatusType = "KubernetesManifestSyncStatuses.omni.sidero.dev";
+export const KubernetesNodeStatusType = "KubernetesNodeStatuses.omni.sidero.dev";
 export const KubernetesStatusType = "KubernetesStatuses.omni.sidero.dev";
+export const KubernetesUpgradeHistoryType = "KubernetesUpgradeHistories.omni.sidero.dev";
 export const KubernetesUpgradeManifestStatusType = "KubernetesUpgradeManifestStatuses.omni.sidero.dev";
 export const KubernetesUpgradeStatusType = "KubernetesUpgradeStatuses.omni.sidero.dev";
+export const KubernetesUpgradeWindowType = "KubernetesUpgradeWindows.omni.sidero.dev";
 export const KubernetesVersionType = "KubernetesVersions.omni.sidero.dev";
 export const SystemLabelPrefix = "omni.sidero.dev/";
 export const LabelControlPlaneRole = "omni.sidero.dev/role-controlplane";
@@ -99,8 +104,11 @@ export const LabelClusterMachine = "omni.sidero.dev/cluster-machine";
 export const LabelMachine = "omni.sidero.dev/machine";
 export const LabelSystemPatch = "omni.sidero.dev/system-patch";
 export const LabelExposedServiceAlias = "omni.sidero.dev/exposed-service-alias";
+export const LabelExternallyManaged = "omni.sidero.dev/externally-managed";
+export const LabelReserved = "omni.sidero.dev/reserved";
 export const MachineStatusLabelConnected = "omni.sidero.dev/connected";
 export const MachineStatusLabelDisconnected = "omni.sidero.dev/disconnected";
+export const MachineStatusLabelLongDisconnected = "omni.sidero.dev/long-disconnected";
 export const MachineStatusLabelInvalidState = "omni.sidero.dev/invalid-state";
 export const MachineStatusLabelReportingEvents = "omni.sidero.dev/reporting-events";
 export const MachineStatusLabelAvailable = "omni.sidero.dev/available";
@@ -114,11 +122,20 @@ export const MachineStatusLabelPlatform = "omni.sidero.dev/platform";
 export const MachineStatusLabelRegion = "omni.sidero.dev/region";
 export const MachineStatusLabelZone = "omni.sidero.dev/zone";
 export const MachineStatusLabelInstance = "omni.sidero.dev/instance";
+export const MachineStatusLabelInstanceID = "omni.sidero.dev/instance-id";
+export const MachineStatusLabelProviderID = "omni.sidero.dev/provider-id";
+export const MachineStatusLabelSpot = "omni.sidero.dev/spot";
+export const MachineStatusLabelUnencryptedVolume = "omni.sidero.dev/unencrypted-volume";
+export const MachineStatusLabelLinkDown = "omni.sidero.dev/link-down";
+export const MachineStatusLabelKubernetesNodeReady = "omni.sidero.dev/kubernetes-node-ready";
 export const ClusterMachineStatusLabelNodeName = "omni.sidero.dev/node-name";
 export const MachineType = "Machines.omni.sidero.dev";
 export const MachineClassType = "MachineClasses.omni.sidero.dev";
+export const MachineGroupType = "MachineGroups.omni.sidero.dev";
 export const MachineConfigGenOptionsType = "MachineConfigGenOptions.omni.sidero.dev";
 export const MachineLabelsType = "MachineLabels.omni.sidero.dev";
+export const MachineLogRetentionType = "MachineLogRetentions.omni.sidero.dev";
+export const MachineReservationType = "MachineReservations.omni.sidero.dev";
 export const ControlPlanesIDSuffix = "control-planes";
 export const DefaultWorkersIDSuffix = "workers";
 export const MachineSetType = "MachineSets.omni.sidero.dev";
@@ -126,6 +143,7 @@ export const MachineSetDestroyStatusType = "MachineSetDestroyStatuses.omni.sider
 export const MachineSetNodeType = "MachineSetNodes.omni.sidero.dev";
 export const MachineSetStatusType = "MachineSetStatuses.omni.sidero.dev";
 export const MachineStatusType = "MachineStatuses.omni.sidero.dev";
+export const MachineStatusHistoryType = "MachineStatusHistories.omni.sidero.dev";
 export const MachineStatusLinkType = "MachineStatusLinks.omni.sidero.dev";
 export const MachineStatusSnapshotType = "MachineStatusSnapshots.omni.sidero.dev";
 export const OngoingTaskType = "OngoingTasks.omni.sidero.dev";
@@ -0,0 +1,145 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// SimulateAccessPolicy returns the role the user would get on the cluster with the candidate access policy, without storing the policy.
+//
+// If the policy override is not set, the stored access policy is used.
+func (s *managementServer) SimulateAccessPolicy(ctx context.Context, req *management.SimulateAccessPolicyRequest) (*management.SimulateAccessPolicyResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Admin); err != nil {
+		return nil, err
+	}
+
+	if req.GetIdentity() == "" {
+		return nil, status.Error(codes.InvalidArgument, "identity is required")
+	}
+
+	if req.GetClusterName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, req.GetIdentity()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "identity %q not found", req.GetIdentity())
+		}
+
+		return nil, err
+	}
+
+	user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+	if err != nil {
+		return nil, err
+	}
+
+	userRole, err := role.Parse(user.TypedSpec().Value.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	var accessPolicy *authres.AccessPolicy
+
+	if req.GetPolicyOverride() != nil {
+		accessPolicy = authres.NewAccessPolicy()
+		accessPolicy.TypedSpec().Value = req.GetPolicyOverride()
+	} else {
+		accessPolicy, err = safe.StateGet[*authres.AccessPolicy](ctx, s.omniState, authres.NewAccessPolicy().Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	// simulate the request context of the user being checked
+	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, identity.Metadata().ID())
+	ctx = context.WithValue(ctx, auth.RoleContextKey{}, userRole)
+
+	clusterRole, matchesAllClusters, err := accesspolicy.RoleForClusterWithPolicy(ctx, req.GetClusterName(), s.omniState, accessPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to check the access policy: %s", err)
+	}
+
+	return &management.SimulateAccessPolicyResponse{
+		Role:               string(clusterRole),
+		MatchesAllClusters: matchesAllClusters,
+	}, nil
+}
+
+// WhoAmI returns the identity and the role of the caller, optionally with the effective role on each cluster the caller can access.
+func (s *managementServer) WhoAmI(ctx context.Context, req *management.WhoAmIRequest) (*management.WhoAmIResponse, error) {
+	// any authenticated user is allowed to inspect their own access
+	authCheckResult, err := s.authCheckMethod(ctx, role.None)
+	if err != nil {
+		return nil, err
+	}
+
+	userRole := authCheckResult.Role
+
+	// every check passes when the authentication is disabled
+	if !authCheckResult.AuthEnabled {
+		userRole = role.Admin
+	}
+
+	response := &management.WhoAmIResponse{
+		Identity:       authCheckResult.Identity,
+		Role:           string(userRole),
+		ServiceAccount: strings.HasSuffix(authCheckResult.Identity, pkgaccess.ServiceAccountNameSuffix),
+		AuthEnabled:    authCheckResult.AuthEnabled,
+	}
+
+	if !req.GetIncludeClusterRoles() {
+		return response, nil
+	}
+
+	clusters, err := safe.StateListAll[*omni.Cluster](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	// the list is sorted by the ID, so are the cluster roles
+	for iter := clusters.Iterator(); iter.Next(); {
+		cluster := iter.Value()
+		clusterRole := userRole
+
+		if authCheckResult.AuthEnabled {
+			if clusterRole, _, err = accesspolicy.RoleForCluster(ctx, cluster.Metadata().ID(), s.omniState); err != nil {
+				return nil, err
+			}
+		}
+
+		if clusterRole.Check(role.Reader) != nil {
+			continue
+		}
+
+		response.ClusterRoles = append(response.ClusterRoles, &management.WhoAmIResponse_ClusterRole{
+			Cluster: cluster.Metadata().ID(),
+			Role:    string(clusterRole),
+		})
+	}
+
+	return response, nil
+}
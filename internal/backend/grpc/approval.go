@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// approvalRequest is the payload sent to the approval webhook.
+type approvalRequest struct {
+	Operation string `json:"operation"`
+	Identity  string `json:"identity"`
+	Target    string `json:"target"`
+}
+
+// approvalResponse is the decision returned by the approval webhook.
+type approvalResponse struct {
+	Reason   string `json:"reason"`
+	Approved bool   `json:"approved"`
+}
+
+// maxApprovalResponseSize limits the approval webhook response body.
+const maxApprovalResponseSize = 64 * 1024
+
+// requireApproval asks the approval webhook to approve the operation if the operation is gated.
+//
+// The gate fails closed: the operation is not performed if the webhook can't be reached or returns an unexpected response.
+func (s *managementServer) requireApproval(ctx context.Context, operation, identity, target string) error {
+	approvalConfig := config.Config.ApprovalWebhook
+
+	if !approvalConfig.RequiresApproval(operation) {
+		return nil
+	}
+
+	response, err := requestApproval(ctx, approvalConfig, &approvalRequest{
+		Operation: operation,
+		Identity:  identity,
+		Target:    target,
+	})
+	if err != nil {
+		s.logger.Warn("approval webhook failed", zap.String("operation", operation), zap.String("target", target), zap.Error(err))
+
+		return status.Errorf(codes.Unavailable, "failed to get the approval for %s: %s", operation, err)
+	}
+
+	s.logger.Info("approval webhook decision",
+		zap.String("operation", operation),
+		zap.String("target", target),
+		zap.String("identity", identity),
+		zap.Bool("approved", response.Approved),
+		zap.String("reason", response.Reason),
+	)
+
+	if !response.Approved {
+		reason := response.Reason
+		if reason == "" {
+			reason = "no reason given"
+		}
+
+		return status.Errorf(codes.PermissionDenied, "%s was denied by the approver: %s", operation, reason)
+	}
+
+	return nil
+}
+
+func requestApproval(ctx context.Context, approvalConfig config.ApprovalWebhookParams, request *approvalRequest) (*approvalResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, approvalConfig.Timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, approvalConfig.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("approval webhook responded with status %d", resp.StatusCode)
+	}
+
+	var response approvalResponse
+
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxApprovalResponseSize)).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode the approval webhook response: %w", err)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetClusterCapacity sums the hardware reported by the cluster machines.
+func (s *managementServer) GetClusterCapacity(ctx context.Context, req *management.GetClusterCapacityRequest) (*management.GetClusterCapacityResponse, error) {
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the capacity is derived from the machine statuses
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName)))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.GetClusterCapacityResponse{
+		Total: &management.GetClusterCapacityResponse_Capacity{},
+		Nodes: make([]*management.GetClusterCapacityResponse_Node, 0, machineStatuses.Len()),
+	}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		node := &management.GetClusterCapacityResponse_Node{
+			MachineId: iter.Value().Metadata().ID(),
+		}
+
+		response.Nodes = append(response.Nodes, node)
+
+		hardware := iter.Value().TypedSpec().Value.GetHardware()
+		if hardware == nil {
+			node.HardwareUnknown = true
+
+			continue
+		}
+
+		node.Capacity = hardwareCapacity(hardware)
+
+		response.Total.CpuCores += node.Capacity.CpuCores
+		response.Total.CpuThreads += node.Capacity.CpuThreads
+		response.Total.MemoryMb += node.Capacity.MemoryMb
+		response.Total.DiskBytes += node.Capacity.DiskBytes
+	}
+
+	return response, nil
+}
+
+// hardwareCapacity sums the processors, memory modules and disks of the machine.
+func hardwareCapacity(hardware *specs.MachineStatusSpec_HardwareStatus) *management.GetClusterCapacityResponse_Capacity {
+	capacity := &management.GetClusterCapacityResponse_Capacity{}
+
+	for _, processor := range hardware.GetProcessors() {
+		capacity.CpuCores += processor.GetCoreCount()
+		capacity.CpuThreads += processor.GetThreadCount()
+	}
+
+	for _, memoryModule := range hardware.GetMemoryModules() {
+		capacity.MemoryMb += uint64(memoryModule.GetSizeMb())
+	}
+
+	for _, blockDevice := range hardware.GetBlockdevices() {
+		capacity.DiskBytes += blockDevice.GetSize()
+	}
+
+	return capacity
+}
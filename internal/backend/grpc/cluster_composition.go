@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// machineRequirements are the minimum hardware requirements of the Talos node.
+type machineRequirements struct {
+	cpuCores  uint32
+	memoryMB  uint64
+	diskBytes uint64
+}
+
+var (
+	controlPlaneRequirements = machineRequirements{cpuCores: 2, memoryMB: 2048, diskBytes: 10 * 1000 * 1000 * 1000}
+	workerRequirements       = machineRequirements{cpuCores: 1, memoryMB: 1024, diskBytes: 10 * 1000 * 1000 * 1000}
+)
+
+// unmet returns the requirements the hardware doesn't meet.
+//
+// The processors and the memory modules are not reported by some platforms, so the missing information is not treated as a failure.
+func (r machineRequirements) unmet(hardware *specs.MachineStatusSpec_HardwareStatus) []string {
+	capacity := hardwareCapacity(hardware)
+
+	var unmet []string
+
+	if capacity.CpuCores > 0 && capacity.CpuCores < r.cpuCores {
+		unmet = append(unmet, fmt.Sprintf("%d CPU cores", r.cpuCores))
+	}
+
+	if capacity.MemoryMb > 0 && capacity.MemoryMb < r.memoryMB {
+		unmet = append(unmet, fmt.Sprintf("%d MiB of memory", r.memoryMB))
+	}
+
+	// Talos is installed to a single disk
+	var largestDisk uint64
+
+	for _, blockDevice := range hardware.GetBlockdevices() {
+		largestDisk = max(largestDisk, blockDevice.GetSize())
+	}
+
+	if largestDisk < r.diskBytes {
+		unmet = append(unmet, fmt.Sprintf("a %d GB disk", r.diskBytes/(1000*1000*1000)))
+	}
+
+	return unmet
+}
+
+// ValidateClusterComposition checks that the cluster can be created from the machines.
+//
+// The machines should be connected and free, have the same architecture and meet the minimum hardware requirements.
+func (s *managementServer) ValidateClusterComposition(ctx context.Context, req *management.ValidateClusterCompositionRequest) (*management.ValidateClusterCompositionResponse, error) {
+	machineIDs := req.GetMachineIds()
+	if len(machineIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "machine ids are required")
+	}
+
+	if req.GetControlPlaneCount() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "control plane count must be positive")
+	}
+
+	for i, machineID := range machineIDs {
+		if slices.Contains(machineIDs[:i], machineID) {
+			return nil, status.Errorf(codes.InvalidArgument, "machine %q is selected more than once", machineID)
+		}
+	}
+
+	// the checks are based on the machine statuses
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	var (
+		reasons                []string
+		controlPlaneCandidates int
+	)
+
+	machinesByArch := map[string][]string{}
+
+	for _, machineID := range machineIDs {
+		machineStatus, err := safe.StateGet[*omnires.MachineStatus](ctx, s.omniState, omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				reasons = append(reasons, fmt.Sprintf("machine %q not found", machineID))
+
+				continue
+			}
+
+			return nil, err
+		}
+
+		spec := machineStatus.TypedSpec().Value
+
+		if !spec.GetConnected() {
+			reasons = append(reasons, fmt.Sprintf("machine %q is not connected", machineID))
+		}
+
+		if spec.GetCluster() != "" {
+			reasons = append(reasons, fmt.Sprintf("machine %q is already used in the cluster %q", machineID, spec.GetCluster()))
+		}
+
+		hardware := spec.GetHardware()
+		if hardware == nil {
+			reasons = append(reasons, fmt.Sprintf("machine %q didn't report the hardware information yet", machineID))
+
+			continue
+		}
+
+		if arch := hardware.GetArch(); arch != "" {
+			machinesByArch[arch] = append(machinesByArch[arch], machineID)
+		}
+
+		if unmet := workerRequirements.unmet(hardware); len(unmet) > 0 {
+			reasons = append(reasons, fmt.Sprintf("machine %q doesn't meet the minimum requirements, it needs %s", machineID, strings.Join(unmet, ", ")))
+
+			continue
+		}
+
+		if len(controlPlaneRequirements.unmet(hardware)) == 0 {
+			controlPlaneCandidates++
+		}
+	}
+
+	controlPlaneCount := int(req.GetControlPlaneCount())
+
+	switch {
+	case controlPlaneCount > len(machineIDs):
+		reasons = append(reasons, fmt.Sprintf("%d control plane nodes are requested, but only %d machines are selected", controlPlaneCount, len(machineIDs)))
+	case controlPlaneCount > controlPlaneCandidates:
+		reasons = append(reasons, fmt.Sprintf("%d control plane nodes are requested, but only %d machines meet the control plane requirements",
+			controlPlaneCount, controlPlaneCandidates))
+	}
+
+	if len(machinesByArch) > 1 {
+		archs := maps.Keys(machinesByArch)
+		slices.Sort(archs)
+
+		groups := make([]string, 0, len(archs))
+
+		for _, arch := range archs {
+			groups = append(groups, fmt.Sprintf("%s (%s)", arch, strings.Join(machinesByArch[arch], ", ")))
+		}
+
+		reasons = append(reasons, "machines have different architectures: "+strings.Join(groups, ", "))
+	}
+
+	return &management.ValidateClusterCompositionResponse{
+		Valid:   len(reasons) == 0,
+		Reasons: reasons,
+	}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ValidateClusterConfigs validates the configs of all machines of the cluster and streams the result for each machine.
+//
+// The generated config contains the fields controlled by Omni, so the config patches merged into it are validated instead,
+// along with the config generation error.
+func (s *managementServer) ValidateClusterConfigs(req *management.ValidateClusterConfigsRequest, srv management.ManagementService_ValidateClusterConfigsServer) error {
+	ctx := srv.Context()
+
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// the report is meant to be acted upon, so it requires the same role as fixing the config patches
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return err
+	}
+
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName))
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return err
+	}
+
+	configPatches, err := listByID[*omnires.ClusterMachineConfigPatches](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return err
+	}
+
+	machineConfigs, err := listByID[*omnires.ClusterMachineConfig](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return err
+	}
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		machineID := iter.Value().Metadata().ID()
+
+		result := &management.ValidateClusterConfigsResponse{
+			MachineId: machineID,
+		}
+
+		if patches := configPatches[machineID]; patches != nil {
+			for i, patch := range patches.TypedSpec().Value.GetPatches() {
+				if err = omnires.ValidateConfigPatch(patch); err != nil {
+					result.Errors = append(result.Errors, validationErrors(fmt.Sprintf("config patch %d", i), err)...)
+				}
+			}
+		}
+
+		if machineConfig := machineConfigs[machineID]; machineConfig != nil {
+			if generationError := machineConfig.TypedSpec().Value.GetGenerationError(); generationError != "" {
+				result.Errors = append(result.Errors, fmt.Sprintf("config generation failed: %s", generationError))
+			}
+		}
+
+		if err = srv.Send(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validationErrors flattens the validation error into the separate error messages prefixed with the source.
+func validationErrors(source string, err error) []string {
+	var multiErr *multierror.Error
+
+	errs := []error{err}
+
+	if errors.As(err, &multiErr) {
+		errs = multiErr.WrappedErrors()
+	}
+
+	messages := make([]string, 0, len(errs))
+
+	for _, err := range errs {
+		messages = append(messages, fmt.Sprintf("%s: %s", source, err))
+	}
+
+	return messages
+}
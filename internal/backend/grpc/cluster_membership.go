@@ -0,0 +1,298 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	commonOmni "github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// clusterMembershipCheckTimeout limits the time spent on querying the etcd and Kubernetes membership.
+const clusterMembershipCheckTimeout = 10 * time.Second
+
+// clusterMembership is the cluster membership as it is recorded in the Omni resources.
+type clusterMembership struct {
+	clusterMachines map[string]*omnires.ClusterMachine
+	identities      map[string]*omnires.ClusterMachineIdentity
+	issues          []*management.ValidateClusterMembershipResponse_Issue
+}
+
+func (m *clusterMembership) addIssue(machineID, format string, args ...any) {
+	m.issues = append(m.issues, &management.ValidateClusterMembershipResponse_Issue{
+		MachineId:   machineID,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateClusterMembership cross-checks the cluster membership recorded in the resource labels,
+// the cluster machine identities and the actual etcd and Kubernetes membership of the nodes.
+//
+// The checks which require access to the cluster are skipped if the cluster is not reachable.
+func (s *managementServer) ValidateClusterMembership(ctx context.Context, req *management.ValidateClusterMembershipRequest) (*management.ValidateClusterMembershipResponse, error) {
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the report is meant to be acted upon, so it requires the same role as fixing the issues
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	membership, err := s.validateClusterLabels(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.ValidateClusterMembershipResponse{}
+
+	checkCtx, cancel := context.WithTimeout(ctx, clusterMembershipCheckTimeout)
+	defer cancel()
+
+	if err = validateEtcdMembership(checkCtx, clusterName, membership); err != nil {
+		response.SkippedChecks = append(response.SkippedChecks, fmt.Sprintf("etcd membership: %s", err))
+	}
+
+	if err = validateKubernetesMembership(checkCtx, clusterName, membership); err != nil {
+		response.SkippedChecks = append(response.SkippedChecks, fmt.Sprintf("kubernetes membership: %s", err))
+	}
+
+	slices.SortFunc(membership.issues, func(a, b *management.ValidateClusterMembershipResponse_Issue) int {
+		return cmp.Or(cmp.Compare(a.MachineId, b.MachineId), cmp.Compare(a.Description, b.Description))
+	})
+
+	response.Issues = membership.issues
+
+	return response, nil
+}
+
+// validateClusterLabels checks that the cluster machines, their statuses and identities agree on the cluster membership.
+func (s *managementServer) validateClusterLabels(ctx context.Context, clusterName string) (*clusterMembership, error) {
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName))
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := safe.StateListAll[*omnires.ClusterMachineIdentity](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	membership := &clusterMembership{
+		clusterMachines: map[string]*omnires.ClusterMachine{},
+		identities:      map[string]*omnires.ClusterMachineIdentity{},
+	}
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		membership.clusterMachines[iter.Value().Metadata().ID()] = iter.Value()
+	}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		machineStatus := iter.Value()
+		machineID := machineStatus.Metadata().ID()
+		specCluster := machineStatus.TypedSpec().Value.GetCluster()
+		labelCluster, _ := machineStatus.Metadata().Labels().Get(omnires.LabelCluster)
+
+		_, inCluster := membership.clusterMachines[machineID]
+
+		switch {
+		case inCluster && specCluster != clusterName:
+			membership.addIssue(machineID, "machine status reports cluster %q instead of %q", specCluster, clusterName)
+		case !inCluster && (specCluster == clusterName || labelCluster == clusterName):
+			membership.addIssue(machineID, "machine status claims to be in the cluster, but the machine is not allocated to it")
+		case inCluster && labelCluster != clusterName:
+			membership.addIssue(machineID, "machine status has the cluster label %q instead of %q", labelCluster, clusterName)
+		}
+	}
+
+	for iter := identities.Iterator(); iter.Next(); {
+		identity := iter.Value()
+		machineID := identity.Metadata().ID()
+
+		clusterMachine, inCluster := membership.clusterMachines[machineID]
+		if !inCluster {
+			membership.addIssue(machineID, "stale cluster machine identity, the machine is not allocated to the cluster")
+
+			continue
+		}
+
+		_, identityControlPlane := identity.Metadata().Labels().Get(omnires.LabelControlPlaneRole)
+		_, controlPlane := clusterMachine.Metadata().Labels().Get(omnires.LabelControlPlaneRole)
+
+		if identityControlPlane != controlPlane {
+			membership.addIssue(machineID, "cluster machine identity role doesn't match the cluster machine role")
+		}
+
+		membership.identities[machineID] = identity
+	}
+
+	return membership, nil
+}
+
+// validateEtcdMembership checks that the control plane machines are the etcd members and there are no unknown members.
+func validateEtcdMembership(ctx context.Context, clusterName string, membership *clusterMembership) error {
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return err
+	}
+
+	talosClient, err := talosRuntime.GetClient(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("error getting talos client: %w", err)
+	}
+
+	memberList, err := talosClient.EtcdMemberList(ctx, &machine.EtcdMemberListRequest{})
+	if err != nil {
+		return fmt.Errorf("error listing etcd members: %w", err)
+	}
+
+	members := map[uint64]string{}
+
+	for _, message := range memberList.GetMessages() {
+		for _, member := range message.GetMembers() {
+			members[member.GetId()] = member.GetHostname()
+		}
+	}
+
+	knownMembers := map[uint64]struct{}{}
+
+	for machineID, clusterMachine := range membership.clusterMachines {
+		if _, controlPlane := clusterMachine.Metadata().Labels().Get(omnires.LabelControlPlaneRole); !controlPlane {
+			continue
+		}
+
+		identity, ok := membership.identities[machineID]
+		if !ok || identity.TypedSpec().Value.GetEtcdMemberId() == 0 {
+			membership.addIssue(machineID, "control plane machine has no known etcd member id")
+
+			continue
+		}
+
+		memberID := identity.TypedSpec().Value.GetEtcdMemberId()
+		knownMembers[memberID] = struct{}{}
+
+		if _, ok = members[memberID]; !ok {
+			membership.addIssue(machineID, "etcd member %s is not in the etcd cluster", etcd.FormatMemberID(memberID))
+		}
+	}
+
+	for memberID, hostname := range members {
+		if _, ok := knownMembers[memberID]; !ok {
+			membership.addIssue("", "etcd member %s (%s) doesn't belong to any control plane machine of the cluster", etcd.FormatMemberID(memberID), hostname)
+		}
+	}
+
+	return nil
+}
+
+// validateKubernetesMembership checks that the cluster machines are the Kubernetes nodes and there are no unknown nodes.
+func validateKubernetesMembership(ctx context.Context, clusterName string, membership *clusterMembership) error {
+	type kubeConfigGetter interface {
+		GetKubeconfig(ctx context.Context, cluster *commonOmni.Context) (*rest.Config, error)
+	}
+
+	k8sRuntime, err := runtime.LookupInterface[kubeConfigGetter](kubernetes.Name)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := k8sRuntime.GetKubeconfig(ctx, &commonOmni.Context{Name: clusterName})
+	if err != nil {
+		return fmt.Errorf("error getting kubeconfig: %w", err)
+	}
+
+	clientset, err := k8s.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	nodeNames := map[string]struct{}{}
+
+	for _, node := range nodes.Items {
+		nodeNames[node.Name] = struct{}{}
+	}
+
+	knownNodes := map[string]struct{}{}
+
+	for machineID := range membership.clusterMachines {
+		identity, ok := membership.identities[machineID]
+		if !ok || identity.TypedSpec().Value.GetNodename() == "" {
+			membership.addIssue(machineID, "machine has no known Kubernetes node name")
+
+			continue
+		}
+
+		nodename := identity.TypedSpec().Value.GetNodename()
+		knownNodes[nodename] = struct{}{}
+
+		if _, ok = nodeNames[nodename]; !ok {
+			membership.addIssue(machineID, "Kubernetes node %q is not in the cluster", nodename)
+		}
+	}
+
+	for nodename := range nodeNames {
+		if _, ok := knownNodes[nodename]; !ok {
+			membership.addIssue("", "Kubernetes node %q doesn't belong to any machine of the cluster", nodename)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const (
+	topologyRoleControlPlane = "controlplane"
+	topologyRoleWorker       = "worker"
+)
+
+// GetClusterTopology returns the cluster machine sets and machines as a graph.
+//
+// The machines are linked to their machine sets, the machine sets are linked to the cluster,
+// and the worker machines are linked to the control plane machines they reach the Kubernetes API through.
+func (s *managementServer) GetClusterTopology(ctx context.Context, req *management.GetClusterTopologyRequest) (*management.GetClusterTopologyResponse, error) {
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the topology is assembled from the resources readable by the cluster readers
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName))
+
+	machineSets, err := safe.StateListAll[*omnires.MachineSet](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := safe.StateListAll[*omnires.ClusterMachineIdentity](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	nodenames := map[string]*omnires.ClusterMachineIdentity{}
+
+	for iter := identities.Iterator(); iter.Next(); {
+		nodenames[iter.Value().Metadata().ID()] = iter.Value()
+	}
+
+	clusterNodeID := topologyNodeID(management.GetClusterTopologyResponse_Node_CLUSTER, clusterName)
+
+	response := &management.GetClusterTopologyResponse{
+		Nodes: []*management.GetClusterTopologyResponse_Node{
+			{
+				Id:   clusterNodeID,
+				Kind: management.GetClusterTopologyResponse_Node_CLUSTER,
+				Name: clusterName,
+			},
+		},
+	}
+
+	for iter := machineSets.Iterator(); iter.Next(); {
+		machineSetNodeID := topologyNodeID(management.GetClusterTopologyResponse_Node_MACHINE_SET, iter.Value().Metadata().ID())
+
+		response.Nodes = append(response.Nodes, &management.GetClusterTopologyResponse_Node{
+			Id:   machineSetNodeID,
+			Kind: management.GetClusterTopologyResponse_Node_MACHINE_SET,
+			Name: iter.Value().Metadata().ID(),
+			Role: topologyRole(iter.Value().Metadata()),
+		})
+
+		response.Edges = append(response.Edges, &management.GetClusterTopologyResponse_Edge{
+			From: machineSetNodeID,
+			To:   clusterNodeID,
+			Kind: management.GetClusterTopologyResponse_Edge_MEMBER_OF,
+		})
+	}
+
+	var controlPlanes, workers []string
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		clusterMachine := iter.Value()
+		machineID := clusterMachine.Metadata().ID()
+		machineNodeID := topologyNodeID(management.GetClusterTopologyResponse_Node_MACHINE, machineID)
+
+		node := &management.GetClusterTopologyResponse_Node{
+			Id:   machineNodeID,
+			Kind: management.GetClusterTopologyResponse_Node_MACHINE,
+			Name: machineID,
+			Role: topologyRole(clusterMachine.Metadata()),
+		}
+
+		if identity, ok := nodenames[machineID]; ok {
+			node.Name = cmp.Or(identity.TypedSpec().Value.GetNodename(), machineID)
+			node.Addresses = identity.TypedSpec().Value.GetNodeIps()
+		}
+
+		machineStatus, err := safe.StateGet[*omnires.MachineStatus](ctx, s.omniState, omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if machineStatus != nil {
+			node.Connected = machineStatus.TypedSpec().Value.GetConnected()
+			node.TalosVersion = machineStatus.TypedSpec().Value.GetTalosVersion()
+		}
+
+		response.Nodes = append(response.Nodes, node)
+
+		if machineSetName, ok := clusterMachine.Metadata().Labels().Get(omnires.LabelMachineSet); ok {
+			response.Edges = append(response.Edges, &management.GetClusterTopologyResponse_Edge{
+				From: machineNodeID,
+				To:   topologyNodeID(management.GetClusterTopologyResponse_Node_MACHINE_SET, machineSetName),
+				Kind: management.GetClusterTopologyResponse_Edge_MEMBER_OF,
+			})
+		}
+
+		switch node.Role {
+		case topologyRoleControlPlane:
+			controlPlanes = append(controlPlanes, machineNodeID)
+		case topologyRoleWorker:
+			workers = append(workers, machineNodeID)
+		}
+	}
+
+	for _, worker := range workers {
+		for _, controlPlane := range controlPlanes {
+			response.Edges = append(response.Edges, &management.GetClusterTopologyResponse_Edge{
+				From: worker,
+				To:   controlPlane,
+				Kind: management.GetClusterTopologyResponse_Edge_CONNECTS_TO,
+			})
+		}
+	}
+
+	slices.SortStableFunc(response.Nodes, func(a, b *management.GetClusterTopologyResponse_Node) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Id, b.Id))
+	})
+
+	slices.SortStableFunc(response.Edges, func(a, b *management.GetClusterTopologyResponse_Edge) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To))
+	})
+
+	return response, nil
+}
+
+// topologyNodeID prefixes the resource ID with the node kind, as the machine sets might be named the same as the machines.
+func topologyNodeID(kind management.GetClusterTopologyResponse_Node_Kind, id string) string {
+	switch kind {
+	case management.GetClusterTopologyResponse_Node_CLUSTER:
+		return "cluster/" + id
+	case management.GetClusterTopologyResponse_Node_MACHINE_SET:
+		return "machineset/" + id
+	case management.GetClusterTopologyResponse_Node_MACHINE:
+		return "machine/" + id
+	case management.GetClusterTopologyResponse_Node_UNKNOWN:
+	}
+
+	return id
+}
+
+func topologyRole(md *resource.Metadata) string {
+	if _, ok := md.Labels().Get(omnires.LabelControlPlaneRole); ok {
+		return topologyRoleControlPlane
+	}
+
+	if _, ok := md.Labels().Get(omnires.LabelWorkerRole); ok {
+		return topologyRoleWorker
+	}
+
+	return ""
+}
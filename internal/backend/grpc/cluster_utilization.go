@@ -0,0 +1,274 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const (
+	defaultUtilizationInterval = 5 * time.Second
+	minUtilizationInterval     = time.Second
+	maxUtilizationInterval     = time.Minute
+
+	defaultUtilizationDuration = 5 * time.Minute
+	maxUtilizationDuration     = 30 * time.Minute
+)
+
+// StreamClusterUtilization periodically samples the CPU and memory utilization of the cluster nodes.
+//
+// The CPU utilization is calculated between two samples, so the first response is sent after the first interval.
+func (s *managementServer) StreamClusterUtilization(req *management.StreamClusterUtilizationRequest, srv management.ManagementService_StreamClusterUtilizationServer) error {
+	ctx := srv.Context()
+
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	interval := defaultUtilizationInterval
+
+	if req.GetInterval() != nil {
+		interval = req.GetInterval().AsDuration()
+
+		if interval < minUtilizationInterval || interval > maxUtilizationInterval {
+			return status.Errorf(codes.InvalidArgument, "interval must be between %s and %s", minUtilizationInterval, maxUtilizationInterval)
+		}
+	}
+
+	duration := defaultUtilizationDuration
+
+	if req.GetDuration() != nil {
+		duration = req.GetDuration().AsDuration()
+
+		if duration <= 0 || duration > maxUtilizationDuration {
+			return status.Errorf(codes.InvalidArgument, "duration must be positive and at most %s", maxUtilizationDuration)
+		}
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// the utilization is the runtime state of the cluster machines
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return err
+	}
+
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return err
+	}
+
+	talosClient, err := talosRuntime.GetClient(ctx, clusterName)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "error getting talos client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// the first sample is the baseline for the CPU utilization
+	_, previous, err := s.sampleClusterUtilization(ctx, talosClient.Client, clusterName, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		var response *management.StreamClusterUtilizationResponse
+
+		response, previous, err = s.sampleClusterUtilization(ctx, talosClient.Client, clusterName, previous)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = srv.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// sampleClusterUtilization reads the utilization of the cluster nodes, it returns the CPU stats of the nodes to be used as the baseline for the next sample.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (s *managementServer) sampleClusterUtilization(ctx context.Context, c *client.Client, clusterName string, previous map[string]*machine.CPUStat) (
+	*management.StreamClusterUtilizationResponse, map[string]*machine.CPUStat, error,
+) {
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &management.StreamClusterUtilizationResponse{
+		SampledAt: timestamppb.Now(),
+		Nodes:     make([]*management.StreamClusterUtilizationResponse_Node, 0, machineStatuses.Len()),
+	}
+
+	// the responses are matched to the machines by the node address
+	nodes := map[string]*management.StreamClusterUtilizationResponse_Node{}
+	addresses := make([]string, 0, machineStatuses.Len())
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		node := &management.StreamClusterUtilizationResponse_Node{
+			MachineId: iter.Value().Metadata().ID(),
+		}
+
+		response.Nodes = append(response.Nodes, node)
+
+		address := iter.Value().TypedSpec().Value.GetManagementAddress()
+		if !iter.Value().TypedSpec().Value.GetConnected() || address == "" {
+			node.Error = "machine is not connected"
+
+			continue
+		}
+
+		nodes[address] = node
+		addresses = append(addresses, address)
+	}
+
+	cpuStats := map[string]*machine.CPUStat{}
+
+	if len(addresses) == 0 {
+		return response, cpuStats, nil
+	}
+
+	nodesCtx := client.WithNodes(ctx, addresses...)
+
+	// the Talos client doesn't wrap the SystemStat call, so the per-node errors are filtered here the same way
+	statResp, statErr := c.MachineClient.SystemStat(nodesCtx, &emptypb.Empty{})
+
+	filtered, statErr := client.FilterMessages(statResp, statErr)
+	statResp, _ = filtered.(*machine.SystemStatResponse) //nolint:errcheck
+
+	memResp, memErr := c.Memory(nodesCtx)
+
+	// the other errors are reported on the nodes
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+
+	for _, msg := range statResp.GetMessages() {
+		cpuStats[msg.GetMetadata().GetHostname()] = msg.GetCpuTotal()
+	}
+
+	memory := map[string]*machine.MemInfo{}
+
+	for _, msg := range memResp.GetMessages() {
+		memory[msg.GetMetadata().GetHostname()] = msg.GetMeminfo()
+	}
+
+	var cpuNodes int
+
+	for address, node := range nodes {
+		cpuStat, prevCPUStat, memInfo := cpuStats[address], previous[address], memory[address]
+
+		if cpuStat == nil || memInfo == nil {
+			switch {
+			case statErr != nil:
+				node.Error = statErr.Error()
+			case memErr != nil:
+				node.Error = memErr.Error()
+			default:
+				node.Error = "no utilization data received"
+			}
+
+			continue
+		}
+
+		// the memory info is reported in KiB
+		node.MemoryTotalBytes = memInfo.GetMemtotal() * 1024
+		node.MemoryUsedBytes = (memInfo.GetMemtotal() - memInfo.GetMemavailable()) * 1024
+
+		response.MemoryTotalBytes += node.MemoryTotalBytes
+		response.MemoryUsedBytes += node.MemoryUsedBytes
+
+		if cpuPercent, ok := cpuUtilization(prevCPUStat, cpuStat); ok {
+			node.CpuPercent = cpuPercent
+
+			response.CpuPercent += cpuPercent
+			cpuNodes++
+		}
+	}
+
+	if cpuNodes > 0 {
+		response.CpuPercent /= float64(cpuNodes)
+	}
+
+	return response, cpuStats, nil
+}
+
+// cpuUtilization returns the share of the CPU time spent not idle between two samples.
+func cpuUtilization(previous, current *machine.CPUStat) (float64, bool) {
+	if previous == nil || current == nil {
+		return 0, false
+	}
+
+	total := func(stat *machine.CPUStat) float64 {
+		return stat.GetUser() + stat.GetNice() + stat.GetSystem() + stat.GetIdle() + stat.GetIowait() + stat.GetIrq() + stat.GetSoftIrq() + stat.GetSteal()
+	}
+
+	idle := func(stat *machine.CPUStat) float64 {
+		return stat.GetIdle() + stat.GetIowait()
+	}
+
+	totalDelta := total(current) - total(previous)
+	if totalDelta <= 0 {
+		return 0, false
+	}
+
+	return (totalDelta - (idle(current) - idle(previous))) / totalDelta * 100, true
+}
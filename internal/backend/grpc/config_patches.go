@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/configpatch"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetMachinePatchOrder returns the config patches of the cluster machine in the order they are applied.
+func (s *managementServer) GetMachinePatchOrder(ctx context.Context, req *management.GetMachinePatchOrderRequest) (*management.GetMachinePatchOrderResponse, error) {
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	clusterMachine, err := safe.StateGet[*omnires.ClusterMachine](internalCtx, s.omniState, omnires.NewClusterMachine(resources.DefaultNamespace, req.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q is not part of a cluster", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	clusterName, _ := clusterMachine.Metadata().Labels().Get(omnires.LabelCluster)
+
+	ctx, err = s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// reading the patch order is equivalent to reading the cluster config patches
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSetName, ok := clusterMachine.Metadata().Labels().Get(omnires.LabelMachineSet)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster machine %q doesn't have machine set label set", req.GetMachineId())
+	}
+
+	machineSet, err := safe.StateGet[*omnires.MachineSet](ctx, s.omniState, omnires.NewMachineSet(resources.DefaultNamespace, machineSetName).Metadata())
+	if err != nil {
+		return nil, err
+	}
+
+	helper, err := configpatch.NewHelper(ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	patches, err := helper.GetWithSources(clusterMachine, machineSet)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &management.GetMachinePatchOrderResponse{
+		Patches: xslices.Map(patches, func(patch configpatch.Patch) *management.GetMachinePatchOrderResponse_Patch {
+			name, _ := patch.Metadata().Annotations().Get(omnires.ConfigPatchName)
+
+			return &management.GetMachinePatchOrderResponse_Patch{
+				Id:     patch.Metadata().ID(),
+				Name:   name,
+				Source: patchSource(patch.Source),
+			}
+		}),
+	}, nil
+}
+
+func patchSource(source configpatch.Source) management.GetMachinePatchOrderResponse_Patch_Source {
+	switch source {
+	case configpatch.SourceCluster:
+		return management.GetMachinePatchOrderResponse_Patch_CLUSTER
+	case configpatch.SourceMachineSet:
+		return management.GetMachinePatchOrderResponse_Patch_MACHINE_SET
+	case configpatch.SourceClusterMachine:
+		return management.GetMachinePatchOrderResponse_Patch_CLUSTER_MACHINE
+	case configpatch.SourceMachine:
+		return management.GetMachinePatchOrderResponse_Patch_MACHINE
+	}
+
+	return management.GetMachinePatchOrderResponse_Patch_CLUSTER
+}
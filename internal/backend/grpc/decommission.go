@@ -0,0 +1,197 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// DecommissionMachine removes the machine from its cluster, waits for it to be drained and wiped, and then removes the machine from Omni.
+//
+// The progress is streamed back as the decommission steps.
+//
+//nolint:gocyclo,cyclop
+func (s *managementServer) DecommissionMachine(req *management.DecommissionMachineRequest, srv management.ManagementService_DecommissionMachineServer) error {
+	ctx := srv.Context()
+
+	authCheckResult, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if _, err = safe.StateGet[*omnires.Machine](ctx, s.omniState, omnires.NewMachine(resources.DefaultNamespace, machineID).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return err
+	}
+
+	machineSetNode, err := safe.StateGet[*omnires.MachineSetNode](ctx, s.omniState, resource.NewMetadata(resources.DefaultNamespace, omnires.MachineSetNodeType, machineID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if machineSetNode != nil {
+		if err = s.checkControlPlaneDecommission(ctx, machineSetNode, req.GetConfirmControlPlane()); err != nil {
+			return err
+		}
+	}
+
+	if err = s.requireApproval(ctx, config.ApprovalOperationDecommissionMachine, authCheckResult.Identity, machineID); err != nil {
+		return err
+	}
+
+	sendStep := func(format string, args ...any) error {
+		step := fmt.Sprintf(format, args...)
+
+		s.logger.Info("decommissioning machine", zap.String("machine", machineID), zap.String("step", step))
+
+		return srv.Send(&management.DecommissionMachineResponse{
+			Step: step,
+		})
+	}
+
+	if machineSetNode != nil {
+		clusterName, _ := machineSetNode.Metadata().Labels().Get(omnires.LabelCluster)
+
+		if err = sendStep("removing the machine from the cluster %q", clusterName); err != nil {
+			return err
+		}
+
+		if err = s.teardownAndDestroy(ctx, machineSetNode.Metadata()); err != nil {
+			return fmt.Errorf("failed to remove the machine from the cluster: %w", err)
+		}
+
+		// the cluster machine is removed only after the machine is drained and reset by the controllers
+		if err = sendStep("waiting for the machine to be drained and wiped"); err != nil {
+			return err
+		}
+
+		if _, err = s.omniState.WatchFor(ctx, omnires.NewClusterMachine(resources.DefaultNamespace, machineID).Metadata(), state.WithEventTypes(state.Destroyed)); err != nil {
+			return fmt.Errorf("failed waiting for the machine to be reset: %w", err)
+		}
+	} else if err = sendStep("the machine is not part of a cluster, skipping the reset"); err != nil {
+		return err
+	}
+
+	if err = sendStep("removing the machine config patches and labels"); err != nil {
+		return err
+	}
+
+	configPatches, err := safe.StateListAll[*omnires.ConfigPatch](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelMachine, machineID)))
+	if err != nil {
+		return err
+	}
+
+	for iter := configPatches.Iterator(); iter.Next(); {
+		if err = s.teardownAndDestroy(ctx, iter.Value().Metadata()); err != nil {
+			return fmt.Errorf("failed to remove config patch %q: %w", iter.Value().Metadata().ID(), err)
+		}
+	}
+
+	if err = s.teardownAndDestroy(ctx, omnires.NewMachineLabels(resources.DefaultNamespace, machineID).Metadata()); err != nil {
+		return fmt.Errorf("failed to remove the machine labels: %w", err)
+	}
+
+	if err = s.teardownAndDestroy(ctx, omnires.NewMachineReservation(resources.DefaultNamespace, machineID).Metadata()); err != nil {
+		return fmt.Errorf("failed to remove the machine reservation: %w", err)
+	}
+
+	if err = s.teardownAndDestroy(ctx, omnires.NewMachineLogRetention(resources.DefaultNamespace, machineID).Metadata()); err != nil {
+		return fmt.Errorf("failed to remove the machine log retention: %w", err)
+	}
+
+	if err = sendStep("removing the machine"); err != nil {
+		return err
+	}
+
+	if err = s.teardownAndDestroy(ctx, siderolink.NewLink(resources.DefaultNamespace, machineID, nil).Metadata()); err != nil {
+		return fmt.Errorf("failed to remove the machine link: %w", err)
+	}
+
+	if err = s.teardownAndDestroy(ctx, omnires.NewImportedMachine(resources.DefaultNamespace, machineID).Metadata()); err != nil {
+		return fmt.Errorf("failed to remove the imported machine: %w", err)
+	}
+
+	if _, err = s.omniState.WatchFor(ctx, omnires.NewMachine(resources.DefaultNamespace, machineID).Metadata(), state.WithEventTypes(state.Destroyed)); err != nil {
+		return fmt.Errorf("failed waiting for the machine to be removed: %w", err)
+	}
+
+	return sendStep("the machine is decommissioned")
+}
+
+// checkControlPlaneDecommission verifies that removing the control plane node is explicitly confirmed, and it won't destroy the etcd cluster.
+func (s *managementServer) checkControlPlaneDecommission(ctx context.Context, machineSetNode *omnires.MachineSetNode, confirmed bool) error {
+	if _, isControlPlane := machineSetNode.Metadata().Labels().Get(omnires.LabelControlPlaneRole); !isControlPlane {
+		return nil
+	}
+
+	if !confirmed {
+		return status.Errorf(codes.FailedPrecondition,
+			"machine %q is a control plane node, removing it affects the etcd quorum, the control plane removal should be confirmed", machineSetNode.Metadata().ID())
+	}
+
+	clusterName, _ := machineSetNode.Metadata().Labels().Get(omnires.LabelCluster)
+
+	controlPlanes, err := safe.StateListAll[*omnires.MachineSetNode](ctx, s.omniState, state.WithLabelQuery(
+		resource.LabelEqual(omnires.LabelCluster, clusterName),
+		resource.LabelExists(omnires.LabelControlPlaneRole),
+	))
+	if err != nil {
+		return err
+	}
+
+	if controlPlanes.Len() <= 1 {
+		return status.Errorf(codes.FailedPrecondition, "machine %q is the last control plane node of the cluster %q", machineSetNode.Metadata().ID(), clusterName)
+	}
+
+	return nil
+}
+
+// teardownAndDestroy tears down the resource, waits for the finalizers to be removed and destroys it.
+func (s *managementServer) teardownAndDestroy(ctx context.Context, ptr resource.Pointer) error {
+	if _, err := s.omniState.Teardown(ctx, ptr); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if _, err := s.omniState.WatchFor(ctx, ptr, state.WithFinalizerEmpty()); err != nil {
+		return err
+	}
+
+	if err := s.omniState.Destroy(ctx, ptr); err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
@@ -6,7 +6,18 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
 )
 
 type ManagementServer = managementServer
@@ -15,9 +26,60 @@ type ManagementServer = managementServer
 func NewManagementServer(st state.State) *ManagementServer {
 	return &ManagementServer{
 		omniState: st,
+		logger:    zap.NewNop(),
 	}
 }
 
 func GenerateDest(apiurl string) (string, error) {
 	return generateDest(apiurl)
 }
+
+func TruncateLogLine(line []byte, maxSize int) []byte {
+	return truncateLogLine(line, maxSize)
+}
+
+func ManifestHashes(objs []manifests.Manifest) (map[string]string, error) {
+	return manifestHashes(objs)
+}
+
+func FilterChangedManifests(objs []manifests.Manifest, hashes, syncedHashes map[string]string) (changed, unchanged []manifests.Manifest) {
+	return filterChangedManifests(objs, hashes, syncedHashes)
+}
+
+func SelectInstallDisk(blockDevices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice, autoInstallDisk string,
+	installConfig *v1alpha1.InstallConfig,
+) *management.GetInstallDiskSelectionResponse {
+	return selectInstallDisk(blockDevices, autoInstallDisk, installConfig)
+}
+
+func (s *ManagementServer) TriggerManifestResync(ctx context.Context, clusterName, identity, reason string, dryRun bool) error {
+	return s.triggerManifestResync(ctx, &common.Context{Name: clusterName}, manifestSyncRecord{
+		identity: identity,
+		reason:   reason,
+		dryRun:   dryRun,
+	})
+}
+
+func (s *ManagementServer) StoreManifestHashes(ctx context.Context, clusterName string, hashes map[string]string) error {
+	return s.storeManifestHashes(ctx, clusterName, hashes)
+}
+
+func (s *ManagementServer) StoreFailedManifestSync(ctx context.Context, clusterName string, appliedHashes map[string]string) error {
+	return s.storeFailedManifestSync(ctx, clusterName, appliedHashes)
+}
+
+func (s *ManagementServer) FailedSyncManifestHashes(ctx context.Context, clusterName string) (map[string]string, bool, error) {
+	return s.failedSyncManifestHashes(ctx, clusterName)
+}
+
+func CPUUtilization(previous, current *machine.CPUStat) (float64, bool) {
+	return cpuUtilization(previous, current)
+}
+
+func (s *ManagementServer) UpgradeProgress(ctx context.Context, clusterName string) ([]*management.WatchUpgradeProgressResponse, error) {
+	return s.upgradeProgress(ctx, clusterName)
+}
+
+func KubernetesEventResponse(event *corev1.Event) *management.WatchKubernetesEventsResponse {
+	return kubernetesEventResponse(event)
+}
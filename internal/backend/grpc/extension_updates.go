@@ -0,0 +1,222 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// availableExtension is the most recent extension version available in the image factory.
+type availableExtension struct {
+	info         *specs.TalosExtensionsSpec_Info
+	talosVersion semver.Version
+}
+
+// GetAvailableExtensionUpdates reports the installed extensions of the machine which have newer versions in the image factory.
+//
+// The extension versions are tied to the Talos versions, so the newest version is looked up
+// across the image factory extensions of the machine Talos version and all newer Talos versions.
+func (s *managementServer) GetAvailableExtensionUpdates(ctx context.Context, req *management.GetAvailableExtensionUpdatesRequest) (*management.GetAvailableExtensionUpdatesResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// the report is built from the machine status and the extensions available for everyone
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSchematic := machineStatus.TypedSpec().Value.GetSchematic()
+	if machineSchematic == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q extensions are not known yet", machineID)
+	}
+
+	if machineSchematic.GetInvalid() {
+		return &management.GetAvailableExtensionUpdatesResponse{
+			Unresolvable:       true,
+			UnresolvableReason: "the machine has extensions installed bypassing the image factory",
+		}, nil
+	}
+
+	talosVersion, err := semver.ParseTolerant(machineStatus.TypedSpec().Value.GetTalosVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q Talos version is not known", machineID)
+	}
+
+	available, err := s.availableExtensions(ctx, talosVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.GetAvailableExtensionUpdatesResponse{}
+
+	for _, installed := range machineSchematic.GetInstalledExtensions() {
+		extension, ok := available[installed.GetName()]
+		if !ok || !isNewerExtensionVersion(extension.info.GetVersion(), installed.GetVersion()) {
+			continue
+		}
+
+		response.Updates = append(response.Updates, &management.GetAvailableExtensionUpdatesResponse_Update{
+			Name:             installed.GetName(),
+			InstalledVersion: installed.GetVersion(),
+			AvailableVersion: extension.info.GetVersion(),
+			TalosVersion:     extension.talosVersion.String(),
+		})
+	}
+
+	slices.SortFunc(response.Updates, func(a, b *management.GetAvailableExtensionUpdatesResponse_Update) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return response, nil
+}
+
+// GetExtensionUsage counts the machines which have each extension installed.
+//
+// Only the machines the user can read are counted.
+func (s *managementServer) GetExtensionUsage(ctx context.Context, _ *emptypb.Empty) (*management.GetExtensionUsageResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+
+	response := &management.GetExtensionUsageResponse{}
+	usage := map[string]int32{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		if spec.GetSchematic() == nil {
+			response.UnknownMachines++
+
+			continue
+		}
+
+		// the same extension might be reported several times, e.g. with the different versions
+		seen := map[string]struct{}{}
+
+		for _, installed := range spec.GetSchematic().GetInstalledExtensions() {
+			if _, ok := seen[installed.GetName()]; ok {
+				continue
+			}
+
+			seen[installed.GetName()] = struct{}{}
+			usage[installed.GetName()]++
+		}
+	}
+
+	for name, machines := range usage {
+		response.Extensions = append(response.Extensions, &management.GetExtensionUsageResponse_Extension{
+			Name:     name,
+			Machines: machines,
+		})
+	}
+
+	slices.SortFunc(response.Extensions, func(a, b *management.GetExtensionUsageResponse_Extension) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return response, nil
+}
+
+// availableExtensions returns the extensions built for the newest Talos version starting from the given version.
+//
+// The extensions are keyed by the short name, as the machine reports the extensions without the image repository.
+func (s *managementServer) availableExtensions(ctx context.Context, minTalosVersion semver.Version) (map[string]availableExtension, error) {
+	talosExtensions, err := safe.StateListAll[*omnires.TalosExtensions](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	available := map[string]availableExtension{}
+
+	for iter := talosExtensions.Iterator(); iter.Next(); {
+		talosVersion, parseErr := semver.ParseTolerant(iter.Value().Metadata().ID())
+		if parseErr != nil || talosVersion.LT(minTalosVersion) {
+			continue
+		}
+
+		for _, info := range iter.Value().TypedSpec().Value.GetItems() {
+			name := path.Base(info.GetName())
+
+			if existing, ok := available[name]; ok && existing.talosVersion.GT(talosVersion) {
+				continue
+			}
+
+			available[name] = availableExtension{
+				info:         info,
+				talosVersion: talosVersion,
+			}
+		}
+	}
+
+	return available, nil
+}
+
+// isNewerExtensionVersion compares the extension versions as semantic versions if possible,
+// the extensions which don't follow semantic versioning are reported if the versions differ.
+func isNewerExtensionVersion(available, installed string) bool {
+	availableVersion, availableErr := semver.ParseTolerant(available)
+	installedVersion, installedErr := semver.ParseTolerant(installed)
+
+	if availableErr != nil || installedErr != nil {
+		return available != installed
+	}
+
+	return availableVersion.GT(installedVersion)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ImportExternalNodes registers the nodes of a cluster not managed by Omni as machines.
+//
+// Imported machines are only polled for the status, Omni never takes over their lifecycle.
+// The API stores only the imported machines and the talosconfig they are accessed with,
+// the controllers turn them into the machines and the talosconfig used by the machine status poller.
+func (s *managementServer) ImportExternalNodes(ctx context.Context, req *management.ImportExternalNodesRequest) (*management.ImportExternalNodesResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Admin); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if len(req.GetEndpoints()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one endpoint is required")
+	}
+
+	talosConfig, err := parseExternalTalosConfig(req.GetName(), req.GetTalosconfig())
+	if err != nil {
+		return nil, err
+	}
+
+	// read the identities of all nodes first, so that nothing is stored if any of the nodes is not reachable
+	machineIDs := make([]string, 0, len(req.GetEndpoints()))
+
+	for _, endpoint := range req.GetEndpoints() {
+		var machineID string
+
+		machineID, err = getExternalNodeID(ctx, talosConfig, endpoint)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to read node %q identity: %s", endpoint, err)
+		}
+
+		if err = s.checkExternalMachine(ctx, machineID); err != nil {
+			return nil, err
+		}
+
+		machineIDs = append(machineIDs, machineID)
+	}
+
+	if err = createOrUpdate(ctx, s.omniState, omni.NewImportedTalosConfig(resources.DefaultNamespace, req.GetName()), func(res *omni.ImportedTalosConfig) error {
+		res.TypedSpec().Value.Ca = talosConfig.TypedSpec().Value.Ca
+		res.TypedSpec().Value.Crt = talosConfig.TypedSpec().Value.Crt
+		res.TypedSpec().Value.Key = talosConfig.TypedSpec().Value.Key
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for i, machineID := range machineIDs {
+		if err = createOrUpdate(ctx, s.omniState, omni.NewImportedMachine(resources.DefaultNamespace, machineID), func(res *omni.ImportedMachine) error {
+			res.Metadata().Labels().Set(omni.LabelExternallyManaged, req.GetName())
+
+			res.TypedSpec().Value.ManagementAddress = req.GetEndpoints()[i]
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &management.ImportExternalNodesResponse{
+		MachineIds: machineIDs,
+	}, nil
+}
+
+// parseExternalTalosConfig reads the credentials from the talosconfig of the imported nodes.
+func parseExternalTalosConfig(name string, data []byte) (*omni.TalosConfig, error) {
+	config, err := clientconfig.FromBytes(data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse talosconfig: %s", err)
+	}
+
+	configContext, ok := config.Contexts[config.Context]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "talosconfig context %q is not defined", config.Context)
+	}
+
+	if configContext.CA == "" || configContext.Crt == "" || configContext.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "talosconfig context should have the CA, certificate and key set")
+	}
+
+	talosConfig := omni.NewTalosConfig(resources.DefaultNamespace, omni.ImportedTalosConfigID(name))
+
+	talosConfig.TypedSpec().Value.Ca = configContext.CA
+	talosConfig.TypedSpec().Value.Crt = configContext.Crt
+	talosConfig.TypedSpec().Value.Key = configContext.Key
+
+	return talosConfig, nil
+}
+
+// checkExternalMachine verifies that the machine is not connected to Omni over SideroLink.
+func (s *managementServer) checkExternalMachine(ctx context.Context, machineID string) error {
+	machine, err := safe.StateGet[*omni.Machine](ctx, s.omniState, omni.NewMachine(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if _, external := machine.Metadata().Labels().Get(omni.LabelExternallyManaged); !external {
+		return status.Errorf(codes.FailedPrecondition, "machine %q is already managed by Omni", machineID)
+	}
+
+	return nil
+}
+
+func getExternalNodeID(ctx context.Context, talosConfig *omni.TalosConfig, endpoint string) (string, error) {
+	opts := talos.GetSocketOptions(endpoint)
+	opts = append(opts, client.WithConfig(omni.NewTalosClientConfig(talosConfig, endpoint)))
+
+	c, err := client.New(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	systemInformation, err := safe.StateGet[*hardware.SystemInformation](ctx, c.COSI, hardware.NewSystemInformation(hardware.SystemInformationID).Metadata())
+	if err != nil {
+		return "", err
+	}
+
+	if systemInformation.TypedSpec().UUID == "" {
+		return "", fmt.Errorf("node has no UUID")
+	}
+
+	return systemInformation.TypedSpec().UUID, nil
+}
@@ -7,22 +7,46 @@ package grpc_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/gen/pair"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-api-signature/pkg/message"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
+	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	talosmachine "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
@@ -32,22 +56,36 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/siderolabs/omni/client/api/common"
 	"github.com/siderolabs/omni/client/api/omni/management"
 	resapi "github.com/siderolabs/omni/client/api/omni/resources"
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	managementcli "github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	omniruntime "github.com/siderolabs/omni/internal/backend/runtime/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
+	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
 type GrpcSuite struct {
@@ -61,6 +99,8 @@ type GrpcSuite struct {
 	ctx        context.Context //nolint:containedctx
 	ctxCancel  context.CancelFunc
 	socketPath string
+
+	managementServer *grpcomni.ManagementServer
 }
 
 func (suite *GrpcSuite) SetupTest() {
@@ -260,44 +300,3619 @@ func (suite *GrpcSuite) TestConfigValidation() {
 	})
 	suite.Require().Error(err)
 	suite.Require().Equalf(codes.InvalidArgument, status.Code(err), err.Error())
+
+	config := `debug: true
+machine:
+  network:
+    hostname: abcd
+`
+
+	resp, err := client.ValidateConfig(suite.ctx, &management.ValidateConfigRequest{
+		Config: config,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.LintWarnings, 1)
+	suite.Assert().Equal("debug-enabled", resp.LintWarnings[0].RuleId)
+
+	resp, err = client.ValidateConfig(suite.ctx, &management.ValidateConfigRequest{
+		Config:              config,
+		SuppressedLintRules: []string{"debug-enabled"},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.LintWarnings)
 }
 
-func (suite *GrpcSuite) newServer(opts ...grpc.ServerOption) error {
-	var err error
+func (suite *GrpcSuite) TestGetConfigSchema() {
+	client := management.NewManagementServiceClient(suite.conn)
 
-	suite.socketPath = filepath.Join(suite.T().TempDir(), "socket")
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewTalosVersion(resources.DefaultNamespace, "1.6.4")))
 
-	listener, err := net.Listen("unix", suite.socketPath)
-	if err != nil {
-		return err
+	_, err := client.GetConfigSchema(suite.ctx, &management.GetConfigSchemaRequest{})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetConfigSchema(suite.ctx, &management.GetConfigSchemaRequest{
+		TalosVersion: "1.2.3",
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	resp, err := client.GetConfigSchema(suite.ctx, &management.GetConfigSchemaRequest{
+		TalosVersion: "v1.6.4",
+	})
+	suite.Require().NoError(err)
+
+	var schema map[string]any
+
+	suite.Require().NoError(json.Unmarshal([]byte(resp.Schema), &schema))
+	suite.Assert().Equal("#/$defs/Config", schema["$ref"])
+}
+
+func (suite *GrpcSuite) TestGetServiceAccountKey() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa1", "user1", "key1")
+	suite.createServiceAccount("sa2", "user2", "key2")
+
+	resp, err := client.GetServiceAccountKey(suite.ctx, &management.GetServiceAccountKeyRequest{
+		Name:  "sa1",
+		KeyId: "key1",
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("key1", resp.Id)
+	suite.Assert().Equal("armored-key1", resp.Armored)
+	suite.Assert().Equal(string(role.Reader), resp.Role)
+
+	_, err = client.GetServiceAccountKey(suite.ctx, &management.GetServiceAccountKeyRequest{
+		Name:  "sa1",
+		KeyId: "key2",
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = client.GetServiceAccountKey(suite.ctx, &management.GetServiceAccountKeyRequest{
+		Name:  "sa3",
+		KeyId: "key1",
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestListAllServiceAccountKeys() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("audit-b", "audit-user-b", "audit-key-3")
+	suite.createServiceAccount("audit-a", "audit-user-a", "audit-key-2", "audit-key-1")
+
+	// the keys of the regular users are not listed
+	userKey := authres.NewPublicKey(resources.DefaultNamespace, "audit-user-key")
+	userKey.Metadata().Labels().Set(authres.LabelPublicKeyUserID, "audit-user")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, userKey))
+
+	resp, err := client.ListAllServiceAccountKeys(suite.ctx, &management.ListAllServiceAccountKeysRequest{})
+	suite.Require().NoError(err)
+
+	var auditKeys []string
+
+	for _, key := range resp.Keys {
+		if strings.HasPrefix(key.ServiceAccount, "audit-") {
+			auditKeys = append(auditKeys, key.ServiceAccount+"/"+key.Id)
+
+			suite.Assert().True(key.Confirmed)
+			suite.Assert().Equal(string(role.Reader), key.Role)
+		}
 	}
 
-	grpcAddress := fmt.Sprintf("unix://%s", suite.socketPath)
+	suite.Assert().Equal([]string{"audit-a/audit-key-1", "audit-a/audit-key-2", "audit-b/audit-key-3"}, auditKeys)
+	suite.Assert().EqualValues(len(resp.Keys), resp.Total)
 
-	suite.server = grpc.NewServer(opts...)
+	page, err := client.ListAllServiceAccountKeys(suite.ctx, &management.ListAllServiceAccountKeysRequest{Offset: 1, Limit: 1})
+	suite.Require().NoError(err)
 
-	resapi.RegisterResourceServiceServer(suite.server, &grpcomni.ResourceServer{})
-	management.RegisterManagementServiceServer(suite.server, grpcomni.NewManagementServer(
-		suite.state,
-	))
+	suite.Require().Len(page.Keys, 1)
+	suite.Assert().Equal(resp.Keys[1].Id, page.Keys[0].Id)
+	suite.Assert().Equal(resp.Total, page.Total)
 
-	go func() {
-		for {
-			err = suite.server.Serve(listener)
-			if err == nil || errors.Is(err, grpc.ErrServerStopped) {
-				break
-			}
+	_, err = client.ListAllServiceAccountKeys(suite.ctx, &management.ListAllServiceAccountKeysRequest{Offset: -1})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestConfirmServiceAccountKey() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa1", "user1", "key1")
+	suite.createServiceAccount("sa2", "user2", "key2")
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, "key1").Metadata(), func(res *authres.PublicKey) error {
+		res.TypedSpec().Value.Confirmed = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = client.ConfirmServiceAccountKey(suite.ctx, &management.ConfirmServiceAccountKeyRequest{
+		Name:  "sa1",
+		KeyId: "key1",
+	})
+	suite.Require().NoError(err)
+
+	key, err := safe.StateGet[*authres.PublicKey](suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, "key1").Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().True(key.TypedSpec().Value.Confirmed)
+
+	_, err = client.ConfirmServiceAccountKey(suite.ctx, &management.ConfirmServiceAccountKeyRequest{
+		Name:  "sa1",
+		KeyId: "key2",
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = client.ConfirmServiceAccountKey(suite.ctx, &management.ConfirmServiceAccountKeyRequest{
+		Name: "sa1",
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestDestroyServiceAccountDryRun() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa1", "user1", "key1", "key2")
+
+	resp, err := client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{
+		Name:   "sa1",
+		DryRun: true,
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{
+		authres.PublicKeyType + "/key1",
+		authres.PublicKeyType + "/key2",
+		authres.IdentityType + "/sa1" + pkgaccess.ServiceAccountNameSuffix,
+		authres.UserType + "/user1",
+	}, xslices.Map(resp.Resources, func(res *management.DestroyServiceAccountResponse_Resource) string {
+		return res.Type + "/" + res.Id
+	}))
+
+	_, err = suite.state.Get(suite.ctx, authres.NewPublicKey(resources.DefaultNamespace, "key1").Metadata())
+	suite.Require().NoError(err)
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user1").Metadata())
+	suite.Require().NoError(err)
+}
+
+func (suite *GrpcSuite) TestDestroyServiceAccountApproval() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	var approved atomic.Bool
+
+	approver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]string
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request["operation"] != "DestroyServiceAccount" || request["target"] != "sa1" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
 		}
+
+		json.NewEncoder(w).Encode(map[string]any{"approved": approved.Load(), "reason": "change freeze"}) //nolint:errcheck
+	}))
+
+	defer approver.Close()
+
+	config.Config.ApprovalWebhook.URL = approver.URL
+	config.Config.ApprovalWebhook.Operations = []string{config.ApprovalOperationDestroyServiceAccount}
+
+	defer func() {
+		config.Config.ApprovalWebhook.URL = ""
+		config.Config.ApprovalWebhook.Operations = nil
 	}()
 
-	suite.conn, err = grpc.DialContext(suite.ctx, grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return err
+	suite.createServiceAccount("sa1", "user1", "key1")
+
+	// the dry run doesn't need the approval
+	_, err := client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{Name: "sa1", DryRun: true})
+	suite.Require().NoError(err)
+
+	_, err = client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{Name: "sa1"})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+	suite.Assert().Contains(status.Convert(err).Message(), "change freeze")
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user1").Metadata())
+	suite.Require().NoError(err)
+
+	approved.Store(true)
+
+	_, err = client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{Name: "sa1"})
+	suite.Require().NoError(err)
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user1").Metadata())
+	suite.Assert().True(state.IsNotFoundError(err))
+
+	// the gate fails closed
+	approver.Close()
+
+	suite.createServiceAccount("sa2", "user2", "key2")
+
+	_, err = client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{Name: "sa2"})
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestDestroyServiceAccountPartialFailure() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa1", "user1", "key1", "key2")
+
+	// a pending finalizer makes the destroy fail
+	suite.Require().NoError(suite.state.AddFinalizer(suite.ctx, authres.NewPublicKey(resources.DefaultNamespace, "key2").Metadata(), "test"))
+
+	_, err := client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{
+		Name: "sa1",
+	})
+	suite.Require().Error(err)
+
+	result, ok := managementcli.DestroyServiceAccountPartialResult(err)
+	suite.Require().True(ok)
+
+	suite.Require().Len(result.Failures, 1)
+	suite.Assert().Equal("key2", result.Failures[0].Resource.Id)
+	suite.Assert().Len(result.Resources, 3)
+
+	_, err = suite.state.Get(suite.ctx, authres.NewPublicKey(resources.DefaultNamespace, "key1").Metadata())
+	suite.Assert().True(state.IsNotFoundError(err))
+}
+
+func (suite *GrpcSuite) TestDestroyServiceAccounts() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-valid", "user-valid", "key-valid")
+	suite.createServiceAccount("sa-expired", "user-expired", "key-expired")
+	suite.createServiceAccount("sa-labeled", "user-labeled", "key-labeled")
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, "key-expired").Metadata(), func(res *authres.PublicKey) error {
+		res.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(-time.Hour))
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, authres.NewIdentity(resources.DefaultNamespace, "sa-labeled"+pkgaccess.ServiceAccountNameSuffix).Metadata(),
+		func(res *authres.Identity) error {
+			res.Metadata().Labels().Set("team", "ci")
+
+			return nil
+		})
+	suite.Require().NoError(err)
+
+	destroy := func(filter *management.DestroyServiceAccountsRequest_Filter, dryRun bool) (*management.DestroyServiceAccountsResponse, error) {
+		return client.DestroyServiceAccounts(suite.ctx, &management.DestroyServiceAccountsRequest{
+			Filter: filter,
+			DryRun: dryRun,
+		})
 	}
 
-	return nil
+	names := func(resp *management.DestroyServiceAccountsResponse) []string {
+		return xslices.Map(resp.Results, func(result *management.DestroyServiceAccountsResponse_Result) string { return result.Name })
+	}
+
+	_, err = destroy(nil, true)
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = destroy(&management.DestroyServiceAccountsRequest_Filter{Role: "superuser"}, true)
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the filter is not validated for the users who are not allowed to destroy the service accounts
+	_, err = suite.managementServer.DestroyServiceAccounts(suite.authContext(role.Operator), &management.DestroyServiceAccountsRequest{
+		Filter: &management.DestroyServiceAccountsRequest_Filter{Role: "superuser"},
+	})
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	resp, err := destroy(&management.DestroyServiceAccountsRequest_Filter{Role: string(role.Reader)}, true)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"sa-expired", "sa-labeled", "sa-valid"}, names(resp))
+
+	resp, err = destroy(&management.DestroyServiceAccountsRequest_Filter{LabelSelector: "team=ci"}, true)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"sa-labeled"}, names(resp))
+
+	resp, err = destroy(&management.DestroyServiceAccountsRequest_Filter{Expired: true, Names: []string{"sa-valid", "sa-labeled"}}, true)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.Results)
+
+	resp, err = destroy(&management.DestroyServiceAccountsRequest_Filter{Expired: true}, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"sa-expired"}, names(resp))
+	suite.Assert().Len(resp.Results[0].Result.Resources, 3)
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user-expired").Metadata())
+	suite.Require().NoError(err)
+
+	resp, err = destroy(&management.DestroyServiceAccountsRequest_Filter{Expired: true}, false)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"sa-expired"}, names(resp))
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user-expired").Metadata())
+	suite.Assert().True(state.IsNotFoundError(err))
+
+	_, err = suite.state.Get(suite.ctx, authres.NewUser(resources.DefaultNamespace, "user-valid").Metadata())
+	suite.Require().NoError(err)
+
+	// a pending finalizer makes the destroy fail
+	suite.Require().NoError(suite.state.AddFinalizer(suite.ctx, authres.NewPublicKey(resources.DefaultNamespace, "key-labeled").Metadata(), "test"))
+
+	_, err = destroy(&management.DestroyServiceAccountsRequest_Filter{LabelSelector: "team=ci"}, false)
+	suite.Require().Error(err)
+
+	result, ok := managementcli.DestroyServiceAccountsPartialResult(err)
+	suite.Require().True(ok)
+	suite.Require().Len(result.Results, 1)
+	suite.Assert().NotEmpty(result.Results[0].Error)
+	suite.Assert().Len(result.Results[0].Result.Failures, 1)
 }
 
-func TestGrpcSuite(t *testing.T) {
-	suite.Run(t, new(GrpcSuite))
+func (suite *GrpcSuite) TestCreateServiceAccountRole() {
+	operatorCtx := suite.authContext(role.Operator)
+
+	armoredKey := func(name string) string {
+		key, err := pgp.GenerateKey(name, "test", name+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+		suite.Require().NoError(err)
+
+		armored, err := key.ArmorPublic()
+		suite.Require().NoError(err)
+
+		return armored
+	}
+
+	assertRole := func(resp *management.CreateServiceAccountResponse, expected role.Role) {
+		publicKey, err := safe.StateGet[*authres.PublicKey](suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, resp.PublicKeyId).Metadata())
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(string(expected), publicKey.TypedSpec().Value.Role)
+	}
+
+	// operators can create the service accounts with their own role
+	resp, err := suite.managementServer.CreateServiceAccount(operatorCtx, &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-operator"),
+		UseUserRole:         true,
+	})
+	suite.Require().NoError(err)
+
+	assertRole(resp, role.Operator)
+
+	// operators can explicitly specify the roles up to their own
+	resp, err = suite.managementServer.CreateServiceAccount(operatorCtx, &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-reader"),
+		Role:                string(role.Reader),
+	})
+	suite.Require().NoError(err)
+
+	assertRole(resp, role.Reader)
+
+	// the roles above the caller's role require admin
+	_, err = suite.managementServer.CreateServiceAccount(operatorCtx, &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-admin"),
+		Role:                string(role.Admin),
+	})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = suite.state.Get(suite.ctx, authres.NewIdentity(resources.DefaultNamespace, "sa-admin"+pkgaccess.ServiceAccountNameSuffix).Metadata())
+	suite.Require().True(state.IsNotFoundError(err))
+
+	resp, err = suite.managementServer.CreateServiceAccount(suite.authContext(role.Admin), &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-admin"),
+		Role:                string(role.Admin),
+	})
+	suite.Require().NoError(err)
+
+	assertRole(resp, role.Admin)
+
+	// the configured default role is used if the role is not set
+	resp, err = suite.managementServer.CreateServiceAccount(operatorCtx, &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-default"),
+	})
+	suite.Require().NoError(err)
+
+	assertRole(resp, role.Role(config.Config.Auth.ServiceAccountDefaultRole))
+
+	// the default role is capped by the caller's role
+	defaultRole := config.Config.Auth.ServiceAccountDefaultRole
+	config.Config.Auth.ServiceAccountDefaultRole = string(role.Admin)
+
+	defer func() {
+		config.Config.Auth.ServiceAccountDefaultRole = defaultRole
+	}()
+
+	resp, err = suite.managementServer.CreateServiceAccount(operatorCtx, &management.CreateServiceAccountRequest{
+		ArmoredPgpPublicKey: armoredKey("sa-capped"),
+	})
+	suite.Require().NoError(err)
+
+	assertRole(resp, role.Operator)
+}
+
+func (suite *GrpcSuite) TestValidateServiceAccountKey() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	key, err := pgp.GenerateKey("sa1", "test", "sa1"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+	suite.Require().NoError(err)
+
+	armored, err := key.ArmorPublic()
+	suite.Require().NoError(err)
+
+	resp, err := client.ValidateServiceAccountKey(suite.ctx, &management.ValidateServiceAccountKeyRequest{
+		ArmoredPgpPublicKey: armored,
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("sa1", resp.Username)
+	suite.Assert().Equal(key.Fingerprint(), resp.PublicKeyId)
+
+	_, err = client.ValidateServiceAccountKey(suite.ctx, &management.ValidateServiceAccountKeyRequest{
+		ArmoredPgpPublicKey: "invalid",
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestTestServiceAccount() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-test", "sa-test-user")
+
+	key, err := pgp.GenerateKey("sa-test", "test", "sa-test"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+	suite.Require().NoError(err)
+
+	armored, err := key.ArmorPublic()
+	suite.Require().NoError(err)
+
+	publicKey := authres.NewPublicKey(resources.DefaultNamespace, key.Fingerprint())
+	publicKey.Metadata().Labels().Set(authres.LabelPublicKeyUserID, "sa-test-user")
+	publicKey.TypedSpec().Value.PublicKey = []byte(armored)
+	publicKey.TypedSpec().Value.Role = string(role.Reader)
+	publicKey.TypedSpec().Value.Confirmed = true
+
+	proof, err := key.Sign([]byte("sa-test"))
+	suite.Require().NoError(err)
+
+	_, err = client.TestServiceAccount(suite.ctx, &management.TestServiceAccountRequest{Name: "sa-test", SignedProof: proof})
+	suite.Require().Equal(codes.FailedPrecondition, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, publicKey))
+
+	resp, err := client.TestServiceAccount(suite.ctx, &management.TestServiceAccountRequest{Name: "sa-test", SignedProof: proof})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(key.Fingerprint(), resp.PublicKeyId)
+	suite.Assert().Equal(string(role.Reader), resp.Role)
+
+	// the proof made with another key pair
+	otherKey, err := pgp.GenerateKey("sa-test", "test", "sa-test"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+	suite.Require().NoError(err)
+
+	otherProof, err := otherKey.Sign([]byte("sa-test"))
+	suite.Require().NoError(err)
+
+	_, err = client.TestServiceAccount(suite.ctx, &management.TestServiceAccountRequest{Name: "sa-test", SignedProof: otherProof})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.TestServiceAccount(suite.ctx, &management.TestServiceAccountRequest{Name: "sa-missing", SignedProof: proof})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = client.TestServiceAccount(suite.ctx, &management.TestServiceAccountRequest{Name: "sa-test"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestRotateServiceAccount() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-rotate", "user-rotate", "old-key1", "old-key2")
+
+	key, err := pgp.GenerateKey("sa-rotate", "test", "sa-rotate"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+	suite.Require().NoError(err)
+
+	armored, err := key.ArmorPublic()
+	suite.Require().NoError(err)
+
+	resp, err := client.RotateServiceAccount(suite.ctx, &management.RotateServiceAccountRequest{
+		Name:                "sa-rotate",
+		ArmoredPgpPublicKey: armored,
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(key.Fingerprint(), resp.PublicKeyId)
+	suite.Assert().ElementsMatch([]string{"old-key1", "old-key2"}, resp.RevokedPublicKeyIds)
+	suite.Assert().Empty(resp.FailedPublicKeyIds)
+
+	keys, err := safe.StateListAll[*authres.PublicKey](suite.ctx, suite.state, state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, "user-rotate")))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(1, keys.Len())
+	suite.Assert().Equal(key.Fingerprint(), keys.Get(0).Metadata().ID())
+
+	_, err = client.RotateServiceAccount(suite.ctx, &management.RotateServiceAccountRequest{
+		Name:                "sa-missing",
+		ArmoredPgpPublicKey: armored,
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestRenewServiceAccountGracePeriod() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-renew", "user-renew", "old-key")
+
+	renew := func(gracePeriod *durationpb.Duration) (*management.RenewServiceAccountResponse, error) {
+		key, err := pgp.GenerateKey("sa-renew", "test", "sa-renew"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+		suite.Require().NoError(err)
+
+		armored, err := key.ArmorPublic()
+		suite.Require().NoError(err)
+
+		return client.RenewServiceAccount(suite.ctx, &management.RenewServiceAccountRequest{
+			Name:                "sa-renew",
+			ArmoredPgpPublicKey: armored,
+			GracePeriod:         gracePeriod,
+		})
+	}
+
+	getKey := func(id string) *specs.PublicKeySpec {
+		key, err := safe.StateGet[*authres.PublicKey](suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, id).Metadata())
+		suite.Require().NoError(err)
+
+		return key.TypedSpec().Value
+	}
+
+	_, err := renew(durationpb.New(-time.Minute))
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	oldExpiration := getKey("old-key").Expiration.AsTime()
+
+	// the keys which expire before the end of the grace period are kept valid until the end of the grace period
+	resp, err := renew(durationpb.New(2 * time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.RetiredKeys, 1)
+	suite.Assert().Equal("old-key", resp.RetiredKeys[0].PublicKeyId)
+	suite.Assert().WithinDuration(time.Now().Add(2*time.Hour), resp.RetiredKeys[0].RetireAt.AsTime(), time.Minute)
+
+	oldKey := getKey("old-key")
+
+	suite.Assert().True(oldExpiration.Equal(oldKey.Expiration.AsTime()))
+	suite.Assert().True(resp.RetiredKeys[0].RetireAt.AsTime().Equal(oldKey.RetireAt.AsTime()))
+	suite.Assert().True(authres.PublicKeyValidUntil(oldKey).Equal(oldKey.RetireAt.AsTime()))
+
+	firstRetireAt := oldKey.RetireAt.AsTime()
+	secondKeyID := resp.PublicKeyId
+
+	// the keys which expire after the end of the grace period are retired when they expire,
+	// the already retired keys are never retired earlier
+	resp, err = renew(durationpb.New(10 * time.Minute))
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.RetiredKeys, 2)
+
+	retireAt := xslices.ToMap(resp.RetiredKeys, func(retiredKey *management.RenewServiceAccountResponse_RetiredKey) (string, time.Time) {
+		return retiredKey.PublicKeyId, retiredKey.RetireAt.AsTime()
+	})
+
+	suite.Assert().True(firstRetireAt.Equal(retireAt["old-key"]))
+	suite.Assert().True(getKey(secondKeyID).Expiration.AsTime().Equal(retireAt[secondKeyID]))
+	suite.Assert().True(getKey(secondKeyID).RetireAt.AsTime().Equal(retireAt[secondKeyID]))
+	suite.Assert().Nil(getKey(resp.PublicKeyId).RetireAt)
+
+	// without the grace period, the old keys are kept until they expire
+	resp, err = renew(nil)
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(resp.RetiredKeys)
+}
+
+func (suite *GrpcSuite) TestListServiceAccountsInternal() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-user", "user-sa-user", "key-sa-user")
+	suite.createServiceAccount("sa-internal", "user-sa-internal", "key-sa-internal")
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, authres.NewIdentity(resources.DefaultNamespace, "sa-internal"+pkgaccess.ServiceAccountNameSuffix).Metadata(),
+		func(res *authres.Identity) error {
+			res.Metadata().Labels().Set(authres.LabelIdentityInternal, "")
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	listNames := func(includeInternal bool) []string {
+		resp, listErr := client.ListServiceAccounts(suite.ctx, &management.ListServiceAccountsRequest{
+			IncludeInternal: includeInternal,
+		})
+		suite.Require().NoError(listErr)
+
+		return xslices.Map(resp.ServiceAccounts, func(sa *management.ListServiceAccountsResponse_ServiceAccount) string {
+			return sa.Name
+		})
+	}
+
+	names := listNames(false)
+	suite.Assert().Contains(names, "sa-user")
+	suite.Assert().NotContains(names, "sa-internal")
+
+	names = listNames(true)
+	suite.Assert().Contains(names, "sa-user")
+	suite.Assert().Contains(names, "sa-internal")
+}
+
+func (suite *GrpcSuite) TestListServiceAccountsExpired() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("sa-active", "user-sa-active", "key-active")
+	suite.createServiceAccount("sa-mixed", "user-sa-mixed", "key-mixed-expired", "key-mixed-active")
+	suite.createServiceAccount("sa-stale", "user-sa-stale", "key-stale")
+
+	for _, keyID := range []string{"key-mixed-expired", "key-stale"} {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, keyID).Metadata(), func(res *authres.PublicKey) error {
+			res.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(-time.Hour))
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	expiredKeys := func(excludeExpired bool) map[string][]string {
+		resp, err := client.ListServiceAccounts(suite.ctx, &management.ListServiceAccountsRequest{
+			ExcludeExpired: excludeExpired,
+		})
+		suite.Require().NoError(err)
+
+		accounts := map[string][]string{}
+
+		for _, sa := range resp.ServiceAccounts {
+			if !strings.HasPrefix(sa.Name, "sa-") {
+				continue
+			}
+
+			accounts[sa.Name] = []string{}
+
+			for _, key := range sa.PgpPublicKeys {
+				if key.Expired {
+					accounts[sa.Name] = append(accounts[sa.Name], key.Id)
+				}
+			}
+		}
+
+		return accounts
+	}
+
+	suite.Assert().Equal(map[string][]string{
+		"sa-active": {},
+		"sa-mixed":  {"key-mixed-expired"},
+		"sa-stale":  {"key-stale"},
+	}, expiredKeys(false))
+
+	// the accounts with the mixed keys are kept, the expired keys are still flagged
+	suite.Assert().Equal(map[string][]string{
+		"sa-active": {},
+		"sa-mixed":  {"key-mixed-expired"},
+	}, expiredKeys(true))
+}
+
+func (suite *GrpcSuite) TestMachineGroups() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, rack := range map[string]string{"machine-group-1": "rack-1", "machine-group-2": "rack-1", "machine-group-3": "rack-2"} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.Metadata().Labels().Set("rack", rack)
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	_, err := client.CreateMachineGroup(suite.ctx, &management.CreateMachineGroupRequest{
+		Name:        "rack-1",
+		MatchLabels: []string{"rack in (a"},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	createResp, err := client.CreateMachineGroup(suite.ctx, &management.CreateMachineGroupRequest{
+		Name:        "rack-1",
+		MatchLabels: []string{"rack = rack-1"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().ElementsMatch([]string{"machine-group-1", "machine-group-2"}, createResp.MachineIds)
+
+	_, err = client.LabelMachineGroup(suite.ctx, &management.LabelMachineGroupRequest{
+		Name:   "rack-1",
+		Labels: map[string]string{omni.LabelCluster: "test"},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	labelResp, err := client.LabelMachineGroup(suite.ctx, &management.LabelMachineGroupRequest{
+		Name:   "rack-1",
+		Labels: map[string]string{"pool": "gpu"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().ElementsMatch([]string{"machine-group-1", "machine-group-2"}, labelResp.MachineIds)
+	suite.Assert().Empty(labelResp.Failures)
+
+	for _, id := range []string{"machine-group-1", "machine-group-2"} {
+		machineLabels, getErr := safe.StateGet[*omni.MachineLabels](suite.ctx, suite.state, omni.NewMachineLabels(resources.DefaultNamespace, id).Metadata())
+		suite.Require().NoError(getErr)
+
+		value, ok := machineLabels.Metadata().Labels().Get("pool")
+		suite.Assert().True(ok)
+		suite.Assert().Equal("gpu", value)
+	}
+
+	_, err = client.LabelMachineGroup(suite.ctx, &management.LabelMachineGroupRequest{
+		Name:   "missing",
+		Labels: map[string]string{"pool": "gpu"},
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestGetMachinePatchOrder() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "patch-order-machine")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "patch-order")
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, "patch-order-workers")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineSet(resources.DefaultNamespace, "patch-order-workers")))
+
+	for _, patch := range []*omni.ConfigPatch{
+		omni.NewConfigPatch(resources.DefaultNamespace, "000-machine", pair.MakePair(omni.LabelMachine, clusterMachine.Metadata().ID())),
+		omni.NewConfigPatch(resources.DefaultNamespace, "001-cluster-machine",
+			pair.MakePair(omni.LabelCluster, "patch-order"), pair.MakePair(omni.LabelClusterMachine, clusterMachine.Metadata().ID())),
+		omni.NewConfigPatch(resources.DefaultNamespace, "002-machine-set",
+			pair.MakePair(omni.LabelCluster, "patch-order"), pair.MakePair(omni.LabelMachineSet, "patch-order-workers")),
+		omni.NewConfigPatch(resources.DefaultNamespace, "003-cluster", pair.MakePair(omni.LabelCluster, "patch-order")),
+		omni.NewConfigPatch(resources.DefaultNamespace, "004-other-machine-set",
+			pair.MakePair(omni.LabelCluster, "patch-order"), pair.MakePair(omni.LabelMachineSet, "patch-order-control-planes")),
+	} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, patch))
+	}
+
+	resp, err := client.GetMachinePatchOrder(suite.ctx, &management.GetMachinePatchOrderRequest{
+		MachineId: clusterMachine.Metadata().ID(),
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"003-cluster", "002-machine-set", "001-cluster-machine", "000-machine"},
+		xslices.Map(resp.Patches, func(patch *management.GetMachinePatchOrderResponse_Patch) string { return patch.Id }))
+	suite.Assert().Equal([]management.GetMachinePatchOrderResponse_Patch_Source{
+		management.GetMachinePatchOrderResponse_Patch_CLUSTER,
+		management.GetMachinePatchOrderResponse_Patch_MACHINE_SET,
+		management.GetMachinePatchOrderResponse_Patch_CLUSTER_MACHINE,
+		management.GetMachinePatchOrderResponse_Patch_MACHINE,
+	}, xslices.Map(resp.Patches, func(patch *management.GetMachinePatchOrderResponse_Patch) management.GetMachinePatchOrderResponse_Patch_Source {
+		return patch.Source
+	}))
+
+	_, err = client.GetMachinePatchOrder(suite.ctx, &management.GetMachinePatchOrderRequest{
+		MachineId: "missing",
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestKubernetesUpgradeWindow() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "upgrade-window")))
+
+	_, err := client.SetKubernetesUpgradeWindow(suite.ctx, &management.SetKubernetesUpgradeWindowRequest{
+		ClusterName: "upgrade-window",
+		Start:       "1:00pm",
+		Duration:    durationpb.New(time.Hour),
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetKubernetesUpgradeWindow(suite.ctx, &management.SetKubernetesUpgradeWindowRequest{
+		ClusterName: "missing",
+		Start:       "01:00",
+		Duration:    durationpb.New(time.Hour),
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = client.SetKubernetesUpgradeWindow(suite.ctx, &management.SetKubernetesUpgradeWindowRequest{
+		ClusterName: "upgrade-window",
+		Start:       "01:00",
+		Duration:    durationpb.New(4 * time.Hour),
+		Days:        []uint32{uint32(time.Saturday)},
+	})
+	suite.Require().NoError(err)
+
+	window, err := safe.StateGet[*omni.KubernetesUpgradeWindow](suite.ctx, suite.state, omni.NewKubernetesUpgradeWindow(resources.DefaultNamespace, "upgrade-window").Metadata())
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("01:00", window.TypedSpec().Value.Start)
+	suite.Assert().Equal(4*time.Hour, window.TypedSpec().Value.Duration.AsDuration())
+	suite.Assert().Equal([]uint32{uint32(time.Saturday)}, window.TypedSpec().Value.Days)
+
+	_, err = client.DeleteKubernetesUpgradeWindow(suite.ctx, &management.DeleteKubernetesUpgradeWindowRequest{
+		ClusterName: "upgrade-window",
+	})
+	suite.Require().NoError(err)
+
+	_, err = client.DeleteKubernetesUpgradeWindow(suite.ctx, &management.DeleteKubernetesUpgradeWindowRequest{
+		ClusterName: "upgrade-window",
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestDecommissionMachine() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	link := siderolink.NewLink(resources.DefaultNamespace, "decommission", &specs.SiderolinkSpec{
+		NodeSubnet: "fdae:41e4:649b:9303:2a07:9c7:5b08:aef7/64",
+	})
+	suite.Require().NoError(suite.state.Create(suite.ctx, link))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{link.Metadata().ID()}, func(*omni.Machine, *assert.Assertions) {})
+
+	machineLabels := omni.NewMachineLabels(resources.DefaultNamespace, link.Metadata().ID())
+	machineLabels.Metadata().Labels().Set("pool", "gpu")
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineLabels))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx,
+		omni.NewConfigPatch(resources.DefaultNamespace, "decommission-patch", pair.MakePair(omni.LabelMachine, link.Metadata().ID()))))
+
+	cpSet := omni.NewMachineSet(resources.DefaultNamespace, "decommission-control-planes")
+	cpSet.Metadata().Labels().Set(omni.LabelCluster, "decommission")
+	cpSet.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	for _, id := range []string{"decommission-cp-1", "decommission-cp-2"} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachine(resources.DefaultNamespace, id)))
+		suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineSetNode(resources.DefaultNamespace, id, cpSet)))
+	}
+
+	decommission := func(machineID string, confirmControlPlane bool) ([]string, error) {
+		stream, err := client.DecommissionMachine(suite.ctx, &management.DecommissionMachineRequest{
+			MachineId:           machineID,
+			ConfirmControlPlane: confirmControlPlane,
+		})
+		suite.Require().NoError(err)
+
+		var steps []string
+
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return steps, nil
+			}
+
+			if err != nil {
+				return steps, err
+			}
+
+			steps = append(steps, msg.Step)
+		}
+	}
+
+	_, err := decommission("missing", false)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = decommission("decommission-cp-1", false)
+	suite.Require().Equal(codes.FailedPrecondition, status.Code(err))
+
+	steps, err := decommission(link.Metadata().ID(), false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{
+		"the machine is not part of a cluster, skipping the reset",
+		"removing the machine config patches and labels",
+		"removing the machine",
+		"the machine is decommissioned",
+	}, steps)
+
+	rtestutils.AssertNoResource[*omni.Machine](suite.ctx, suite.T(), suite.state, link.Metadata().ID())
+	rtestutils.AssertNoResource[*siderolink.Link](suite.ctx, suite.T(), suite.state, link.Metadata().ID())
+	rtestutils.AssertNoResource[*omni.MachineLabels](suite.ctx, suite.T(), suite.state, link.Metadata().ID())
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, "decommission-patch")
+}
+
+func (suite *GrpcSuite) TestListMachinesByTalosVersion() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, version := range map[string]string{
+		"talos-version-1": "v1.6.4",
+		"talos-version-2": "1.6.4",
+		"talos-version-3": "v1.7.0",
+		"talos-version-4": "v1.5.5",
+		"talos-version-5": "",
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.TalosVersion = version
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	_, err := client.ListMachinesByTalosVersion(suite.ctx, &management.ListMachinesByTalosVersionRequest{
+		Constraint: "~1.6",
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	resp, err := client.ListMachinesByTalosVersion(suite.ctx, &management.ListMachinesByTalosVersionRequest{
+		Constraint: ">=1.6.0",
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.Groups, 2)
+	suite.Assert().Equal("1.6.4", resp.Groups[0].Version)
+	suite.Assert().ElementsMatch([]string{"talos-version-1", "talos-version-2"}, resp.Groups[0].MachineIds)
+	suite.Assert().Equal("1.7.0", resp.Groups[1].Version)
+	suite.Assert().Equal([]string{"talos-version-3"}, resp.Groups[1].MachineIds)
+	suite.Assert().Equal([]string{"talos-version-5"}, resp.UnknownMachineIds)
+}
+
+func (suite *GrpcSuite) TestListUnhealthyMachines() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, update := range map[string]func(*specs.MachineStatusSpec){
+		"unhealthy-connected": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+		},
+		"unhealthy-disconnected": func(spec *specs.MachineStatusSpec) {
+			spec.Cluster = "unhealthy"
+		},
+		"unhealthy-poll": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+			spec.LastError = "unknown error"
+			spec.PollStatuses = map[string]specs.MachineStatusSpec_PollStatus{
+				"disks":   specs.MachineStatusSpec_ERROR,
+				"version": specs.MachineStatusSpec_DATA,
+				"routes":  specs.MachineStatusSpec_ERROR,
+			}
+		},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		update(machineStatus.TypedSpec().Value)
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	resp, err := client.ListUnhealthyMachines(suite.ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	machines := map[string]*management.ListUnhealthyMachinesResponse_Machine{}
+
+	for _, machine := range resp.Machines {
+		if strings.HasPrefix(machine.MachineId, "unhealthy-") {
+			machines[machine.MachineId] = machine
+		}
+	}
+
+	suite.Require().Len(machines, 2)
+
+	suite.Assert().Equal("unhealthy", machines["unhealthy-disconnected"].Cluster)
+	suite.Assert().False(machines["unhealthy-disconnected"].Connected)
+	suite.Assert().Equal("machine is disconnected", machines["unhealthy-disconnected"].Reason)
+
+	suite.Assert().True(machines["unhealthy-poll"].Connected)
+	suite.Assert().Equal([]string{"disks", "routes"}, machines["unhealthy-poll"].FailedPolls)
+	suite.Assert().Equal("last poll failed: unknown error; failed to poll disks, routes", machines["unhealthy-poll"].Reason)
+}
+
+func (suite *GrpcSuite) TestListMachinesByUptime() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	now := time.Now()
+
+	for id, update := range map[string]func(*specs.MachineStatusSpec){
+		"uptime-long": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+			spec.ConnectivityChangedAt = timestamppb.New(now.Add(-72 * time.Hour))
+		},
+		"uptime-longest": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+			spec.Cluster = "uptime"
+			spec.ConnectivityChangedAt = timestamppb.New(now.Add(-96 * time.Hour))
+		},
+		"uptime-flapping": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+			spec.ConnectivityChangedAt = timestamppb.New(now.Add(-time.Minute))
+		},
+		"uptime-disconnected": func(spec *specs.MachineStatusSpec) {
+			spec.ConnectivityChangedAt = timestamppb.New(now.Add(-96 * time.Hour))
+		},
+		"uptime-unknown": func(spec *specs.MachineStatusSpec) {
+			spec.Connected = true
+		},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		update(machineStatus.TypedSpec().Value)
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	_, err := client.ListMachinesByUptime(suite.ctx, &management.ListMachinesByUptimeRequest{MinConnectedDuration: durationpb.New(-time.Hour)})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	machineIDs := func(resp *management.ListMachinesByUptimeResponse) []string {
+		return xslices.Filter(xslices.Map(resp.Machines, func(machine *management.ListMachinesByUptimeResponse_Machine) string {
+			return machine.MachineId
+		}), func(id string) bool {
+			return strings.HasPrefix(id, "uptime-")
+		})
+	}
+
+	resp, err := client.ListMachinesByUptime(suite.ctx, &management.ListMachinesByUptimeRequest{})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"uptime-longest", "uptime-long", "uptime-flapping"}, machineIDs(resp))
+
+	resp, err = client.ListMachinesByUptime(suite.ctx, &management.ListMachinesByUptimeRequest{MinConnectedDuration: durationpb.New(48 * time.Hour)})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"uptime-longest", "uptime-long"}, machineIDs(resp))
+	suite.Assert().Equal("uptime", resp.Machines[0].Cluster)
+	suite.Assert().GreaterOrEqual(resp.Machines[0].ConnectedDuration.AsDuration(), 96*time.Hour)
+}
+
+func (suite *GrpcSuite) TestListMachinesWithHardwareChanges() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	now := time.Now()
+
+	for id, changedAt := range map[string]time.Time{
+		"hwchange-old":    now.Add(-48 * time.Hour),
+		"hwchange-recent": now.Add(-time.Hour),
+		"hwchange-latest": now.Add(-time.Minute),
+		"hwchange-none":   {},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+
+		if !changedAt.IsZero() {
+			machineStatus.TypedSpec().Value.HardwareChange = &specs.MachineStatusSpec_HardwareChange{
+				ChangedAt: timestamppb.New(changedAt),
+				Changes:   []string{"disk added: QEMU HARDDISK 11 GB"},
+			}
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	machineIDs := func(resp *management.ListMachinesWithHardwareChangesResponse) []string {
+		return xslices.Filter(xslices.Map(resp.Machines, func(machine *management.ListMachinesWithHardwareChangesResponse_Machine) string {
+			return machine.MachineId
+		}), func(id string) bool {
+			return strings.HasPrefix(id, "hwchange-")
+		})
+	}
+
+	resp, err := client.ListMachinesWithHardwareChanges(suite.ctx, &management.ListMachinesWithHardwareChangesRequest{})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"hwchange-latest", "hwchange-recent", "hwchange-old"}, machineIDs(resp))
+
+	resp, err = client.ListMachinesWithHardwareChanges(suite.ctx, &management.ListMachinesWithHardwareChangesRequest{Since: timestamppb.New(now.Add(-24 * time.Hour))})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"hwchange-latest", "hwchange-recent"}, machineIDs(resp))
+	suite.Assert().Equal([]string{"disk added: QEMU HARDDISK 11 GB"}, resp.Machines[0].Changes)
+}
+
+func (suite *GrpcSuite) TestListMachinesPendingReboot() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, rebootStatus := range map[string]*specs.MachineStatusSpec_RebootStatus{
+		"reboot-pending": {
+			State:  specs.MachineStatusSpec_RebootStatus_PENDING,
+			Reason: "staged configuration is applied after a reboot",
+		},
+		"reboot-rebooting": {
+			State:  specs.MachineStatusSpec_RebootStatus_REBOOTING,
+			Reason: "machine is rebooting",
+		},
+		"reboot-none":    {},
+		"reboot-unknown": nil,
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.RebootStatus = rebootStatus
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	resp, err := client.ListMachinesPendingReboot(suite.ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	machines := xslices.Filter(resp.Machines, func(machine *management.ListMachinesPendingRebootResponse_Machine) bool {
+		return strings.HasPrefix(machine.MachineId, "reboot-")
+	})
+
+	suite.Require().Len(machines, 2)
+
+	suite.Assert().Equal("reboot-pending", machines[0].MachineId)
+	suite.Assert().Equal(specs.MachineStatusSpec_RebootStatus_PENDING, machines[0].State)
+	suite.Assert().Equal("staged configuration is applied after a reboot", machines[0].Reason)
+
+	suite.Assert().Equal("reboot-rebooting", machines[1].MachineId)
+	suite.Assert().Equal(specs.MachineStatusSpec_RebootStatus_REBOOTING, machines[1].State)
+}
+
+func (suite *GrpcSuite) TestQueryMachineStatuses() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, rack := range map[string]string{
+		"query-1": "a",
+		"query-2": "a",
+		"query-3": "a",
+		"query-4": "b",
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.Metadata().Labels().Set("query-rack", rack)
+		machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{Arch: "amd64"}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	resp, err := client.QueryMachineStatuses(suite.ctx, &management.QueryMachineStatusesRequest{LabelSelector: "query-rack=a", Limit: 2})
+	suite.Require().NoError(err)
+
+	suite.Assert().EqualValues(3, resp.Total)
+	suite.Require().Len(resp.Machines, 2)
+	suite.Assert().Equal("query-1", resp.Machines[0].MachineId)
+	suite.Assert().Equal("query-2", resp.Machines[1].MachineId)
+	suite.Assert().Equal("a", resp.Machines[0].Labels["query-rack"])
+	suite.Assert().Equal("amd64", resp.Machines[0].Status.GetHardware().GetArch())
+
+	resp, err = client.QueryMachineStatuses(suite.ctx, &management.QueryMachineStatusesRequest{LabelSelector: "query-rack=a", Offset: 2, Limit: 2})
+	suite.Require().NoError(err)
+
+	suite.Assert().EqualValues(3, resp.Total)
+	suite.Require().Len(resp.Machines, 1)
+	suite.Assert().Equal("query-3", resp.Machines[0].MachineId)
+
+	resp, err = client.QueryMachineStatuses(suite.ctx, &management.QueryMachineStatusesRequest{LabelSelector: "query-rack=a", Offset: 5})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.Machines)
+
+	_, err = client.QueryMachineStatuses(suite.ctx, &management.QueryMachineStatusesRequest{LabelSelector: "query-rack in (a"})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.QueryMachineStatuses(suite.ctx, &management.QueryMachineStatusesRequest{Limit: -1})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestMachineReservation() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.SetMachineReserved(suite.ctx, &management.SetMachineReservedRequest{MachineId: "reserved", Reserved: true})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachine(resources.DefaultNamespace, "reserved")))
+
+	_, err = client.SetMachineReserved(suite.ctx, &management.SetMachineReservedRequest{MachineId: "reserved", Reserved: true})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"reserved"}, func(*omni.MachineReservation, *assert.Assertions) {})
+
+	// reserving the machine again is a no-op
+	_, err = client.SetMachineReserved(suite.ctx, &management.SetMachineReservedRequest{MachineId: "reserved", Reserved: true})
+	suite.Require().NoError(err)
+
+	for id, cluster := range map[string]string{
+		"unallocated-reserved": "",
+		"unallocated":          "",
+		"allocated":            "reservation",
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.Cluster = cluster
+
+		if id == "unallocated-reserved" {
+			machineStatus.Metadata().Labels().Set(omni.LabelReserved, "")
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	listIDs := func(excludeReserved bool) []string {
+		resp, listErr := client.ListUnallocatedMachines(suite.ctx, &management.ListUnallocatedMachinesRequest{ExcludeReserved: excludeReserved})
+		suite.Require().NoError(listErr)
+
+		return xslices.Map(resp.Machines, func(machine *management.ListUnallocatedMachinesResponse_Machine) string { return machine.MachineId })
+	}
+
+	suite.Assert().Subset(listIDs(false), []string{"unallocated-reserved", "unallocated"})
+	suite.Assert().NotContains(listIDs(false), "allocated")
+	suite.Assert().Contains(listIDs(true), "unallocated")
+	suite.Assert().NotContains(listIDs(true), "unallocated-reserved")
+
+	_, err = client.SetMachineReserved(suite.ctx, &management.SetMachineReservedRequest{MachineId: "reserved"})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.MachineReservation](suite.ctx, suite.T(), suite.state, "reserved")
+}
+
+func (suite *GrpcSuite) TestKubeconfigTTL() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.Kubeconfig(suite.ctx, &management.KubeconfigRequest{Ttl: durationpb.New(time.Second)})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.Kubeconfig(suite.ctx, &management.KubeconfigRequest{Ttl: durationpb.New(24 * time.Hour)})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.Kubeconfig(suite.ctx, &management.KubeconfigRequest{
+		ServiceAccount:    true,
+		ServiceAccountTtl: durationpb.New(time.Hour),
+		Ttl:               durationpb.New(time.Hour),
+	})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestSetMachineLogRetention() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.SetMachineLogRetention(suite.ctx, &management.SetMachineLogRetentionRequest{MachineId: "log-retention", MaxSizeBytes: 1 << 20})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachine(resources.DefaultNamespace, "log-retention")))
+
+	_, err = client.SetMachineLogRetention(suite.ctx, &management.SetMachineLogRetentionRequest{MachineId: "log-retention", MaxSizeBytes: 1024})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetMachineLogRetention(suite.ctx, &management.SetMachineLogRetentionRequest{MachineId: "log-retention", MaxSizeBytes: 1 << 20})
+	suite.Require().NoError(err)
+
+	retention, err := safe.StateGet[*omni.MachineLogRetention](suite.ctx, suite.state, omni.NewMachineLogRetention(resources.DefaultNamespace, "log-retention").Metadata())
+	suite.Require().NoError(err)
+
+	suite.Assert().EqualValues(1<<20, retention.TypedSpec().Value.MaxSizeBytes)
+
+	_, err = client.SetMachineLogRetention(suite.ctx, &management.SetMachineLogRetentionRequest{MachineId: "log-retention"})
+	suite.Require().NoError(err)
+
+	_, err = suite.state.Get(suite.ctx, retention.Metadata())
+	suite.Assert().True(state.IsNotFoundError(err))
+}
+
+func (suite *GrpcSuite) TestGetMachineDmesg() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	recvErr := func(machineID string) error {
+		stream, err := client.GetMachineDmesg(suite.ctx, &management.GetMachineDmesgRequest{
+			MachineId: machineID,
+		})
+		suite.Require().NoError(err)
+
+		_, err = stream.Recv()
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.NotFound, status.Code(recvErr("dmesg-missing")))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "dmesg-disconnected")
+	machineStatus.TypedSpec().Value.Maintenance = true
+	machineStatus.TypedSpec().Value.ManagementAddress = "127.0.0.1:50000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	suite.Assert().Equal(codes.Unavailable, status.Code(recvErr("dmesg-disconnected")))
+}
+
+func (suite *GrpcSuite) TestGetMachineResource() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	getResource := func(machineID, resourceType string) error {
+		_, err := client.GetMachineResource(suite.ctx, &management.GetMachineResourceRequest{
+			MachineId: machineID,
+			Namespace: "runtime",
+			Type:      resourceType,
+			Id:        "1",
+		})
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(getResource("", "MachineStatuses.runtime.talos.dev")))
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(getResource("resource-disconnected", "")))
+	suite.Assert().Equal(codes.NotFound, status.Code(getResource("resource-missing", "MachineStatuses.runtime.talos.dev")))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "resource-disconnected")
+	machineStatus.TypedSpec().Value.ManagementAddress = "127.0.0.1:50000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	suite.Assert().Equal(codes.Unavailable, status.Code(getResource("resource-disconnected", "MachineStatuses.runtime.talos.dev")))
+}
+
+func (suite *GrpcSuite) TestReapplyMachineConfig() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	reapply := func(machineID string) error {
+		_, err := client.ReapplyMachineConfig(suite.ctx, &management.ReapplyMachineConfigRequest{
+			MachineId: machineID,
+		})
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(reapply("")))
+	suite.Assert().Equal(codes.NotFound, status.Code(reapply("reapply-missing")))
+
+	unallocated := omni.NewMachineStatus(resources.DefaultNamespace, "reapply-unallocated")
+	suite.Require().NoError(suite.state.Create(suite.ctx, unallocated))
+
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(reapply("reapply-unallocated")))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "reapply-allocated")
+	machineStatus.TypedSpec().Value.Cluster = "reapply"
+	machineStatus.TypedSpec().Value.ManagementAddress = "127.0.0.1:50000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	// the config is not generated yet
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(reapply("reapply-allocated")))
+
+	machineConfig := omni.NewClusterMachineConfig(resources.DefaultNamespace, "reapply-allocated")
+	machineConfig.TypedSpec().Value.Data = []byte("version: v1alpha1\nmachine:\n  type: worker\n")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineConfig))
+
+	// the machine is disconnected
+	suite.Assert().Equal(codes.Unavailable, status.Code(reapply("reapply-allocated")))
+}
+
+func (suite *GrpcSuite) TestApplyMaintenanceConfig() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	input, err := generate.NewInput("maintenance", "https://doesntmatter:6443", talosconstants.DefaultKubernetesVersion, generate.WithInstallDisk("/dev/vda"))
+	suite.Require().NoError(err)
+
+	machineConfig, err := input.Config(talosmachine.TypeWorker)
+	suite.Require().NoError(err)
+
+	validConfig, err := machineConfig.EncodeString()
+	suite.Require().NoError(err)
+
+	apply := func(machineID, config string) error {
+		_, err := client.ApplyMaintenanceConfig(suite.ctx, &management.ApplyMaintenanceConfigRequest{
+			MachineId: machineID,
+			Config:    config,
+		})
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(apply("", validConfig)))
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(apply("maintenance", "")))
+	suite.Assert().Equal(codes.NotFound, status.Code(apply("maintenance-missing", validConfig)))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatus(resources.DefaultNamespace, "maintenance-running")))
+
+	// the machine has Talos installed
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(apply("maintenance-running", validConfig)))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "maintenance")
+	machineStatus.TypedSpec().Value.Maintenance = true
+	machineStatus.TypedSpec().Value.ManagementAddress = "127.0.0.1:50000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(apply("maintenance", "not a config")))
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(apply("maintenance", "version: v1alpha1\nmachine:\n  type: worker\n")))
+
+	// the machine is disconnected
+	suite.Assert().Equal(codes.Unavailable, status.Code(apply("maintenance", validConfig)))
+}
+
+func (suite *GrpcSuite) TestGetPendingMachineConfig() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetPendingMachineConfig(suite.ctx, &management.GetPendingMachineConfigRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetPendingMachineConfig(suite.ctx, &management.GetPendingMachineConfigRequest{MachineId: "pending"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "pending")
+	machineStatus.TypedSpec().Value.Cluster = "pending"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	_, err = client.GetPendingMachineConfig(suite.ctx, &management.GetPendingMachineConfigRequest{MachineId: "pending"})
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	machineConfig := omni.NewClusterMachineConfig(resources.DefaultNamespace, "pending")
+	machineConfig.TypedSpec().Value.Data = []byte("version: v1alpha1\nmachine:\n  type: worker\n")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineConfig))
+
+	resp, err := client.GetPendingMachineConfig(suite.ctx, &management.GetPendingMachineConfigRequest{MachineId: "pending"})
+	suite.Require().NoError(err)
+
+	suite.Assert().True(resp.Pending)
+	suite.Assert().Empty(resp.AppliedConfigVersion)
+	suite.Assert().Equal([]string{"no config was applied to the machine yet", "machine is disconnected"}, resp.Reasons)
+	suite.Assert().Empty(resp.Diff)
+
+	shaSum := sha256.Sum256(machineConfig.TypedSpec().Value.Data)
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "pending")
+	configStatus.TypedSpec().Value.ClusterMachineConfigVersion = resp.ConfigVersion
+	configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = hex.EncodeToString(shaSum[:])
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+
+	resp, err = client.GetPendingMachineConfig(suite.ctx, &management.GetPendingMachineConfigRequest{MachineId: "pending"})
+	suite.Require().NoError(err)
+
+	suite.Assert().False(resp.Pending)
+	suite.Assert().Equal(resp.ConfigVersion, resp.AppliedConfigVersion)
+	suite.Assert().Empty(resp.Reasons)
+}
+
+func (suite *GrpcSuite) TestOmniconfigFor() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.OmniconfigFor(suite.ctx, &management.OmniconfigForRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.OmniconfigFor(suite.ctx, &management.OmniconfigForRequest{Identity: "missing@example.com"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.createServiceAccount("onboarding", "user-onboarding")
+
+	identity := "onboarding" + pkgaccess.ServiceAccountNameSuffix
+
+	resp, err := client.OmniconfigFor(suite.ctx, &management.OmniconfigForRequest{Identity: identity})
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(resp.Omniconfig), "identity: "+identity)
+}
+
+func (suite *GrpcSuite) TestSimulateAccessPolicy() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	suite.createServiceAccount("simulate", "user-simulate")
+
+	identity := "simulate" + pkgaccess.ServiceAccountNameSuffix
+
+	policy := &specs.AccessPolicySpec{
+		Rules: []*specs.AccessPolicyRule{
+			{
+				Users:    []string{identity},
+				Clusters: []string{"prod"},
+				Role:     string(role.Operator),
+			},
+		},
+	}
+
+	resp, err := client.SimulateAccessPolicy(suite.ctx, &management.SimulateAccessPolicyRequest{
+		Identity:       identity,
+		ClusterName:    "prod",
+		PolicyOverride: policy,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(role.Operator), resp.Role)
+
+	resp, err = client.SimulateAccessPolicy(suite.ctx, &management.SimulateAccessPolicyRequest{
+		Identity:       identity,
+		ClusterName:    "staging",
+		PolicyOverride: policy,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(role.Reader), resp.Role)
+
+	// the candidate policy is not stored
+	resp, err = client.SimulateAccessPolicy(suite.ctx, &management.SimulateAccessPolicyRequest{
+		Identity:    identity,
+		ClusterName: "prod",
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(role.Reader), resp.Role)
+
+	_, err = suite.state.Get(suite.ctx, authres.NewAccessPolicy().Metadata())
+	suite.Assert().True(state.IsNotFoundError(err))
+
+	_, err = client.SimulateAccessPolicy(suite.ctx, &management.SimulateAccessPolicyRequest{
+		Identity:    "missing",
+		ClusterName: "prod",
+	})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestGetKubeletStatus() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetKubeletStatus(suite.ctx, &management.GetKubeletStatusRequest{
+		MachineId: "kubelet-missing",
+	})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "kubelet-maintenance")
+	machineStatus.TypedSpec().Value.Maintenance = true
+	machineStatus.TypedSpec().Value.Connected = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	_, err = client.GetKubeletStatus(suite.ctx, &management.GetKubeletStatusRequest{
+		MachineId: "kubelet-maintenance",
+	})
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	machineStatus = omni.NewMachineStatus(resources.DefaultNamespace, "kubelet-disconnected")
+	machineStatus.TypedSpec().Value.Cluster = "kubelet-cluster"
+	machineStatus.TypedSpec().Value.ManagementAddress = "127.0.0.1:50000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	_, err = client.GetKubeletStatus(suite.ctx, &management.GetKubeletStatusRequest{
+		MachineId: "kubelet-disconnected",
+	})
+	suite.Assert().Equal(codes.Unavailable, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestGetMachineStatusAt() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	now := time.Now()
+
+	request := func(machineID string, timestamp time.Time) (*management.GetMachineStatusAtResponse, error) {
+		return client.GetMachineStatusAt(suite.ctx, &management.GetMachineStatusAtRequest{
+			MachineId: machineID,
+			Timestamp: timestamppb.New(timestamp),
+		})
+	}
+
+	_, err := request("history", now)
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	config.Config.MachineStatusHistory.Depth = 10
+
+	suite.T().Cleanup(func() {
+		config.Config.MachineStatusHistory.Depth = 0
+	})
+
+	_, err = request("history", now)
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatus(resources.DefaultNamespace, "history")))
+
+	history := omni.NewMachineStatusHistory(resources.DefaultNamespace, "history")
+	history.TypedSpec().Value.Snapshots = []*specs.MachineStatusHistorySpec_Snapshot{
+		{
+			TakenAt: timestamppb.New(now.Add(-time.Hour)),
+			Status:  &specs.MachineStatusSpec{TalosVersion: "v1.6.0"},
+		},
+		{
+			TakenAt: timestamppb.New(now.Add(-time.Minute)),
+			Status:  &specs.MachineStatusSpec{TalosVersion: "v1.6.1"},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, history))
+
+	resp, err := request("history", now.Add(-30*time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("v1.6.0", resp.Status.TalosVersion)
+	suite.Assert().True(resp.TakenAt.AsTime().Equal(now.Add(-time.Hour)))
+
+	resp, err = request("history", now.Add(-time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("v1.6.1", resp.Status.TalosVersion)
+
+	_, err = request("history", now.Add(-2*time.Hour))
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestGetMachineStatusTimeSeries() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	now := time.Now().Truncate(time.Minute)
+
+	request := func(metric management.GetMachineStatusTimeSeriesRequest_Metric, from time.Time, resolution time.Duration) (*management.GetMachineStatusTimeSeriesResponse, error) {
+		return client.GetMachineStatusTimeSeries(suite.ctx, &management.GetMachineStatusTimeSeriesRequest{
+			MachineId:  "time-series",
+			Metric:     metric,
+			From:       timestamppb.New(from),
+			To:         timestamppb.New(now),
+			Resolution: durationpb.New(resolution),
+		})
+	}
+
+	_, err := request(management.GetMachineStatusTimeSeriesRequest_CONNECTED, now.Add(-time.Hour), 10*time.Minute)
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	config.Config.MachineStatusHistory.Depth = 10
+
+	suite.T().Cleanup(func() {
+		config.Config.MachineStatusHistory.Depth = 0
+	})
+
+	for _, tc := range []struct {
+		metric     management.GetMachineStatusTimeSeriesRequest_Metric
+		from       time.Time
+		resolution time.Duration
+	}{
+		{metric: management.GetMachineStatusTimeSeriesRequest_UNKNOWN, from: now.Add(-time.Hour), resolution: time.Minute},
+		{metric: management.GetMachineStatusTimeSeriesRequest_CONNECTED, from: now, resolution: time.Minute},
+		{metric: management.GetMachineStatusTimeSeriesRequest_CONNECTED, from: now.Add(-time.Hour), resolution: time.Millisecond},
+		{metric: management.GetMachineStatusTimeSeriesRequest_CONNECTED, from: now.Add(-24 * time.Hour), resolution: time.Second},
+		{metric: management.GetMachineStatusTimeSeriesRequest_CONNECTED, from: now.Add(-60 * 24 * time.Hour), resolution: 24 * time.Hour},
+	} {
+		_, err = request(tc.metric, tc.from, tc.resolution)
+		suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err = request(management.GetMachineStatusTimeSeriesRequest_CONNECTED, now.Add(-time.Hour), 10*time.Minute)
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatus(resources.DefaultNamespace, "time-series")))
+
+	history := omni.NewMachineStatusHistory(resources.DefaultNamespace, "time-series")
+	history.TypedSpec().Value.Snapshots = []*specs.MachineStatusHistorySpec_Snapshot{
+		{
+			TakenAt: timestamppb.New(now.Add(-45 * time.Minute)),
+			Status:  &specs.MachineStatusSpec{Connected: true},
+		},
+		{
+			TakenAt: timestamppb.New(now.Add(-25 * time.Minute)),
+			Status: &specs.MachineStatusSpec{
+				KubernetesNodeStatus: &specs.MachineStatusSpec_KubernetesNodeStatus{Ready: true},
+			},
+		},
+		{
+			TakenAt: timestamppb.New(now.Add(-5 * time.Minute)),
+			Status:  &specs.MachineStatusSpec{Connected: true},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, history))
+
+	values := func(resp *management.GetMachineStatusTimeSeriesResponse) []float64 {
+		return xslices.Map(resp.Samples, func(sample *management.GetMachineStatusTimeSeriesResponse_Sample) float64 {
+			return sample.Value
+		})
+	}
+
+	// there are no samples before the first snapshot
+	resp, err := request(management.GetMachineStatusTimeSeriesRequest_CONNECTED, now.Add(-time.Hour), 10*time.Minute)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]float64{1, 1, 0, 0, 1}, values(resp))
+	suite.Assert().True(resp.Samples[0].Timestamp.AsTime().Equal(now.Add(-40 * time.Minute)))
+	suite.Assert().True(resp.Samples[4].Timestamp.AsTime().Equal(now))
+
+	// the value is unknown if the node status is not set
+	resp, err = request(management.GetMachineStatusTimeSeriesRequest_KUBERNETES_NODE_READY, now.Add(-time.Hour), 10*time.Minute)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]float64{1, 1}, values(resp))
+}
+
+func (suite *GrpcSuite) TestGetClockDrift() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetClockDrift(suite.ctx, &management.GetClockDriftRequest{MachineId: "clock"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "clock")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	_, err = client.GetClockDrift(suite.ctx, &management.GetClockDriftRequest{MachineId: "clock"})
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.ClockStatus = &specs.MachineStatusSpec_ClockStatus{
+			Drift: durationpb.New(-3 * time.Second),
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err := client.GetClockDrift(suite.ctx, &management.GetClockDriftRequest{MachineId: "clock"})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(-3*time.Second, resp.Drift.AsDuration())
+	suite.Assert().False(resp.ThresholdExceeded)
+
+	resp, err = client.GetClockDrift(suite.ctx, &management.GetClockDriftRequest{MachineId: "clock", Threshold: durationpb.New(time.Second)})
+	suite.Require().NoError(err)
+	suite.Assert().True(resp.ThresholdExceeded)
+}
+
+func (suite *GrpcSuite) TestGetMachineEvents() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetMachineEvents(suite.ctx, &management.GetMachineEventsRequest{MachineId: "events"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatus(resources.DefaultNamespace, "events")))
+
+	_, err = client.GetMachineEvents(suite.ctx, &management.GetMachineEventsRequest{MachineId: "events", Limit: -1})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	// no events received yet
+	resp, err := client.GetMachineEvents(suite.ctx, &management.GetMachineEventsRequest{MachineId: "events"})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.Events)
+
+	now := time.Now()
+
+	snapshot := omni.NewMachineStatusSnapshot(resources.DefaultNamespace, "events")
+	snapshot.TypedSpec().Value.RecentEvents = []*specs.MachineStatusSnapshotSpec_Event{
+		{
+			ReceivedAt:    timestamppb.New(now.Add(-2 * time.Minute)),
+			MachineStatus: &machine.MachineStatusEvent{Stage: machine.MachineStatusEvent_REBOOTING},
+		},
+		{
+			ReceivedAt: timestamppb.New(now.Add(-time.Minute)),
+			MachineStatus: &machine.MachineStatusEvent{
+				Stage: machine.MachineStatusEvent_BOOTING,
+				Status: &machine.MachineStatusEvent_MachineStatus{
+					UnmetConditions: []*machine.MachineStatusEvent_MachineStatus_UnmetCondition{
+						{Name: "nodeReady", Reason: "node not ready"},
+					},
+				},
+			},
+		},
+		{
+			ReceivedAt: timestamppb.New(now),
+			MachineStatus: &machine.MachineStatusEvent{
+				Stage:  machine.MachineStatusEvent_RUNNING,
+				Status: &machine.MachineStatusEvent_MachineStatus{Ready: true},
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, snapshot))
+
+	resp, err = client.GetMachineEvents(suite.ctx, &management.GetMachineEventsRequest{MachineId: "events", Limit: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Events, 2)
+
+	suite.Assert().Equal("RUNNING", resp.Events[0].Stage)
+	suite.Assert().True(resp.Events[0].Ready)
+	suite.Assert().Equal(now.Unix(), resp.Events[0].ReceivedAt.AsTime().Unix())
+	suite.Assert().Equal("BOOTING", resp.Events[1].Stage)
+	suite.Assert().False(resp.Events[1].Ready)
+	suite.Assert().Equal([]string{"nodeReady: node not ready"}, resp.Events[1].UnmetConditions)
+
+	resp, err = client.GetMachineEvents(suite.ctx, &management.GetMachineEventsRequest{MachineId: "events"})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Events, 3)
+	suite.Assert().Equal("REBOOTING", resp.Events[2].Stage)
+}
+
+func (suite *GrpcSuite) TestGetMachineStatusJSON() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetMachineStatusJSON(suite.ctx, &management.GetMachineStatusJSONRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetMachineStatusJSON(suite.ctx, &management.GetMachineStatusJSONRequest{MachineId: "flat"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "flat")
+	machineStatus.Metadata().Labels().Set("rack", "a1")
+	machineStatus.TypedSpec().Value.Cluster = "flat"
+	machineStatus.TypedSpec().Value.Role = specs.MachineStatusSpec_CONTROL_PLANE
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Arch: "amd64",
+		Processors: []*specs.MachineStatusSpec_HardwareStatus_Processor{
+			{CoreCount: 4, ThreadCount: 8, Description: "cpu"},
+			{CoreCount: 4, ThreadCount: 8, Description: "cpu"},
+		},
+		MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{{SizeMb: 1024}, {SizeMb: 2048}},
+		Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			{LinuxName: "/dev/sda", Size: 100, SystemDisk: true},
+			{LinuxName: "/dev/sdb", Size: 200},
+		},
+	}
+	machineStatus.TypedSpec().Value.Network = &specs.MachineStatusSpec_NetworkStatus{
+		Hostname:     "flat-1",
+		Addresses:    []string{"10.5.0.2/24"},
+		NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{{HardwareAddress: "aa:bb:cc:dd:ee:ff"}},
+	}
+	machineStatus.TypedSpec().Value.PlatformMetadata = &specs.MachineStatusSpec_PlatformMetadata{Platform: "metal"}
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{Id: "abc"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	resp, err := client.GetMachineStatusJSON(suite.ctx, &management.GetMachineStatusJSONRequest{MachineId: "flat"})
+	suite.Require().NoError(err)
+
+	var doc map[string]any
+
+	suite.Require().NoError(json.Unmarshal([]byte(resp.Body), &doc))
+
+	suite.Assert().Equal("flat", doc["machine_id"])
+	suite.Assert().Equal("control_plane", doc["role"])
+	suite.Assert().Equal(map[string]any{"rack": "a1"}, doc["labels"])
+	suite.Assert().Equal("cpu", doc["cpu_model"])
+	suite.Assert().EqualValues(8, doc["cpu_cores"])
+	suite.Assert().EqualValues(16, doc["cpu_threads"])
+	suite.Assert().EqualValues(3072, doc["memory_mb"])
+	suite.Assert().EqualValues(2, doc["disk_count"])
+	suite.Assert().EqualValues(300, doc["disk_total_bytes"])
+	suite.Assert().Equal("/dev/sda", doc["system_disk"])
+	suite.Assert().Equal("flat-1", doc["hostname"])
+	suite.Assert().Equal([]any{"aa:bb:cc:dd:ee:ff"}, doc["mac_addresses"])
+	suite.Assert().Equal("metal", doc["platform"])
+	suite.Assert().Equal("abc", doc["schematic_id"])
+
+	// the fields which are not reported are still present
+	suite.Assert().Equal([]any{}, doc["default_gateways"])
+	suite.Assert().Equal("", doc["region"])
+}
+
+func (suite *GrpcSuite) TestListKubernetesUpgrades() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.ListKubernetesUpgrades(suite.ctx, &management.ListKubernetesUpgradesRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ListKubernetesUpgrades(suite.ctx, &management.ListKubernetesUpgradesRequest{ClusterName: "upgrades"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "upgrades")))
+
+	resp, err := client.ListKubernetesUpgrades(suite.ctx, &management.ListKubernetesUpgradesRequest{ClusterName: "upgrades"})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.Upgrades)
+
+	history := omni.NewKubernetesUpgradeHistory(resources.DefaultNamespace, "upgrades")
+	history.TypedSpec().Value.Upgrades = []*specs.KubernetesUpgradeHistorySpec_Upgrade{
+		{
+			FromVersion: "1.29.0",
+			ToVersion:   "1.29.1",
+			StartedAt:   timestamppb.New(time.Now().Add(-time.Hour)),
+			FinishedAt:  timestamppb.New(time.Now().Add(-time.Minute)),
+			Outcome:     specs.KubernetesUpgradeHistorySpec_Upgrade_DONE,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, history))
+
+	resp, err = client.ListKubernetesUpgrades(suite.ctx, &management.ListKubernetesUpgradesRequest{ClusterName: "upgrades"})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Upgrades, 1)
+	suite.Assert().Equal("1.29.1", resp.Upgrades[0].ToVersion)
+	suite.Assert().Equal(specs.KubernetesUpgradeHistorySpec_Upgrade_DONE, resp.Upgrades[0].Outcome)
+}
+
+func (suite *GrpcSuite) TestValidateClusterComposition() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	validate := func(controlPlaneCount uint32, machineIDs ...string) (*management.ValidateClusterCompositionResponse, error) {
+		return client.ValidateClusterComposition(suite.ctx, &management.ValidateClusterCompositionRequest{
+			MachineIds:        machineIDs,
+			ControlPlaneCount: controlPlaneCount,
+		})
+	}
+
+	_, err := validate(1)
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = validate(0, "composition-1")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = validate(1, "composition-1", "composition-1")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	hardware := func(arch string, cores uint32, memoryMB uint32) *specs.MachineStatusSpec_HardwareStatus {
+		return &specs.MachineStatusSpec_HardwareStatus{
+			Arch:          arch,
+			Processors:    []*specs.MachineStatusSpec_HardwareStatus_Processor{{CoreCount: cores}},
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{{SizeMb: memoryMB}},
+			Blockdevices:  []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{{Size: 100 * 1000 * 1000 * 1000}},
+		}
+	}
+
+	for id, spec := range map[string]*specs.MachineStatusSpec{
+		"composition-1":            {Connected: true, Hardware: hardware("amd64", 4, 4096)},
+		"composition-2":            {Connected: true, Hardware: hardware("amd64", 1, 1024)},
+		"composition-arm":          {Connected: true, Hardware: hardware("arm64", 4, 4096)},
+		"composition-small":        {Connected: true, Hardware: hardware("amd64", 1, 512)},
+		"composition-disconnected": {Hardware: hardware("amd64", 4, 4096)},
+		"composition-allocated":    {Connected: true, Cluster: "other", Hardware: hardware("amd64", 4, 4096)},
+		"composition-no-hardware":  {Connected: true},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value = spec
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	resp, err := validate(1, "composition-1", "composition-2")
+	suite.Require().NoError(err)
+	suite.Assert().True(resp.Valid)
+	suite.Assert().Empty(resp.Reasons)
+
+	for _, test := range []struct {
+		reason            string
+		machineIDs        []string
+		controlPlaneCount uint32
+	}{
+		{
+			reason:            `machine "composition-missing" not found`,
+			machineIDs:        []string{"composition-1", "composition-missing"},
+			controlPlaneCount: 1,
+		},
+		{
+			reason:            `machine "composition-disconnected" is not connected`,
+			machineIDs:        []string{"composition-disconnected"},
+			controlPlaneCount: 1,
+		},
+		{
+			reason:            `machine "composition-allocated" is already used in the cluster "other"`,
+			machineIDs:        []string{"composition-allocated"},
+			controlPlaneCount: 1,
+		},
+		{
+			reason:            `machine "composition-no-hardware" didn't report the hardware information yet`,
+			machineIDs:        []string{"composition-1", "composition-no-hardware"},
+			controlPlaneCount: 1,
+		},
+		{
+			reason:            `machine "composition-small" doesn't meet the minimum requirements, it needs 1024 MiB of memory`,
+			machineIDs:        []string{"composition-1", "composition-small"},
+			controlPlaneCount: 1,
+		},
+		{
+			reason:            "3 control plane nodes are requested, but only 2 machines are selected",
+			machineIDs:        []string{"composition-1", "composition-2"},
+			controlPlaneCount: 3,
+		},
+		{
+			reason:            "2 control plane nodes are requested, but only 1 machines meet the control plane requirements",
+			machineIDs:        []string{"composition-1", "composition-2"},
+			controlPlaneCount: 2,
+		},
+		{
+			reason:            "machines have different architectures: amd64 (composition-1), arm64 (composition-arm)",
+			machineIDs:        []string{"composition-1", "composition-arm"},
+			controlPlaneCount: 1,
+		},
+	} {
+		resp, err = validate(test.controlPlaneCount, test.machineIDs...)
+		suite.Require().NoError(err)
+		suite.Assert().False(resp.Valid)
+		suite.Assert().Equal([]string{test.reason}, resp.Reasons)
+	}
+}
+
+func (suite *GrpcSuite) TestStreamClusterUtilization() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	recvErr := func(req *management.StreamClusterUtilizationRequest) error {
+		stream, err := client.StreamClusterUtilization(suite.ctx, req)
+		suite.Require().NoError(err)
+
+		_, err = stream.Recv()
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(recvErr(&management.StreamClusterUtilizationRequest{})))
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(recvErr(&management.StreamClusterUtilizationRequest{
+		ClusterName: "utilization",
+		Interval:    durationpb.New(time.Millisecond),
+	})))
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(recvErr(&management.StreamClusterUtilizationRequest{
+		ClusterName: "utilization",
+		Duration:    durationpb.New(time.Hour),
+	})))
+	suite.Assert().Equal(codes.NotFound, status.Code(recvErr(&management.StreamClusterUtilizationRequest{
+		ClusterName: "utilization",
+	})))
+}
+
+func (suite *GrpcSuite) TestWatchUpgradeProgress() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	recvErr := func(req *management.WatchUpgradeProgressRequest) error {
+		stream, err := client.WatchUpgradeProgress(suite.ctx, req)
+		suite.Require().NoError(err)
+
+		_, err = stream.Recv()
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(recvErr(&management.WatchUpgradeProgressRequest{})))
+	suite.Assert().Equal(codes.NotFound, status.Code(recvErr(&management.WatchUpgradeProgressRequest{
+		ClusterName: "upgrade-progress",
+	})))
+}
+
+func (suite *GrpcSuite) TestValidateClusterConfigs() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	validate := func(req *management.ValidateClusterConfigsRequest) ([]*management.ValidateClusterConfigsResponse, error) {
+		stream, err := client.ValidateClusterConfigs(suite.ctx, req)
+		suite.Require().NoError(err)
+
+		var results []*management.ValidateClusterConfigsResponse
+
+		for {
+			result, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return results, nil
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	_, err := validate(&management.ValidateClusterConfigsRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = validate(&management.ValidateClusterConfigsRequest{ClusterName: "config-validation"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "config-validation")))
+
+	for id, patches := range map[string][]string{
+		"config-validation-ok": {"machine:\n  network:\n    hostname: ok\n"},
+		"config-validation-invalid": {
+			"machine:\n  network:\n    hostname: invalid\n",
+			"machine:\n  token: aaa\n  ca:\n    crt: YWFhCg==\n",
+		},
+	} {
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, id)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "config-validation")
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+		configPatches := omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, id)
+		configPatches.Metadata().Labels().Set(omni.LabelCluster, "config-validation")
+		configPatches.TypedSpec().Value.Patches = patches
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configPatches))
+	}
+
+	machineConfig := omni.NewClusterMachineConfig(resources.DefaultNamespace, "config-validation-ok")
+	machineConfig.Metadata().Labels().Set(omni.LabelCluster, "config-validation")
+	machineConfig.TypedSpec().Value.GenerationError = "failed to merge"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineConfig))
+
+	results, err := validate(&management.ValidateClusterConfigsRequest{ClusterName: "config-validation"})
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+
+	suite.Assert().Equal("config-validation-invalid", results[0].MachineId)
+	suite.Assert().Equal([]string{
+		`config patch 1: overriding "machine.token" is not allowed in the config patch`,
+		`config patch 1: overriding "machine.ca" is not allowed in the config patch`,
+	}, results[0].Errors)
+
+	suite.Assert().Equal("config-validation-ok", results[1].MachineId)
+	suite.Assert().Equal([]string{"config generation failed: failed to merge"}, results[1].Errors)
+}
+
+func (suite *GrpcSuite) TestWatchKubernetesEvents() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	watch := func(req *management.WatchKubernetesEventsRequest) error {
+		stream, err := client.WatchKubernetesEvents(suite.ctx, req)
+		suite.Require().NoError(err)
+
+		_, err = stream.Recv()
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(watch(&management.WatchKubernetesEventsRequest{})))
+	suite.Assert().Equal(codes.NotFound, status.Code(watch(&management.WatchKubernetesEventsRequest{ClusterName: "events-missing"})))
+}
+
+func (suite *GrpcSuite) TestValidateClusterMembership() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.ValidateClusterMembership(suite.ctx, &management.ValidateClusterMembershipRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ValidateClusterMembership(suite.ctx, &management.ValidateClusterMembershipRequest{ClusterName: "membership"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "membership")))
+
+	// consistent machine
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "membership-ok")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "membership")
+	clusterMachine.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "membership-ok")
+	machineStatus.TypedSpec().Value.Cluster = "membership"
+	machineStatus.Metadata().Labels().Set(omni.LabelCluster, "membership")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, "membership-ok")
+	identity.Metadata().Labels().Set(omni.LabelCluster, "membership")
+	identity.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	// machine status with the stale cluster labels
+	machineStatus = omni.NewMachineStatus(resources.DefaultNamespace, "membership-stale-status")
+	machineStatus.Metadata().Labels().Set(omni.LabelCluster, "membership")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	// stale identity
+	identity = omni.NewClusterMachineIdentity(resources.DefaultNamespace, "membership-stale-identity")
+	identity.Metadata().Labels().Set(omni.LabelCluster, "membership")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	resp, err := client.ValidateClusterMembership(suite.ctx, &management.ValidateClusterMembershipRequest{ClusterName: "membership"})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"membership-stale-identity", "membership-stale-status"},
+		xslices.Map(resp.Issues, func(issue *management.ValidateClusterMembershipResponse_Issue) string { return issue.MachineId }))
+
+	// the cluster is not reachable in the tests
+	suite.Assert().Len(resp.SkippedChecks, 2)
+}
+
+func (suite *GrpcSuite) TestGetClusterTopology() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetClusterTopology(suite.ctx, &management.GetClusterTopologyRequest{})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetClusterTopology(suite.ctx, &management.GetClusterTopologyRequest{ClusterName: "topology"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "topology")))
+
+	for _, machineSet := range []struct {
+		id, roleLabel string
+	}{
+		{id: omni.ControlPlanesResourceID("topology"), roleLabel: omni.LabelControlPlaneRole},
+		{id: omni.WorkersResourceID("topology"), roleLabel: omni.LabelWorkerRole},
+	} {
+		res := omni.NewMachineSet(resources.DefaultNamespace, machineSet.id)
+		res.Metadata().Labels().Set(omni.LabelCluster, "topology")
+		res.Metadata().Labels().Set(machineSet.roleLabel, "")
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, res))
+	}
+
+	for _, machine := range []struct {
+		id, machineSet, roleLabel string
+	}{
+		{id: "topology-cp", machineSet: omni.ControlPlanesResourceID("topology"), roleLabel: omni.LabelControlPlaneRole},
+		{id: "topology-w", machineSet: omni.WorkersResourceID("topology"), roleLabel: omni.LabelWorkerRole},
+	} {
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machine.id)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "topology")
+		clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machine.machineSet)
+		clusterMachine.Metadata().Labels().Set(machine.roleLabel, "")
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+	}
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, "topology-cp")
+	identity.Metadata().Labels().Set(omni.LabelCluster, "topology")
+	identity.TypedSpec().Value.Nodename = "cp-1"
+	identity.TypedSpec().Value.NodeIps = []string{"10.5.0.2"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "topology-cp")
+	machineStatus.TypedSpec().Value.Connected = true
+	machineStatus.TypedSpec().Value.TalosVersion = "v1.7.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	resp, err := client.GetClusterTopology(suite.ctx, &management.GetClusterTopologyRequest{ClusterName: "topology"})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.Nodes, 5)
+
+	suite.Assert().Equal("cluster/topology", resp.Nodes[0].Id)
+	suite.Assert().Equal(management.GetClusterTopologyResponse_Node_MACHINE_SET, resp.Nodes[1].Kind)
+	suite.Assert().Equal("controlplane", resp.Nodes[1].Role)
+
+	controlPlane := resp.Nodes[3]
+	suite.Assert().Equal("machine/topology-cp", controlPlane.Id)
+	suite.Assert().Equal("cp-1", controlPlane.Name)
+	suite.Assert().Equal([]string{"10.5.0.2"}, controlPlane.Addresses)
+	suite.Assert().True(controlPlane.Connected)
+	suite.Assert().Equal("v1.7.0", controlPlane.TalosVersion)
+
+	worker := resp.Nodes[4]
+	suite.Assert().Equal("topology-w", worker.Name)
+	suite.Assert().Equal("worker", worker.Role)
+	suite.Assert().False(worker.Connected)
+
+	suite.Assert().Equal([]string{
+		"MEMBER_OF machine/topology-cp machineset/topology-control-planes",
+		"MEMBER_OF machine/topology-w machineset/topology-workers",
+		"MEMBER_OF machineset/topology-control-planes cluster/topology",
+		"MEMBER_OF machineset/topology-workers cluster/topology",
+		"CONNECTS_TO machine/topology-w machine/topology-cp",
+	}, xslices.Map(resp.Edges, func(edge *management.GetClusterTopologyResponse_Edge) string {
+		return edge.Kind.String() + " " + edge.From + " " + edge.To
+	}))
+}
+
+func (suite *GrpcSuite) TestSetSchematicName() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicID := strings.Repeat("ab", 32)
+
+	_, err := client.SetSchematicName(suite.ctx, &management.SetSchematicNameRequest{SchematicId: "not-a-schematic", Name: "base"})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SetSchematicName(suite.ctx, &management.SetSchematicNameRequest{SchematicId: schematicID, Name: "base"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	schematic := omni.NewSchematic(resources.DefaultNamespace, schematicID)
+	schematic.TypedSpec().Value.Extensions = []string{"siderolabs/iscsi-tools"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, schematic))
+
+	_, err = client.SetSchematicName(suite.ctx, &management.SetSchematicNameRequest{SchematicId: schematicID, Name: "base", Description: "iSCSI workers"})
+	suite.Require().NoError(err)
+
+	res, err := safe.StateGet[*omni.Schematic](suite.ctx, suite.state, schematic.Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().Equal("base", res.TypedSpec().Value.Name)
+	suite.Assert().Equal("iSCSI workers", res.TypedSpec().Value.Description)
+	suite.Assert().Equal([]string{"siderolabs/iscsi-tools"}, res.TypedSpec().Value.Extensions)
+
+	_, err = client.SetSchematicName(suite.ctx, &management.SetSchematicNameRequest{SchematicId: schematicID})
+	suite.Require().NoError(err)
+
+	res, err = safe.StateGet[*omni.Schematic](suite.ctx, suite.state, schematic.Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().Empty(res.TypedSpec().Value.Name)
+	suite.Assert().Empty(res.TypedSpec().Value.Description)
+}
+
+func (suite *GrpcSuite) TestValidateOmniEndpoint() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for _, url := range []string{"", "omni.example.org", "ftp://omni.example.org", "https://"} {
+		_, err := client.ValidateOmniEndpoint(suite.ctx, &management.ValidateOmniEndpointRequest{Url: url})
+		suite.Assert().Equal(codes.InvalidArgument, status.Code(err), url)
+	}
+
+	resp, err := client.ValidateOmniEndpoint(suite.ctx, &management.ValidateOmniEndpointRequest{Url: "https://omni.example.org"})
+	suite.Require().NoError(err)
+	suite.Assert().Equal("https://omni.example.org", resp.Destination)
+	suite.Assert().False(resp.Reachable)
+	suite.Assert().Empty(resp.Error)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+
+	resp, err = client.ValidateOmniEndpoint(suite.ctx, &management.ValidateOmniEndpointRequest{Url: server.URL, CheckConnectivity: true})
+	suite.Require().NoError(err)
+	suite.Assert().True(resp.Reachable)
+	suite.Assert().Empty(resp.Error)
+
+	server.Close()
+
+	resp, err = client.ValidateOmniEndpoint(suite.ctx, &management.ValidateOmniEndpointRequest{Url: server.URL, CheckConnectivity: true})
+	suite.Require().NoError(err)
+	suite.Assert().False(resp.Reachable)
+	suite.Assert().NotEmpty(resp.Error)
+}
+
+func (suite *GrpcSuite) TestDiffSchematics() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicA, schematicB := strings.Repeat("0a", 32), strings.Repeat("0b", 32)
+
+	_, err := client.DiffSchematics(suite.ctx, &management.DiffSchematicsRequest{SchematicIdA: schematicA})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.DiffSchematics(suite.ctx, &management.DiffSchematicsRequest{SchematicIdA: schematicA, SchematicIdB: schematicB})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	for id, spec := range map[string]*specs.SchematicSpec{
+		schematicA: {
+			Extensions:      []string{"siderolabs/iscsi-tools", "siderolabs/gvisor"},
+			ExtraKernelArgs: []string{"siderolink.api=grpc://omni", "console=ttyS0"},
+		},
+		schematicB: {
+			Extensions:      []string{"siderolabs/iscsi-tools", "siderolabs/nvidia-container-toolkit"},
+			ExtraKernelArgs: []string{"siderolink.api=grpc://omni", "net.ifnames=0"},
+		},
+	} {
+		schematic := omni.NewSchematic(resources.DefaultNamespace, id)
+		schematic.TypedSpec().Value = spec
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, schematic))
+	}
+
+	resp, err := client.DiffSchematics(suite.ctx, &management.DiffSchematicsRequest{SchematicIdA: schematicA, SchematicIdB: schematicB})
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"siderolabs/nvidia-container-toolkit"}, resp.AddedExtensions)
+	suite.Assert().Equal([]string{"siderolabs/gvisor"}, resp.RemovedExtensions)
+	suite.Assert().Equal([]string{"net.ifnames=0"}, resp.AddedKernelArgs)
+	suite.Assert().Equal([]string{"console=ttyS0"}, resp.RemovedKernelArgs)
+
+	resp, err = client.DiffSchematics(suite.ctx, &management.DiffSchematicsRequest{SchematicIdA: schematicA, SchematicIdB: schematicA})
+	suite.Require().NoError(err)
+	suite.Assert().Empty(resp.AddedExtensions)
+	suite.Assert().Empty(resp.RemovedExtensions)
+	suite.Assert().Empty(resp.AddedKernelArgs)
+	suite.Assert().Empty(resp.RemovedKernelArgs)
+}
+
+func (suite *GrpcSuite) TestGetClusterCapacity() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetClusterCapacity(suite.ctx, &management.GetClusterCapacityRequest{ClusterName: "capacity"})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "capacity")))
+
+	for id, hardware := range map[string]*specs.MachineStatusSpec_HardwareStatus{
+		"capacity-1": {
+			Processors: []*specs.MachineStatusSpec_HardwareStatus_Processor{
+				{CoreCount: 4, ThreadCount: 8},
+				{CoreCount: 4, ThreadCount: 8},
+			},
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{
+				{SizeMb: 8192},
+				{SizeMb: 8192},
+			},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+				{Size: 1000},
+				{Size: 2000},
+			},
+		},
+		"capacity-2": {
+			Processors:    []*specs.MachineStatusSpec_HardwareStatus_Processor{{CoreCount: 2, ThreadCount: 2}},
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{{SizeMb: 4096}},
+			Blockdevices:  []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{{Size: 500}},
+		},
+		"capacity-3": nil,
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.Metadata().Labels().Set(omni.LabelCluster, "capacity")
+		machineStatus.TypedSpec().Value.Hardware = hardware
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	resp, err := client.GetClusterCapacity(suite.ctx, &management.GetClusterCapacityRequest{ClusterName: "capacity"})
+	suite.Require().NoError(err)
+
+	suite.Assert().True(proto.Equal(&management.GetClusterCapacityResponse_Capacity{
+		CpuCores:   10,
+		CpuThreads: 18,
+		MemoryMb:   20480,
+		DiskBytes:  3500,
+	}, resp.Total), resp.Total.String())
+
+	suite.Require().Len(resp.Nodes, 3)
+	suite.Assert().Equal("capacity-1", resp.Nodes[0].MachineId)
+	suite.Assert().EqualValues(16384, resp.Nodes[0].Capacity.MemoryMb)
+	suite.Assert().Equal("capacity-3", resp.Nodes[2].MachineId)
+	suite.Assert().True(resp.Nodes[2].HardwareUnknown)
+}
+
+func (suite *GrpcSuite) TestValidateUpgradeImageReachability() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	validate := func(clusterName, version string) (*management.ValidateUpgradeImageReachabilityResponse, error) {
+		return client.ValidateUpgradeImageReachability(suite.ctx, &management.ValidateUpgradeImageReachabilityRequest{
+			ClusterName: clusterName,
+			Version:     version,
+		})
+	}
+
+	_, err := validate("", "1.7.0")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = validate("reachability", "latest")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = validate("reachability", "1.7.0")
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, "reachability")))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "reachability-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "reachability")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "reachability-1")
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{Id: "machine-schematic"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	talosVersion := omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, "reachability-1")
+	talosVersion.TypedSpec().Value.SchematicId = "configured-schematic"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, talosVersion))
+
+	resp, err := validate("reachability", "v1.7.0")
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Nodes, 1)
+
+	node := resp.Nodes[0]
+
+	suite.Assert().Equal("reachability-1", node.MachineId)
+	suite.Assert().True(strings.HasSuffix(node.Image, "/installer/configured-schematic:v1.7.0"), node.Image)
+	suite.Assert().False(node.Reachable)
+	suite.Assert().Equal("machine is not connected", node.Error)
+}
+
+func (suite *GrpcSuite) TestExportImportServiceAccounts() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	key, err := pgp.GenerateKey("sa-export", "test", "sa-export"+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+	suite.Require().NoError(err)
+
+	armored, err := key.ArmorPublic()
+	suite.Require().NoError(err)
+
+	serviceAccount := &management.ServiceAccountExport{
+		Name:                 "sa-export",
+		Role:                 string(role.Operator),
+		Labels:               map[string]string{"team": "infra"},
+		ArmoredPgpPublicKeys: []string{armored},
+	}
+
+	importResp, err := client.ImportServiceAccounts(suite.ctx, &management.ImportServiceAccountsRequest{
+		ServiceAccounts: []*management.ServiceAccountExport{serviceAccount},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"sa-export"}, importResp.Imported)
+	suite.Assert().Empty(importResp.Skipped)
+
+	exportResp, err := client.ExportServiceAccounts(suite.ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	i := slices.IndexFunc(exportResp.ServiceAccounts, func(sa *management.ServiceAccountExport) bool { return sa.Name == "sa-export" })
+	suite.Require().NotEqual(-1, i)
+
+	exported := exportResp.ServiceAccounts[i]
+
+	suite.Assert().Equal(string(role.Operator), exported.Role)
+	suite.Assert().Equal(map[string]string{"team": "infra"}, exported.Labels)
+	suite.Assert().Equal([]string{armored}, exported.ArmoredPgpPublicKeys)
+
+	// the existing service accounts are skipped
+	importResp, err = client.ImportServiceAccounts(suite.ctx, &management.ImportServiceAccountsRequest{
+		ServiceAccounts: []*management.ServiceAccountExport{exported},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Empty(importResp.Imported)
+	suite.Assert().Equal([]string{"sa-export"}, importResp.Skipped)
+
+	_, err = client.DestroyServiceAccount(suite.ctx, &management.DestroyServiceAccountRequest{Name: "sa-export"})
+	suite.Require().NoError(err)
+
+	importResp, err = client.ImportServiceAccounts(suite.ctx, &management.ImportServiceAccountsRequest{
+		ServiceAccounts: []*management.ServiceAccountExport{exported},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"sa-export"}, importResp.Imported)
+
+	identity, err := safe.StateGet[*authres.Identity](suite.ctx, suite.state, authres.NewIdentity(resources.DefaultNamespace, "sa-export"+pkgaccess.ServiceAccountNameSuffix).Metadata())
+	suite.Require().NoError(err)
+
+	team, _ := identity.Metadata().Labels().Get("team")
+	suite.Assert().Equal("infra", team)
+
+	publicKey, err := safe.StateGet[*authres.PublicKey](suite.ctx, suite.state, authres.NewPublicKey(resources.DefaultNamespace, key.Fingerprint()).Metadata())
+	suite.Require().NoError(err)
+
+	userID, _ := publicKey.Metadata().Labels().Get(authres.LabelPublicKeyUserID)
+	suite.Assert().Equal(identity.TypedSpec().Value.UserId, userID)
+	suite.Assert().Equal(string(role.Operator), publicKey.TypedSpec().Value.Role)
+
+	// the key must belong to the imported service account
+	_, err = client.ImportServiceAccounts(suite.ctx, &management.ImportServiceAccountsRequest{
+		ServiceAccounts: []*management.ServiceAccountExport{
+			{
+				Name:                 "sa-other",
+				Role:                 string(role.Reader),
+				ArmoredPgpPublicKeys: []string{armored},
+			},
+		},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestServiceAccountClusterScope() {
+	server := suite.managementServer
+
+	for _, cluster := range []string{"dev", "prod"} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, cluster)))
+	}
+
+	adminCtx := suite.authContext(role.Admin)
+
+	createRequest := func(name, clusterScope string) *management.CreateServiceAccountRequest {
+		key, err := pgp.GenerateKey(name, "test", name+pkgaccess.ServiceAccountNameSuffix, time.Hour)
+		suite.Require().NoError(err)
+
+		armored, err := key.ArmorPublic()
+		suite.Require().NoError(err)
+
+		return &management.CreateServiceAccountRequest{
+			ArmoredPgpPublicKey: armored,
+			Role:                string(role.Operator),
+			ClusterScope:        clusterScope,
+		}
+	}
+
+	clusterScope := func(name string) (string, bool) {
+		identity, err := safe.StateGet[*authres.Identity](suite.ctx, suite.state, authres.NewIdentity(resources.DefaultNamespace, name+pkgaccess.ServiceAccountNameSuffix).Metadata())
+		suite.Require().NoError(err)
+
+		user, err := safe.StateGet[*authres.User](suite.ctx, suite.state, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+		suite.Require().NoError(err)
+
+		identityScope, _ := identity.Metadata().Labels().Get(authres.LabelClusterScope)
+		userScope, ok := user.Metadata().Labels().Get(authres.LabelClusterScope)
+
+		suite.Assert().Equal(identityScope, userScope)
+
+		return userScope, ok
+	}
+
+	_, err := server.CreateServiceAccount(adminCtx, createRequest("global", ""))
+	suite.Require().NoError(err)
+
+	_, ok := clusterScope("global")
+	suite.Assert().False(ok)
+
+	_, err = server.CreateServiceAccount(adminCtx, createRequest("scoped", "dev"))
+	suite.Require().NoError(err)
+
+	scope, _ := clusterScope("scoped")
+	suite.Assert().Equal("dev", scope)
+
+	// the scoped service account can access only its cluster
+	scopedCtx := context.WithValue(adminCtx, auth.ClusterScopeContextKey{}, "dev")
+
+	_, err = server.GetClusterCapacity(scopedCtx, &management.GetClusterCapacityRequest{ClusterName: "dev"})
+	suite.Require().NoError(err)
+
+	_, err = server.GetClusterCapacity(scopedCtx, &management.GetClusterCapacityRequest{ClusterName: "prod"})
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetClusterCapacity(adminCtx, &management.GetClusterCapacityRequest{ClusterName: "prod"})
+	suite.Require().NoError(err)
+
+	// the machines of the other clusters and the unallocated machines are skipped in the listings
+	for id, cluster := range map[string]string{"machine-dev": "dev", "machine-prod": "prod", "machine-free": ""} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.Cluster = cluster
+		machineStatus.TypedSpec().Value.TalosVersion = "v1.7.0"
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	queryResp, err := server.QueryMachineStatuses(scopedCtx, &management.QueryMachineStatusesRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(queryResp.Machines, 1)
+	suite.Assert().Equal("machine-dev", queryResp.Machines[0].MachineId)
+
+	versionsResp, err := server.ListMachinesByTalosVersion(scopedCtx, &management.ListMachinesByTalosVersionRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(versionsResp.Groups, 1)
+	suite.Assert().Equal([]string{"machine-dev"}, versionsResp.Groups[0].MachineIds)
+
+	versionsResp, err = server.ListMachinesByTalosVersion(adminCtx, &management.ListMachinesByTalosVersionRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(versionsResp.Groups, 1)
+	suite.Assert().ElementsMatch([]string{"machine-dev", "machine-prod", "machine-free"}, versionsResp.Groups[0].MachineIds)
+
+	// the machines of the other clusters and the unallocated machines can't be read directly
+	config.Config.MachineStatusHistory.Depth = 10
+
+	suite.T().Cleanup(func() {
+		config.Config.MachineStatusHistory.Depth = 0
+	})
+
+	for _, tc := range []struct {
+		machineID      string
+		historyCode    codes.Code
+		clockDriftCode codes.Code
+		resourceCode   codes.Code
+	}{
+		{machineID: "machine-prod", historyCode: codes.PermissionDenied, clockDriftCode: codes.PermissionDenied, resourceCode: codes.PermissionDenied},
+		{machineID: "machine-free", historyCode: codes.PermissionDenied, clockDriftCode: codes.PermissionDenied, resourceCode: codes.PermissionDenied},
+		// the access check passes, the machine has no history, no clock status and it is not connected
+		{machineID: "machine-dev", historyCode: codes.NotFound, clockDriftCode: codes.FailedPrecondition, resourceCode: codes.Unavailable},
+	} {
+		_, err = server.GetMachineStatusAt(scopedCtx, &management.GetMachineStatusAtRequest{
+			MachineId: tc.machineID,
+			Timestamp: timestamppb.Now(),
+		})
+		suite.Assert().Equal(tc.historyCode, status.Code(err), tc.machineID)
+
+		_, err = server.GetMachineStatusTimeSeries(scopedCtx, &management.GetMachineStatusTimeSeriesRequest{
+			MachineId: tc.machineID,
+			Metric:    management.GetMachineStatusTimeSeriesRequest_CONNECTED,
+			From:      timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		suite.Assert().Equal(tc.historyCode, status.Code(err), tc.machineID)
+
+		_, err = server.GetClockDrift(scopedCtx, &management.GetClockDriftRequest{MachineId: tc.machineID})
+		suite.Assert().Equal(tc.clockDriftCode, status.Code(err), tc.machineID)
+
+		_, err = server.GetMachineResource(scopedCtx, &management.GetMachineResourceRequest{
+			MachineId: tc.machineID,
+			Namespace: "config",
+			Type:      "MachineConfigs.config.talos.dev",
+			Id:        "v1alpha1",
+		})
+		suite.Assert().Equal(tc.resourceCode, status.Code(err), tc.machineID)
+	}
+
+	// the service accounts created by the scoped service account inherit its scope
+	_, err = server.CreateServiceAccount(scopedCtx, createRequest("inherited", ""))
+	suite.Require().NoError(err)
+
+	scope, _ = clusterScope("inherited")
+	suite.Assert().Equal("dev", scope)
+
+	_, err = server.CreateServiceAccount(scopedCtx, createRequest("escaped", "prod"))
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestImportExternalNodes() {
+	talosState := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	systemInformation := hardware.NewSystemInformation(hardware.SystemInformationID)
+	systemInformation.TypedSpec().UUID = "imported"
+
+	suite.Require().NoError(talosState.Create(suite.ctx, systemInformation))
+
+	// the fake Talos API of the imported nodes
+	socketPath := filepath.Join(suite.T().TempDir(), "talos")
+
+	listener, err := net.Listen("unix", socketPath)
+	suite.Require().NoError(err)
+
+	talosServer := grpc.NewServer()
+	v1alpha1.RegisterStateServer(talosServer, server.NewState(talosState))
+
+	go talosServer.Serve(listener) //nolint:errcheck
+
+	suite.T().Cleanup(talosServer.Stop)
+
+	talosconfig, err := (&clientconfig.Config{
+		Context: "dev",
+		Contexts: map[string]*clientconfig.Context{
+			"dev": {
+				CA:  "Y2E=",
+				Crt: "Y3J0",
+				Key: "a2V5",
+			},
+		},
+	}).Bytes()
+	suite.Require().NoError(err)
+
+	endpoint := "unix://" + socketPath
+
+	ctx := suite.authContext(role.Admin)
+
+	resp, err := suite.managementServer.ImportExternalNodes(ctx, &management.ImportExternalNodesRequest{
+		Name:        "dev",
+		Talosconfig: talosconfig,
+		Endpoints:   []string{endpoint},
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]string{"imported"}, resp.MachineIds)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"imported"}, func(res *omni.ImportedMachine, assert *assert.Assertions) {
+		name, _ := res.Metadata().Labels().Get(omni.LabelExternallyManaged)
+
+		assert.Equal("dev", name)
+		assert.Equal(endpoint, res.TypedSpec().Value.ManagementAddress)
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"dev"}, func(res *omni.ImportedTalosConfig, assert *assert.Assertions) {
+		assert.Equal("Y2E=", res.TypedSpec().Value.Ca)
+	})
+
+	// the controllers project the imported resources into the machine and the talosconfig
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"imported"}, func(res *omni.Machine, assert *assert.Assertions) {
+		assert.Equal(endpoint, res.TypedSpec().Value.ManagementAddress)
+		assert.True(res.TypedSpec().Value.Connected)
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ImportedTalosConfigID("dev")}, func(res *omni.TalosConfig, assert *assert.Assertions) {
+		assert.Equal("Y2E=", res.TypedSpec().Value.Ca)
+	})
+
+	// nothing is stored if any of the nodes can't be reached
+	_, err = suite.managementServer.ImportExternalNodes(ctx, &management.ImportExternalNodesRequest{
+		Name:        "unreachable",
+		Talosconfig: talosconfig,
+		Endpoints:   []string{endpoint, "unix://" + filepath.Join(suite.T().TempDir(), "missing")},
+	})
+	suite.Assert().Equal(codes.Unavailable, status.Code(err))
+
+	rtestutils.AssertNoResource[*omni.ImportedTalosConfig](suite.ctx, suite.T(), suite.state, "unreachable")
+
+	// the machines connected to Omni can't be imported
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachine(resources.DefaultNamespace, "managed")))
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, talosState, systemInformation.Metadata(), func(res *hardware.SystemInformation) error {
+		res.TypedSpec().UUID = "managed"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.managementServer.ImportExternalNodes(ctx, &management.ImportExternalNodesRequest{
+		Name:        "dev",
+		Talosconfig: talosconfig,
+		Endpoints:   []string{endpoint},
+	})
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+
+	rtestutils.AssertNoResource[*omni.ImportedMachine](suite.ctx, suite.T(), suite.state, "managed")
+
+	_, err = suite.managementServer.ImportExternalNodes(ctx, &management.ImportExternalNodesRequest{
+		Name:        "dev",
+		Talosconfig: []byte("invalid"),
+		Endpoints:   []string{endpoint},
+	})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestResourceClusterScope() {
+	server := &grpcomni.ResourceServer{}
+
+	for _, cluster := range []string{"dev", "prod"} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewCluster(resources.DefaultNamespace, cluster)))
+
+		configPatch := omni.NewConfigPatch(resources.DefaultNamespace, "patch-"+cluster)
+		configPatch.Metadata().Labels().Set(omni.LabelCluster, cluster)
+		configPatch.TypedSpec().Value.Data = "machine: {}"
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configPatch))
+	}
+
+	scopedCtx := context.WithValue(suite.authContext(role.Operator), auth.ClusterScopeContextKey{}, "dev")
+	scopedCtx = metadata.NewIncomingContext(scopedCtx, metadata.Pairs("runtime", common.Runtime_Omni.String()))
+
+	get := func(resourceType, id string) error {
+		_, err := server.Get(scopedCtx, &resapi.GetRequest{
+			Namespace: resources.DefaultNamespace,
+			Type:      resourceType,
+			Id:        id,
+		})
+
+		return err
+	}
+
+	suite.Require().NoError(get(omni.ClusterType, "dev"))
+	suite.Require().NoError(get(omni.ConfigPatchType, "patch-dev"))
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(get(omni.ClusterType, "prod")))
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(get(omni.ConfigPatchType, "patch-prod")))
+
+	list := func(ctx context.Context) (*resapi.ListResponse, error) {
+		return server.List(ctx, &resapi.ListRequest{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ConfigPatchType,
+		})
+	}
+
+	// listing without the cluster filter would leak the resources of the other clusters
+	_, err := list(scopedCtx)
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = list(metadata.NewIncomingContext(scopedCtx, metadata.Pairs(
+		"runtime", common.Runtime_Omni.String(),
+		message.SelectorsHeaderKey, omni.LabelCluster+"=prod",
+	)))
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	listResp, err := list(metadata.NewIncomingContext(scopedCtx, metadata.Pairs(
+		"runtime", common.Runtime_Omni.String(),
+		message.SelectorsHeaderKey, omni.LabelCluster+"=dev",
+	)))
+	suite.Require().NoError(err)
+
+	suite.Require().Len(listResp.Items, 1)
+
+	create := func(id, cluster string) error {
+		rawSpec, err := runtime.MarshalJSON(&specs.ConfigPatchSpec{Data: "machine: {}"})
+		suite.Require().NoError(err)
+
+		_, err = server.Create(scopedCtx, &resapi.CreateRequest{
+			Resource: &resapi.Resource{
+				Metadata: &v1alpha1.Metadata{
+					Id:        id,
+					Type:      omni.ConfigPatchType,
+					Namespace: resources.DefaultNamespace,
+					Version:   resource.VersionUndefined.String(),
+					Phase:     "running",
+					Labels:    map[string]string{omni.LabelCluster: cluster},
+				},
+				Spec: rawSpec,
+			},
+		})
+
+		return err
+	}
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(create("new-patch-prod", "prod")))
+	suite.Require().NoError(create("new-patch-dev", "dev"))
+
+	_, err = server.Delete(scopedCtx, &resapi.DeleteRequest{
+		Namespace: resources.DefaultNamespace,
+		Type:      omni.ClusterType,
+		Id:        "prod",
+	})
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	// the users without the cluster scope are not affected
+	unscopedCtx := metadata.NewIncomingContext(suite.authContext(role.Operator), metadata.Pairs("runtime", common.Runtime_Omni.String()))
+
+	listResp, err = list(unscopedCtx)
+	suite.Require().NoError(err)
+
+	suite.Assert().Len(listResp.Items, 3)
+}
+
+func (suite *GrpcSuite) TestMachineStatusRedaction() {
+	server := suite.managementServer
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine")
+	machineStatus.TypedSpec().Value = &specs.MachineStatusSpec{
+		Hardware: &specs.MachineStatusSpec_HardwareStatus{
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+				{LinuxName: "/dev/sda", Serial: "S1234", Wwid: "naa.5000"},
+			},
+			Firmware: &specs.MachineStatusSpec_HardwareStatus_Firmware{Vendor: "Dell", SerialNumber: "ABC123"},
+		},
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+				{LinuxName: "eth0", HardwareAddress: "00:11:22:33:44:55"},
+			},
+		},
+		PlatformMetadata: &specs.MachineStatusSpec_PlatformMetadata{Platform: "aws", InstanceId: "i-0123", ProviderId: "aws:///i-0123"},
+		HardwareChange: &specs.MachineStatusSpec_HardwareChange{
+			Changes: []string{"disk added: /dev/sdb, 512 GB, serial S5678, wwid naa.6000"},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	queryStatus := func(ctx context.Context) *specs.MachineStatusSpec {
+		resp, err := server.QueryMachineStatuses(ctx, &management.QueryMachineStatusesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Machines, 1)
+
+		return resp.Machines[0].Status
+	}
+
+	// the readers see the machine status with the hardware identifiers masked
+	redacted := queryStatus(suite.authContext(role.Reader))
+
+	suite.Assert().Equal("/dev/sda", redacted.Hardware.Blockdevices[0].LinuxName)
+	suite.Assert().Equal("******", redacted.Hardware.Blockdevices[0].Serial)
+	suite.Assert().Equal("******", redacted.Hardware.Blockdevices[0].Wwid)
+	suite.Assert().Empty(redacted.Hardware.Blockdevices[0].Uuid)
+	suite.Assert().Equal("Dell", redacted.Hardware.Firmware.Vendor)
+	suite.Assert().Equal("******", redacted.Hardware.Firmware.SerialNumber)
+	suite.Assert().Equal("******", redacted.Network.NetworkLinks[0].HardwareAddress)
+	suite.Assert().Equal("aws", redacted.PlatformMetadata.Platform)
+	suite.Assert().Equal("******", redacted.PlatformMetadata.InstanceId)
+	suite.Assert().Equal("******", redacted.PlatformMetadata.ProviderId)
+	suite.Assert().Equal([]string{"disk added: /dev/sdb, 512 GB, serial ******, wwid ******"}, redacted.HardwareChange.Changes)
+
+	// the stored resource is not modified
+	suite.Assert().Equal("S1234", machineStatus.TypedSpec().Value.Hardware.Blockdevices[0].Serial)
+
+	for _, userRole := range []role.Role{role.Operator, role.Admin} {
+		suite.Assert().True(proto.Equal(machineStatus.TypedSpec().Value, queryStatus(suite.authContext(userRole))), userRole)
+	}
+
+	changesResp, err := server.ListMachinesWithHardwareChanges(suite.authContext(role.Reader), &management.ListMachinesWithHardwareChangesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(changesResp.Machines, 1)
+	suite.Assert().Equal(redacted.HardwareChange.Changes, changesResp.Machines[0].Changes)
+
+	statusDocument := func(ctx context.Context) map[string]any {
+		resp, err := server.GetMachineStatusJSON(ctx, &management.GetMachineStatusJSONRequest{MachineId: "machine"})
+		suite.Require().NoError(err)
+
+		var document map[string]any
+
+		suite.Require().NoError(json.Unmarshal([]byte(resp.Body), &document))
+
+		return document
+	}
+
+	document := statusDocument(suite.authContext(role.Reader))
+	suite.Assert().Equal("******", document["serial_number"])
+	suite.Assert().Equal("******", document["instance_id"])
+
+	document = statusDocument(suite.authContext(role.Operator))
+	suite.Assert().Equal("ABC123", document["serial_number"])
+	suite.Assert().Equal("i-0123", document["instance_id"])
+
+	// the past versions of the machine status are redacted the same way
+	config.Config.MachineStatusHistory.Depth = 10
+
+	suite.T().Cleanup(func() {
+		config.Config.MachineStatusHistory.Depth = 0
+	})
+
+	history := omni.NewMachineStatusHistory(resources.DefaultNamespace, "machine")
+	history.TypedSpec().Value.Snapshots = []*specs.MachineStatusHistorySpec_Snapshot{
+		{
+			TakenAt: timestamppb.New(time.Now().Add(-time.Minute)),
+			Status:  machineStatus.TypedSpec().Value,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, history))
+
+	statusAt := func(ctx context.Context) *specs.MachineStatusSpec {
+		resp, err := server.GetMachineStatusAt(ctx, &management.GetMachineStatusAtRequest{MachineId: "machine", Timestamp: timestamppb.Now()})
+		suite.Require().NoError(err)
+
+		return resp.Status
+	}
+
+	suite.Assert().True(proto.Equal(redacted, statusAt(suite.authContext(role.Reader))))
+	suite.Assert().True(proto.Equal(machineStatus.TypedSpec().Value, statusAt(suite.authContext(role.Operator))))
+}
+
+type fakeServerTransportStream struct {
+	grpc.ServerTransportStream
+
+	method string
+}
+
+func (s fakeServerTransportStream) Method() string {
+	return s.method
+}
+
+func (suite *GrpcSuite) TestMethodRoleOverrides() {
+	server := suite.managementServer
+
+	readerContext := func(method string) context.Context {
+		return grpc.NewContextWithServerTransportStream(suite.authContext(role.Reader), fakeServerTransportStream{method: "/management.ManagementService/" + method})
+	}
+
+	suite.T().Cleanup(func() {
+		config.Config.Auth.MethodRoleOverrides = nil
+	})
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine")
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Firmware: &specs.MachineStatusSpec_HardwareStatus_Firmware{SerialNumber: "ABC123"},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	_, err := server.CreateSchematic(readerContext("CreateSchematic"), &management.CreateSchematicRequest{})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+
+	schematicID := strings.Repeat("ab", 32)
+
+	_, err = server.GetInstallerImageURL(readerContext("GetInstallerImageURL"), &management.GetInstallerImageURLRequest{SchematicId: schematicID, TalosVersion: "1.6.0"})
+	suite.Require().NoError(err)
+
+	config.Config.Auth.MethodRoleOverrides = config.MethodRoleOverrides{
+		"CreateSchematic":      string(role.Reader),
+		"GetInstallerImageURL": string(role.Admin),
+		"QueryMachineStatuses": string(role.Reader),
+		"GetMachineStatusJSON": string(role.Operator),
+	}
+
+	// the role check passes, the request might fail later for the other reasons
+	_, err = server.CreateSchematic(readerContext("CreateSchematic"), &management.CreateSchematicRequest{})
+	suite.Require().NotEqual(codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetInstallerImageURL(readerContext("GetInstallerImageURL"), &management.GetInstallerImageURLRequest{SchematicId: schematicID, TalosVersion: "1.6.0"})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+
+	// the methods which require only a valid signature can be restricted as well
+	_, err = server.GetMachineStatusJSON(readerContext("GetMachineStatusJSON"), &management.GetMachineStatusJSONRequest{MachineId: "machine"})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+
+	// the override replaces only the role required to call the method, the hardware identifiers are still masked for the readers
+	resp, err := server.QueryMachineStatuses(readerContext("QueryMachineStatuses"), &management.QueryMachineStatusesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Machines, 1)
+	suite.Assert().Equal("******", resp.Machines[0].Status.Hardware.Firmware.SerialNumber)
+
+	suite.Require().Error(config.MethodRoleOverrides{"CreateSchematic": string(role.None)}.Validate())
+	suite.Require().Error(config.MethodRoleOverrides{"CreateSchematic": "Superuser"}.Validate())
+	suite.Require().NoError(config.MethodRoleOverrides{"CreateSchematic": string(role.Reader)}.Validate())
+}
+
+func (suite *GrpcSuite) createServiceAccount(name, userID string, keyIDs ...string) {
+	email := name + pkgaccess.ServiceAccountNameSuffix
+
+	user := authres.NewUser(resources.DefaultNamespace, userID)
+	user.TypedSpec().Value.Role = string(role.Reader)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, user))
+
+	identity := authres.NewIdentity(resources.DefaultNamespace, email)
+	identity.TypedSpec().Value.UserId = userID
+	identity.Metadata().Labels().Set(authres.LabelIdentityUserID, userID)
+	identity.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	for _, keyID := range keyIDs {
+		key := authres.NewPublicKey(resources.DefaultNamespace, keyID)
+		key.Metadata().Labels().Set(authres.LabelPublicKeyUserID, userID)
+		key.TypedSpec().Value.PublicKey = []byte("armored-" + keyID)
+		key.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(time.Hour))
+		key.TypedSpec().Value.Role = string(role.Reader)
+		key.TypedSpec().Value.Confirmed = true
+		key.TypedSpec().Value.Identity = &specs.Identity{
+			Email: email,
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, key))
+	}
+}
+
+// authContext returns the context of the user with the given role, it is used to call the management server directly with the auth enabled.
+func (suite *GrpcSuite) authContext(userRole role.Role) context.Context {
+	ctx := context.WithValue(suite.ctx, auth.EnabledAuthContextKey{}, true)
+
+	return context.WithValue(ctx, auth.RoleContextKey{}, userRole)
+}
+
+func (suite *GrpcSuite) newServer(opts ...grpc.ServerOption) error {
+	var err error
+
+	suite.socketPath = filepath.Join(suite.T().TempDir(), "socket")
+
+	listener, err := net.Listen("unix", suite.socketPath)
+	if err != nil {
+		return err
+	}
+
+	grpcAddress := fmt.Sprintf("unix://%s", suite.socketPath)
+
+	suite.server = grpc.NewServer(opts...)
+	suite.managementServer = grpcomni.NewManagementServer(suite.state)
+
+	resapi.RegisterResourceServiceServer(suite.server, &grpcomni.ResourceServer{})
+	management.RegisterManagementServiceServer(suite.server, suite.managementServer)
+
+	go func() {
+		for {
+			err = suite.server.Serve(listener)
+			if err == nil || errors.Is(err, grpc.ErrServerStopped) {
+				break
+			}
+		}
+	}()
+
+	suite.conn, err = grpc.DialContext(suite.ctx, grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func TestGrpcSuite(t *testing.T) {
+	suite.Run(t, new(GrpcSuite))
+}
+
+func TestKubernetesEventResponse(t *testing.T) {
+	t.Parallel()
+
+	lastTimestamp := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	event := &corev1.Event{
+		Type:    corev1.EventTypeWarning,
+		Reason:  "FailedScheduling",
+		Message: "0/3 nodes are available",
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: "default",
+			Name:      "nginx",
+		},
+		Count:         3,
+		LastTimestamp: metav1.NewTime(lastTimestamp),
+	}
+
+	assert.True(t, proto.Equal(&management.WatchKubernetesEventsResponse{
+		Type:    corev1.EventTypeWarning,
+		Reason:  "FailedScheduling",
+		Message: "0/3 nodes are available",
+		InvolvedObject: &management.WatchKubernetesEventsResponse_InvolvedObject{
+			Kind:      "Pod",
+			Namespace: "default",
+			Name:      "nginx",
+		},
+		Count:         3,
+		LastTimestamp: timestamppb.New(lastTimestamp),
+	}, grpcomni.KubernetesEventResponse(event)))
+
+	// the events reported by the new events API only have the event time
+	event.LastTimestamp = metav1.Time{}
+	event.EventTime = metav1.NewMicroTime(lastTimestamp.Add(time.Minute))
+
+	assert.Equal(t, lastTimestamp.Add(time.Minute), grpcomni.KubernetesEventResponse(event).LastTimestamp.AsTime())
+
+	event.EventTime = metav1.MicroTime{}
+
+	assert.Nil(t, grpcomni.KubernetesEventResponse(event).LastTimestamp)
+}
+
+func TestTruncateLogLine(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte("short"), grpcomni.TruncateLogLine([]byte("short"), 10))
+	assert.Equal(t, []byte("unlimited"), grpcomni.TruncateLogLine([]byte("unlimited"), 0))
+	assert.Equal(t, []byte("too l... [truncated 8 bytes]"), grpcomni.TruncateLogLine([]byte("too long line"), 5))
+}
+
+func TestCPUUtilization(t *testing.T) {
+	t.Parallel()
+
+	_, ok := grpcomni.CPUUtilization(nil, &machine.CPUStat{User: 10, Idle: 90})
+	assert.False(t, ok)
+
+	_, ok = grpcomni.CPUUtilization(&machine.CPUStat{User: 10, Idle: 90}, &machine.CPUStat{User: 10, Idle: 90})
+	assert.False(t, ok)
+
+	utilization, ok := grpcomni.CPUUtilization(&machine.CPUStat{User: 10, Idle: 90}, &machine.CPUStat{User: 30, System: 10, Idle: 140, Iowait: 20})
+	assert.True(t, ok)
+	assert.InDelta(t, 30.0, utilization, 0.001)
+}
+
+func TestFilterChangedManifests(t *testing.T) {
+	t.Parallel()
+
+	newManifest := func(kind, name string, data map[string]any) manifests.Manifest {
+		obj := &unstructured.Unstructured{
+			Object: map[string]any{
+				"data": data,
+			},
+		}
+
+		obj.SetAPIVersion("v1")
+		obj.SetKind(kind)
+		obj.SetNamespace("kube-system")
+		obj.SetName(name)
+
+		return obj
+	}
+
+	synced := []manifests.Manifest{
+		newManifest("ConfigMap", "unchanged", map[string]any{"a": "1", "b": "2"}),
+		newManifest("ConfigMap", "changed", map[string]any{"a": "1"}),
+	}
+
+	syncedHashes, err := grpcomni.ManifestHashes(synced)
+	require.NoError(t, err)
+
+	assert.Contains(t, syncedHashes, "v1.ConfigMap/kube-system/unchanged")
+
+	current := []manifests.Manifest{
+		newManifest("ConfigMap", "unchanged", map[string]any{"b": "2", "a": "1"}),
+		newManifest("ConfigMap", "changed", map[string]any{"a": "2"}),
+		newManifest("Secret", "new", nil),
+	}
+
+	hashes, err := grpcomni.ManifestHashes(current)
+	require.NoError(t, err)
+
+	changed, unchanged := grpcomni.FilterChangedManifests(current, hashes, syncedHashes)
+
+	assert.Equal(t, []manifests.Manifest{current[1], current[2]}, changed)
+	assert.Equal(t, []manifests.Manifest{current[0]}, unchanged)
+
+	// full sync if nothing was synced yet
+	changed, unchanged = grpcomni.FilterChangedManifests(current, hashes, nil)
+
+	assert.Equal(t, current, changed)
+	assert.Empty(t, unchanged)
+}
+
+func TestFailedManifestSyncTracking(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	server := grpcomni.NewManagementServer(state.WrapCore(namespaced.NewState(inmem.Build)))
+
+	_, failed, err := server.FailedSyncManifestHashes(ctx, "sync")
+	require.NoError(t, err)
+	assert.False(t, failed)
+
+	// the first attempt fails before applying anything
+	require.NoError(t, server.StoreFailedManifestSync(ctx, "sync", map[string]string{}))
+
+	applied, failed, err := server.FailedSyncManifestHashes(ctx, "sync")
+	require.NoError(t, err)
+	assert.True(t, failed)
+	assert.Empty(t, applied)
+
+	// the retries accumulate the applied manifests
+	require.NoError(t, server.StoreFailedManifestSync(ctx, "sync", map[string]string{"v1.ConfigMap/kube-system/a": "1"}))
+	require.NoError(t, server.StoreFailedManifestSync(ctx, "sync", map[string]string{"v1.ConfigMap/kube-system/b": "2"}))
+
+	applied, failed, err = server.FailedSyncManifestHashes(ctx, "sync")
+	require.NoError(t, err)
+	assert.True(t, failed)
+	assert.Equal(t, map[string]string{"v1.ConfigMap/kube-system/a": "1", "v1.ConfigMap/kube-system/b": "2"}, applied)
+
+	// the successful sync resets the failed sync
+	require.NoError(t, server.StoreManifestHashes(ctx, "sync", map[string]string{"v1.ConfigMap/kube-system/a": "1"}))
+
+	applied, failed, err = server.FailedSyncManifestHashes(ctx, "sync")
+	require.NoError(t, err)
+	assert.False(t, failed)
+	assert.Empty(t, applied)
+}
+
+func TestTriggerManifestResync(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	server := grpcomni.NewManagementServer(st)
+
+	upgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, "sync")
+	require.NoError(t, st.Create(ctx, upgradeStatus, state.WithCreateOwner(omnictrl.KubernetesUpgradeStatusControllerName)))
+
+	annotations := func() *resource.Annotations {
+		res, err := safe.StateGet[*omni.KubernetesUpgradeStatus](ctx, st, upgradeStatus.Metadata())
+		require.NoError(t, err)
+
+		return res.Metadata().Annotations()
+	}
+
+	require.NoError(t, server.TriggerManifestResync(ctx, "sync", "alice@example.com", "CNI update", true))
+
+	dryRunAnnotations := annotations()
+
+	for key, expected := range map[string]string{
+		"manifest-sync-dry-run-by":     "alice@example.com",
+		"manifest-sync-dry-run-reason": "CNI update",
+	} {
+		value, ok := dryRunAnnotations.Get(key)
+		assert.True(t, ok, key)
+		assert.Equal(t, expected, value, key)
+	}
+
+	_, ok := dryRunAnnotations.Get("manifest-rollout")
+	assert.True(t, ok)
+
+	_, ok = dryRunAnnotations.Get("manifest-sync-by")
+	assert.False(t, ok, "the dry run is not recorded as a real sync")
+
+	// the real sync without a reason doesn't touch the dry run record
+	require.NoError(t, server.TriggerManifestResync(ctx, "sync", "bob@example.com", "", false))
+
+	syncAnnotations := annotations()
+
+	value, ok := syncAnnotations.Get("manifest-sync-by")
+	assert.True(t, ok)
+	assert.Equal(t, "bob@example.com", value)
+
+	_, ok = syncAnnotations.Get("manifest-sync-at")
+	assert.True(t, ok)
+
+	_, ok = syncAnnotations.Get("manifest-sync-reason")
+	assert.False(t, ok)
+
+	value, ok = syncAnnotations.Get("manifest-sync-dry-run-by")
+	assert.True(t, ok)
+	assert.Equal(t, "alice@example.com", value)
+
+	// no error if the cluster has no upgrade status yet
+	require.NoError(t, server.TriggerManifestResync(ctx, "missing", "bob@example.com", "", false))
+}
+
+func TestSelectInstallDisk(t *testing.T) {
+	t.Parallel()
+
+	blockDevices := []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		{LinuxName: "/dev/sda", Size: 100e9, Type: "HDD"},
+		{LinuxName: "/dev/nvme0n1", Size: 50e9, Type: "NVME", Model: "fast", SystemDisk: true},
+		{LinuxName: "/dev/nvme1n1", Size: 500e9, Type: "NVME", Model: "fast"},
+	}
+
+	resp := grpcomni.SelectInstallDisk(blockDevices, "/dev/nvme0n1", nil)
+	assert.Equal(t, "/dev/nvme0n1", resp.Disk)
+	assert.Equal(t, "/dev/nvme0n1", resp.SystemDisk)
+	assert.Equal(t, "picked automatically as the disk Talos is already installed to", resp.Reason)
+
+	resp = grpcomni.SelectInstallDisk(blockDevices, "/dev/nvme0n1", &talosconfig.InstallConfig{
+		InstallDisk: "/dev/sdb",
+	})
+	assert.Equal(t, "/dev/sdb", resp.Disk)
+	assert.Equal(t, "set explicitly in the machine config, but the machine doesn't report such disk", resp.Reason)
+
+	resp = grpcomni.SelectInstallDisk(blockDevices, "/dev/nvme0n1", &talosconfig.InstallConfig{
+		InstallDisk: "/dev/nvme0n1",
+		InstallDiskSelector: &talosconfig.InstallDiskSelector{
+			Type: talosconfig.InstallDiskType(disk.TypeNVMe),
+		},
+	})
+	assert.Equal(t, "/dev/nvme0n1", resp.Disk)
+	assert.Equal(t, []string{"/dev/nvme0n1", "/dev/nvme1n1"}, resp.MatchingDisks)
+
+	resp = grpcomni.SelectInstallDisk(blockDevices, "/dev/nvme0n1", &talosconfig.InstallConfig{
+		InstallDiskSelector: &talosconfig.InstallDiskSelector{
+			Model: "slow",
+		},
+	})
+	assert.Empty(t, resp.Disk)
+	assert.Equal(t, "no disk matches the install disk selector in the machine config", resp.Reason)
+}
+
+func TestWhoAmI(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	server := grpcomni.NewManagementServer(st)
+
+	identity := "ci" + pkgaccess.ServiceAccountNameSuffix
+
+	require.NoError(t, st.Create(ctx, authres.NewIdentity(resources.DefaultNamespace, identity)))
+
+	for _, cluster := range []string{"dev", "prod", "staging"} {
+		require.NoError(t, st.Create(ctx, omni.NewCluster(resources.DefaultNamespace, cluster)))
+	}
+
+	accessPolicy := authres.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+		{
+			Users:    []string{identity},
+			Clusters: []string{"dev", "staging"},
+			Role:     string(role.Operator),
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, accessPolicy))
+
+	callerContext := func(userRole role.Role) context.Context {
+		callerCtx := context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+		callerCtx = context.WithValue(callerCtx, auth.RoleContextKey{}, userRole)
+
+		return context.WithValue(callerCtx, auth.IdentityContextKey{}, identity)
+	}
+
+	resp, err := server.WhoAmI(callerContext(role.None), &management.WhoAmIRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, identity, resp.Identity)
+	assert.Equal(t, string(role.None), resp.Role)
+	assert.True(t, resp.ServiceAccount)
+	assert.True(t, resp.AuthEnabled)
+	assert.Empty(t, resp.ClusterRoles)
+
+	// only the clusters granted by the access policy are accessible
+	resp, err = server.WhoAmI(callerContext(role.None), &management.WhoAmIRequest{IncludeClusterRoles: true})
+	require.NoError(t, err)
+
+	clusterRoles := map[string]string{}
+
+	for _, clusterRole := range resp.ClusterRoles {
+		clusterRoles[clusterRole.Cluster] = clusterRole.Role
+	}
+
+	assert.Equal(t, map[string]string{"dev": string(role.Operator), "staging": string(role.Operator)}, clusterRoles)
+
+	// the base role applies to the clusters without the matching rules
+	resp, err = server.WhoAmI(callerContext(role.Reader), &management.WhoAmIRequest{IncludeClusterRoles: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, string(role.Reader), resp.Role)
+	require.Len(t, resp.ClusterRoles, 3)
+	assert.Equal(t, "prod", resp.ClusterRoles[1].Cluster)
+	assert.Equal(t, string(role.Reader), resp.ClusterRoles[1].Role)
+
+	// the request without a valid signature is rejected
+	_, err = server.WhoAmI(context.WithValue(ctx, auth.EnabledAuthContextKey{}, true), &management.WhoAmIRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err = server.WhoAmI(context.WithValue(ctx, auth.EnabledAuthContextKey{}, false), &management.WhoAmIRequest{IncludeClusterRoles: true})
+	require.NoError(t, err)
+
+	assert.False(t, resp.AuthEnabled)
+	assert.Equal(t, string(role.Admin), resp.Role)
+	assert.Len(t, resp.ClusterRoles, 3)
+}
+
+func TestGetExtensionUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	server := grpcomni.NewManagementServer(st)
+
+	extensions := func(names ...string) *specs.MachineStatusSpec_Schematic {
+		schematic := &specs.MachineStatusSpec_Schematic{}
+
+		for _, name := range names {
+			schematic.InstalledExtensions = append(schematic.InstalledExtensions, &specs.MachineStatusSpec_Schematic_InstalledExtension{
+				Name:    name,
+				Version: "v1.0.0",
+			})
+		}
+
+		return schematic
+	}
+
+	for id, spec := range map[string]*specs.MachineStatusSpec{
+		"machine-1": {Schematic: extensions("gvisor", "iscsi-tools")},
+		"machine-2": {Schematic: extensions("iscsi-tools", "iscsi-tools"), Cluster: "prod"},
+		"machine-3": {Schematic: extensions()},
+		"machine-4": {},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value = spec
+
+		require.NoError(t, st.Create(ctx, machineStatus))
+	}
+
+	readerCtx := context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	readerCtx = context.WithValue(readerCtx, auth.RoleContextKey{}, role.Reader)
+
+	resp, err := server.GetExtensionUsage(readerCtx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), resp.UnknownMachines)
+	require.Len(t, resp.Extensions, 2)
+	assert.Equal(t, "gvisor", resp.Extensions[0].Name)
+	assert.Equal(t, int32(1), resp.Extensions[0].Machines)
+	assert.Equal(t, "iscsi-tools", resp.Extensions[1].Name)
+	assert.Equal(t, int32(2), resp.Extensions[1].Machines)
+
+	// the machines the user can't read are not counted
+	noRoleCtx := context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	noRoleCtx = context.WithValue(noRoleCtx, auth.RoleContextKey{}, role.None)
+
+	resp, err = server.GetExtensionUsage(noRoleCtx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	assert.Zero(t, resp.UnknownMachines)
+	assert.Empty(t, resp.Extensions)
+}
+
+func TestUpgradeProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	server := grpcomni.NewManagementServer(st)
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "upgrading")
+	cluster.TypedSpec().Value.TalosVersion = "1.8.0"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.31.0"
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	for id, machine := range map[string]struct {
+		appliedVersion string
+		desiredVersion string
+		configError    string
+	}{
+		"done":      {appliedVersion: "1.8.0", desiredVersion: "1.8.0"},
+		"upgrading": {appliedVersion: "1.7.6", desiredVersion: "1.8.0"},
+		"pending":   {appliedVersion: "1.7.6", desiredVersion: "1.7.6"},
+		"failed":    {appliedVersion: "1.7.6", desiredVersion: "1.8.0", configError: "upgrade failed"},
+	} {
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, id)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "upgrading")
+
+		require.NoError(t, st.Create(ctx, clusterMachine))
+
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, id)
+		configStatus.Metadata().Labels().Set(omni.LabelCluster, "upgrading")
+		configStatus.TypedSpec().Value.TalosVersion = machine.appliedVersion
+		configStatus.TypedSpec().Value.LastConfigError = machine.configError
+
+		require.NoError(t, st.Create(ctx, configStatus))
+
+		talosVersion := omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, id)
+		talosVersion.Metadata().Labels().Set(omni.LabelCluster, "upgrading")
+		talosVersion.TypedSpec().Value.TalosVersion = machine.desiredVersion
+
+		require.NoError(t, st.Create(ctx, talosVersion))
+	}
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, "upgrading")
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "cp-1", KubeletVersion: "1.30.4"},
+		{Nodename: "worker-1", KubeletVersion: "1.31.0"},
+		{Nodename: "worker-2", KubeletVersion: "1.30.4"},
+	}
+	kubernetesStatus.TypedSpec().Value.StaticPods = []*specs.KubernetesStatusSpec_NodeStaticPods{
+		{
+			Nodename: "cp-1",
+			StaticPods: []*specs.KubernetesStatusSpec_StaticPodStatus{
+				{App: "kube-apiserver", Version: "1.31.0"},
+				{App: "kube-scheduler", Version: "1.30.4"},
+			},
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, kubernetesStatus))
+
+	progressStates := func() map[string]management.WatchUpgradeProgressResponse_State {
+		progress, err := server.UpgradeProgress(ctx, "upgrading")
+		require.NoError(t, err)
+
+		return xslices.ToMap(progress, func(node *management.WatchUpgradeProgressResponse) (string, management.WatchUpgradeProgressResponse_State) {
+			return node.Component.String() + "/" + node.Node, node.State
+		})
+	}
+
+	assert.Equal(t, map[string]management.WatchUpgradeProgressResponse_State{
+		"TALOS/done":          management.WatchUpgradeProgressResponse_DONE,
+		"TALOS/upgrading":     management.WatchUpgradeProgressResponse_UPGRADING,
+		"TALOS/pending":       management.WatchUpgradeProgressResponse_PENDING,
+		"TALOS/failed":        management.WatchUpgradeProgressResponse_FAILED,
+		"KUBERNETES/cp-1":     management.WatchUpgradeProgressResponse_UPGRADING,
+		"KUBERNETES/worker-1": management.WatchUpgradeProgressResponse_DONE,
+		"KUBERNETES/worker-2": management.WatchUpgradeProgressResponse_PENDING,
+	}, progressStates())
+
+	upgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, "upgrading")
+	upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Failed
+	upgradeStatus.TypedSpec().Value.Error = "kube-scheduler is not ready"
+
+	require.NoError(t, st.Create(ctx, upgradeStatus))
+
+	states := progressStates()
+
+	assert.Equal(t, management.WatchUpgradeProgressResponse_FAILED, states["KUBERNETES/cp-1"])
+	assert.Equal(t, management.WatchUpgradeProgressResponse_DONE, states["KUBERNETES/worker-1"])
+	assert.Equal(t, management.WatchUpgradeProgressResponse_FAILED, states["KUBERNETES/worker-2"])
+
+	_, err := server.UpgradeProgress(ctx, "missing")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestResourceMachineStatusHistoryAccess() {
+	server := &grpcomni.ResourceServer{}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatusHistory(resources.DefaultNamespace, "history-access")))
+
+	get := func(r role.Role) error {
+		_, err := server.Get(metadata.NewIncomingContext(suite.authContext(r), metadata.Pairs("runtime", common.Runtime_Omni.String())), &resapi.GetRequest{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineStatusHistoryType,
+			Id:        "history-access",
+		})
+
+		return err
+	}
+
+	// the history is not redacted, so it is not readable with the reader role
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(get(role.Reader)))
+	suite.Assert().NoError(get(role.Operator))
 }
@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetInstallDiskSelection returns the disk the machine installs Talos to, and explains how it was picked.
+//
+// For the cluster machines the generated machine config is checked, otherwise the disk Omni would pick automatically is returned.
+func (s *managementServer) GetInstallDiskSelection(ctx context.Context, req *management.GetInstallDiskSelectionRequest) (*management.GetInstallDiskSelectionResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](internalCtx, s.omniState, omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// the disk selection is derived from the machine status and the machine config
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if machineStatus.TypedSpec().Value.GetHardware() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q didn't report the hardware information yet", machineID)
+	}
+
+	var autoInstallDisk string
+
+	genOptions, err := safe.StateGet[*omnires.MachineConfigGenOptions](ctx, s.omniState, omnires.NewMachineConfigGenOptions(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if genOptions != nil {
+		autoInstallDisk = genOptions.TypedSpec().Value.GetInstallDisk()
+	}
+
+	var installConfig *v1alpha1.InstallConfig
+
+	clusterMachineConfig, err := safe.StateGet[*omnires.ClusterMachineConfig](ctx, s.omniState, omnires.NewClusterMachineConfig(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if clusterMachineConfig != nil && len(clusterMachineConfig.TypedSpec().Value.GetData()) > 0 {
+		config, err := configloader.NewFromBytes(clusterMachineConfig.TypedSpec().Value.GetData())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the machine config: %w", err)
+		}
+
+		if config.RawV1Alpha1() != nil && config.RawV1Alpha1().MachineConfig != nil {
+			installConfig = config.RawV1Alpha1().MachineConfig.MachineInstall
+		}
+	}
+
+	return selectInstallDisk(machineStatus.TypedSpec().Value.GetHardware().GetBlockdevices(), autoInstallDisk, installConfig), nil
+}
+
+// selectInstallDisk picks the install disk the same way Talos does: the disk selector takes precedence over the explicitly set disk.
+//
+//nolint:gocyclo,cyclop
+func selectInstallDisk(blockDevices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice, autoInstallDisk string,
+	installConfig *v1alpha1.InstallConfig,
+) *management.GetInstallDiskSelectionResponse {
+	response := &management.GetInstallDiskSelectionResponse{}
+
+	for _, blockDevice := range blockDevices {
+		if blockDevice.GetSystemDisk() {
+			response.SystemDisk = blockDevice.GetLinuxName()
+		}
+	}
+
+	var (
+		configInstallDisk string
+		matchers          []disk.Matcher
+	)
+
+	if installConfig != nil {
+		configInstallDisk = installConfig.InstallDisk
+		matchers = installConfig.DiskMatchers()
+	}
+
+	if len(matchers) > 0 {
+		for _, blockDevice := range blockDevices {
+			if disk.Match(inventoryDisk(blockDevice), matchers...) {
+				response.MatchingDisks = append(response.MatchingDisks, blockDevice.GetLinuxName())
+			}
+		}
+
+		if len(response.MatchingDisks) == 0 {
+			response.Reason = "no disk matches the install disk selector in the machine config"
+
+			return response
+		}
+
+		response.Disk = response.MatchingDisks[0]
+		response.Reason = "the first disk matching the install disk selector in the machine config"
+
+		return response
+	}
+
+	switch {
+	case configInstallDisk != "" && configInstallDisk != autoInstallDisk:
+		response.Disk = configInstallDisk
+		response.Reason = "set explicitly in the machine config"
+	case autoInstallDisk == "":
+		response.Reason = "no disk is large enough for the installation"
+
+		return response
+	case autoInstallDisk == response.SystemDisk:
+		response.Disk = autoInstallDisk
+		response.Reason = "picked automatically as the disk Talos is already installed to"
+	default:
+		response.Disk = autoInstallDisk
+		response.Reason = "picked automatically as the smallest disk large enough for the installation"
+	}
+
+	found := false
+
+	for _, blockDevice := range blockDevices {
+		if blockDevice.GetLinuxName() == response.Disk {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		response.Reason += ", but the machine doesn't report such disk"
+	}
+
+	return response
+}
+
+// inventoryDisk converts the disk reported by the machine to the form the disk selector matchers accept.
+func inventoryDisk(blockDevice *specs.MachineStatusSpec_HardwareStatus_BlockDevice) *disk.Disk {
+	diskType := disk.TypeUnknown
+
+	switch strings.ToLower(blockDevice.GetType()) {
+	case "ssd":
+		diskType = disk.TypeSSD
+	case "hdd":
+		diskType = disk.TypeHDD
+	case "nvme":
+		diskType = disk.TypeNVMe
+	case "sd":
+		diskType = disk.TypeSD
+	}
+
+	return &disk.Disk{
+		Size:       blockDevice.GetSize(),
+		Model:      blockDevice.GetModel(),
+		DeviceName: blockDevice.GetLinuxName(),
+		Name:       blockDevice.GetName(),
+		Serial:     blockDevice.GetSerial(),
+		WWID:       blockDevice.GetWwid(),
+		UUID:       blockDevice.GetUuid(),
+		Type:       diskType,
+		BusPath:    blockDevice.GetBusPath(),
+	}
+}
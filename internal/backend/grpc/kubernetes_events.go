@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	commonOmni "github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// WatchKubernetesEvents streams the Kubernetes events of all namespaces in the cluster.
+func (s *managementServer) WatchKubernetesEvents(req *management.WatchKubernetesEventsRequest, srv management.ManagementService_WatchKubernetesEventsServer) error {
+	ctx := srv.Context()
+
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// reading the events is equivalent to reading the cluster resources
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return err
+	}
+
+	type kubeConfigGetter interface {
+		GetKubeconfig(ctx context.Context, cluster *commonOmni.Context) (*rest.Config, error)
+	}
+
+	k8sRuntime, err := runtime.LookupInterface[kubeConfigGetter](kubernetes.Name)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := k8sRuntime.GetKubeconfig(ctx, &commonOmni.Context{Name: clusterName})
+	if err != nil {
+		return fmt.Errorf("error getting kubeconfig: %w", err)
+	}
+
+	clientset, err := k8s.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	watcher, err := clientset.CoreV1().Events(metav1.NamespaceAll).Watch(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error watching kubernetes events: %w", err)
+	}
+
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+
+			switch ev.Type {
+			case watch.Error:
+				return fmt.Errorf("error watching kubernetes events: %w", apierrors.FromObject(ev.Object))
+			case watch.Deleted, watch.Bookmark:
+				continue
+			case watch.Added, watch.Modified:
+			}
+
+			event, ok := ev.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+
+			if err = srv.Send(kubernetesEventResponse(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func kubernetesEventResponse(event *corev1.Event) *management.WatchKubernetesEventsResponse {
+	lastTimestamp := event.LastTimestamp.Time
+	if lastTimestamp.IsZero() {
+		lastTimestamp = event.EventTime.Time
+	}
+
+	resp := &management.WatchKubernetesEventsResponse{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		InvolvedObject: &management.WatchKubernetesEventsResponse_InvolvedObject{
+			Kind:      event.InvolvedObject.Kind,
+			Namespace: event.InvolvedObject.Namespace,
+			Name:      event.InvolvedObject.Name,
+		},
+		Count: event.Count,
+	}
+
+	if !lastTimestamp.IsZero() {
+		resp.LastTimestamp = timestamppb.New(lastTimestamp)
+	}
+
+	return resp
+}
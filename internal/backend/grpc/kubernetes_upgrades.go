@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ListKubernetesUpgrades returns the past Kubernetes upgrades of the cluster.
+func (s *managementServer) ListKubernetesUpgrades(ctx context.Context, req *management.ListKubernetesUpgradesRequest) (*management.ListKubernetesUpgradesResponse, error) {
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the upgrade history is derived from the upgrade status
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	history, err := safe.StateGet[*omnires.KubernetesUpgradeHistory](ctx, s.omniState, omnires.NewKubernetesUpgradeHistory(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil {
+		// the cluster wasn't upgraded yet
+		if state.IsNotFoundError(err) {
+			return &management.ListKubernetesUpgradesResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &management.ListKubernetesUpgradesResponse{
+		Upgrades: history.TypedSpec().Value.GetUpgrades(),
+	}, nil
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// CreateMachineGroup creates or updates a named group of machines defined by the label selectors.
+func (s *managementServer) CreateMachineGroup(ctx context.Context, req *management.CreateMachineGroupRequest) (*management.CreateMachineGroupResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if len(req.GetMatchLabels()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one label selector is required")
+	}
+
+	if _, err := labels.ParseSelectors(req.GetMatchLabels()); err != nil {
+		return nil, err
+	}
+
+	group := omnires.NewMachineGroup(resources.DefaultNamespace, req.GetName())
+
+	if err := createOrUpdate(ctx, s.omniState, group, func(res *omnires.MachineGroup) error {
+		res.TypedSpec().Value.MatchLabels = req.GetMatchLabels()
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	machines, err := s.resolveMachineGroup(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.CreateMachineGroupResponse{
+		MachineIds: xslices.Map(machines, func(machine *omnires.MachineStatus) string { return machine.Metadata().ID() }),
+	}, nil
+}
+
+// LabelMachineGroup sets the user labels on all machines of the group.
+func (s *managementServer) LabelMachineGroup(ctx context.Context, req *management.LabelMachineGroupRequest) (*management.MachineGroupOperationResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if len(req.GetLabels()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one label is required")
+	}
+
+	for key := range req.GetLabels() {
+		if strings.HasPrefix(key, omnires.SystemLabelPrefix) {
+			return nil, status.Errorf(codes.InvalidArgument, "label %q is reserved for the system use", key)
+		}
+	}
+
+	machines, err := s.resolveMachineGroup(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return runMachineGroupOperation(machines, func(machine *omnires.MachineStatus) error {
+		return createOrUpdate(ctx, s.omniState, omnires.NewMachineLabels(resources.DefaultNamespace, machine.Metadata().ID()), func(res *omnires.MachineLabels) error {
+			for key, value := range req.GetLabels() {
+				res.Metadata().Labels().Set(key, value)
+			}
+
+			return nil
+		})
+	})
+}
+
+// RebootMachineGroup reboots all machines of the group.
+//
+// Only the machines which are part of a cluster can be rebooted, as the cluster Talos API credentials are used.
+func (s *managementServer) RebootMachineGroup(ctx context.Context, req *management.RebootMachineGroupRequest) (*management.MachineGroupOperationResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machines, err := s.resolveMachineGroup(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return runMachineGroupOperation(machines, func(machine *omnires.MachineStatus) error {
+		clusterName := machine.TypedSpec().Value.GetCluster()
+		if clusterName == "" {
+			return fmt.Errorf("machine is not part of a cluster")
+		}
+
+		address := machine.TypedSpec().Value.GetManagementAddress()
+		if address == "" {
+			return fmt.Errorf("machine has no management address")
+		}
+
+		talosClient, err := talosRuntime.GetClient(ctx, clusterName)
+		if err != nil {
+			return fmt.Errorf("error getting talos client: %w", err)
+		}
+
+		return talosClient.Reboot(client.WithNode(ctx, address))
+	})
+}
+
+// resolveMachineGroup returns the machine statuses matching any of the machine group selectors.
+func (s *managementServer) resolveMachineGroup(ctx context.Context, name string) ([]*omnires.MachineStatus, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	group, err := safe.StateGet[*omnires.MachineGroup](ctx, s.omniState, omnires.NewMachineGroup(resources.DefaultNamespace, name).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine group %q not found", name)
+		}
+
+		return nil, err
+	}
+
+	selectors, err := labels.ParseSelectors(group.TypedSpec().Value.GetMatchLabels())
+	if err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		machines []*omnires.MachineStatus
+		seen     = map[resource.ID]struct{}{}
+	)
+
+	for _, selector := range selectors {
+		matched := machineStatuses.FilterLabelQuery(resource.RawLabelQuery(selector))
+
+		for iter := matched.Iterator(); iter.Next(); {
+			if _, ok := seen[iter.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			seen[iter.Value().Metadata().ID()] = struct{}{}
+
+			machines = append(machines, iter.Value())
+		}
+	}
+
+	return machines, nil
+}
+
+// runMachineGroupOperation runs the operation on each machine and collects the results.
+//
+// If the operation fails on any of the machines, the response is attached to the returned error.
+func runMachineGroupOperation(machines []*omnires.MachineStatus, operation func(machine *omnires.MachineStatus) error) (*management.MachineGroupOperationResponse, error) {
+	response := &management.MachineGroupOperationResponse{}
+
+	for _, machine := range machines {
+		if err := operation(machine); err != nil {
+			response.Failures = append(response.Failures, &management.MachineGroupOperationResponse_Failure{
+				MachineId: machine.Metadata().ID(),
+				Error:     err.Error(),
+			})
+
+			continue
+		}
+
+		response.MachineIds = append(response.MachineIds, machine.Metadata().ID())
+	}
+
+	if len(response.Failures) > 0 {
+		st, err := status.New(codes.Internal, fmt.Sprintf("operation failed on %d machine(s)", len(response.Failures))).WithDetails(response)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, st.Err()
+	}
+
+	return response, nil
+}
+
+// createOrUpdate creates the resource or updates it if it already exists.
+func createOrUpdate[T resource.Resource](ctx context.Context, st state.State, res T, update func(T) error) error {
+	if err := update(res); err != nil {
+		return err
+	}
+
+	err := st.Create(ctx, res)
+	if err == nil || !state.IsConflictError(err) {
+		return err
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, res.Metadata(), update)
+
+	return err
+}
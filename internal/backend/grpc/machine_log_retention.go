@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// SetMachineLogRetention overrides the size of the log buffer kept for the machine.
+//
+// The override is kept in the MachineLogRetention resource, the log handler resizes the buffer when the resource changes.
+// The buffer is sized in bytes, as the log lines are kept in a byte-sized circular buffer.
+func (s *managementServer) SetMachineLogRetention(ctx context.Context, req *management.SetMachineLogRetentionRequest) (*emptypb.Empty, error) {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	maxSize := req.GetMaxSizeBytes()
+	if maxSize != 0 && (maxSize < siderolink.MinCapacityOverride || maxSize > siderolink.MaxCapacityOverride) {
+		return nil, status.Errorf(codes.InvalidArgument, "max size should be between %d and %d bytes", siderolink.MinCapacityOverride, siderolink.MaxCapacityOverride)
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err := s.omniState.Get(ctx, omnires.NewMachine(resources.DefaultNamespace, req.GetMachineId()).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	retention := omnires.NewMachineLogRetention(resources.DefaultNamespace, req.GetMachineId())
+
+	if maxSize == 0 {
+		if err := s.omniState.Destroy(ctx, retention.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
+	if err := createOrUpdate(ctx, s.omniState, retention, func(res *omnires.MachineLogRetention) error {
+		res.TypedSpec().Value.MaxSizeBytes = maxSize
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
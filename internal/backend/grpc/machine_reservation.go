@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// SetMachineReserved reserves the machine or releases the reservation.
+//
+// The reservation is kept in the MachineReservation resource, the machine status controller labels the reserved machines.
+// The reserved machines are not available for the allocation, but the machines which are already in a cluster stay there.
+func (s *managementServer) SetMachineReserved(ctx context.Context, req *management.SetMachineReservedRequest) (*emptypb.Empty, error) {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machine, err := safe.StateGet[*omnires.Machine](ctx, s.omniState, omnires.NewMachine(resources.DefaultNamespace, req.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	// externally managed machines are never available for the allocation
+	if _, external := machine.Metadata().Labels().Get(omnires.LabelExternallyManaged); external {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is externally managed", req.GetMachineId())
+	}
+
+	reservation := omnires.NewMachineReservation(resources.DefaultNamespace, req.GetMachineId())
+
+	if !req.GetReserved() {
+		if err = s.omniState.Destroy(ctx, reservation.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.omniState.Create(ctx, reservation); err != nil && !state.IsConflictError(err) {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListUnallocatedMachines returns the machines which are not part of any cluster.
+func (s *managementServer) ListUnallocatedMachines(ctx context.Context, req *management.ListUnallocatedMachinesRequest) (*management.ListUnallocatedMachinesResponse, error) {
+	// listing the machines is equivalent to reading machine statuses
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.ListUnallocatedMachinesResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		machineStatus := iter.Value()
+
+		if machineStatus.TypedSpec().Value.GetCluster() != "" {
+			continue
+		}
+
+		if _, external := machineStatus.Metadata().Labels().Get(omnires.LabelExternallyManaged); external {
+			continue
+		}
+
+		_, reserved := machineStatus.Metadata().Labels().Get(omnires.LabelReserved)
+		if reserved && req.GetExcludeReserved() {
+			continue
+		}
+
+		response.Machines = append(response.Machines, &management.ListUnallocatedMachinesResponse_Machine{
+			MachineId: machineStatus.Metadata().ID(),
+			Connected: machineStatus.TypedSpec().Value.GetConnected(),
+			Reserved:  reserved,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// machineStatusDocument is the flat representation of the machine status.
+//
+// The fields are never omitted, so the schema of the document doesn't depend on what the machine reports.
+// The document is consumed by the external systems, so the existing fields must not be renamed or removed.
+type machineStatusDocument struct {
+	Labels map[string]string `json:"labels"`
+
+	MachineID         string `json:"machine_id"`
+	Cluster           string `json:"cluster"`
+	Role              string `json:"role"`
+	TalosVersion      string `json:"talos_version"`
+	ManagementAddress string `json:"management_address"`
+	LastError         string `json:"last_error"`
+	KernelCmdline     string `json:"kernel_cmdline"`
+
+	Arch            string `json:"arch"`
+	CPUModel        string `json:"cpu_model"`
+	FirmwareVendor  string `json:"firmware_vendor"`
+	FirmwareProduct string `json:"firmware_product"`
+	SerialNumber    string `json:"serial_number"`
+	SystemDisk      string `json:"system_disk"`
+
+	Hostname        string   `json:"hostname"`
+	Domainname      string   `json:"domainname"`
+	Addresses       []string `json:"addresses"`
+	DefaultGateways []string `json:"default_gateways"`
+	MACAddresses    []string `json:"mac_addresses"`
+
+	Platform     string `json:"platform"`
+	Region       string `json:"region"`
+	Zone         string `json:"zone"`
+	InstanceType string `json:"instance_type"`
+	InstanceID   string `json:"instance_id"`
+	ProviderID   string `json:"provider_id"`
+
+	SchematicID string   `json:"schematic_id"`
+	Extensions  []string `json:"extensions"`
+
+	DiskTotalBytes uint64 `json:"disk_total_bytes"`
+
+	CPUCores   uint32 `json:"cpu_cores"`
+	CPUThreads uint32 `json:"cpu_threads"`
+	MemoryMB   uint32 `json:"memory_mb"`
+	DiskCount  int    `json:"disk_count"`
+
+	Connected        bool `json:"connected"`
+	Maintenance      bool `json:"maintenance"`
+	Spot             bool `json:"spot"`
+	SchematicInvalid bool `json:"schematic_invalid"`
+}
+
+// GetMachineStatusJSON returns the machine status as the flat JSON document with the stable schema.
+func (s *managementServer) GetMachineStatusJSON(ctx context.Context, req *management.GetMachineStatusJSONRequest) (*management.GetMachineStatusJSONResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// the document is built from the machine status only
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	spec, err := s.machineStatusRedactor(ctx)(machineStatus.TypedSpec().Value)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(newMachineStatusDocument(machineStatus.Metadata(), spec))
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetMachineStatusJSONResponse{
+		Body: string(body),
+	}, nil
+}
+
+func newMachineStatusDocument(md *resource.Metadata, spec *specs.MachineStatusSpec) *machineStatusDocument {
+	doc := &machineStatusDocument{
+		Labels:            map[string]string{},
+		MachineID:         md.ID(),
+		Cluster:           spec.GetCluster(),
+		Role:              strings.ToLower(spec.GetRole().String()),
+		TalosVersion:      spec.GetTalosVersion(),
+		ManagementAddress: spec.GetManagementAddress(),
+		LastError:         spec.GetLastError(),
+		KernelCmdline:     spec.GetKernelCmdline(),
+		Connected:         spec.GetConnected(),
+		Maintenance:       spec.GetMaintenance(),
+		Addresses:         []string{},
+		DefaultGateways:   []string{},
+		MACAddresses:      []string{},
+		Extensions:        []string{},
+	}
+
+	for key, value := range md.Labels().Raw() {
+		doc.Labels[key] = value
+	}
+
+	if hardware := spec.GetHardware(); hardware != nil {
+		doc.Arch = hardware.GetArch()
+
+		for _, processor := range hardware.GetProcessors() {
+			if doc.CPUModel == "" {
+				doc.CPUModel = processor.GetDescription()
+			}
+
+			doc.CPUCores += processor.GetCoreCount()
+			doc.CPUThreads += processor.GetThreadCount()
+		}
+
+		for _, memoryModule := range hardware.GetMemoryModules() {
+			doc.MemoryMB += memoryModule.GetSizeMb()
+		}
+
+		for _, blockDevice := range hardware.GetBlockdevices() {
+			doc.DiskCount++
+			doc.DiskTotalBytes += blockDevice.GetSize()
+
+			if blockDevice.GetSystemDisk() {
+				doc.SystemDisk = blockDevice.GetLinuxName()
+			}
+		}
+
+		doc.FirmwareVendor = hardware.GetFirmware().GetVendor()
+		doc.FirmwareProduct = hardware.GetFirmware().GetProduct()
+		doc.SerialNumber = hardware.GetFirmware().GetSerialNumber()
+	}
+
+	if network := spec.GetNetwork(); network != nil {
+		doc.Hostname = network.GetHostname()
+		doc.Domainname = network.GetDomainname()
+		doc.Addresses = append(doc.Addresses, network.GetAddresses()...)
+		doc.DefaultGateways = append(doc.DefaultGateways, network.GetDefaultGateways()...)
+
+		for _, link := range network.GetNetworkLinks() {
+			doc.MACAddresses = append(doc.MACAddresses, link.GetHardwareAddress())
+		}
+	}
+
+	if platform := spec.GetPlatformMetadata(); platform != nil {
+		doc.Platform = platform.GetPlatform()
+		doc.Region = platform.GetRegion()
+		doc.Zone = platform.GetZone()
+		doc.InstanceType = platform.GetInstanceType()
+		doc.InstanceID = platform.GetInstanceId()
+		doc.ProviderID = platform.GetProviderId()
+		doc.Spot = platform.GetSpot()
+	}
+
+	if schematic := spec.GetSchematic(); schematic != nil {
+		doc.SchematicID = schematic.GetId()
+		doc.SchematicInvalid = schematic.GetInvalid()
+		doc.Extensions = append(doc.Extensions, schematic.GetExtensions()...)
+	}
+
+	return doc
+}
@@ -0,0 +1,1280 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// ListMachinesByTalosVersion returns the machines grouped by the Talos version they run, filtered by the semver constraint.
+//
+// Only the machines the user can read are returned.
+func (s *managementServer) ListMachinesByTalosVersion(ctx context.Context, req *management.ListMachinesByTalosVersionRequest) (*management.ListMachinesByTalosVersionResponse, error) {
+	// listing the machine versions is equivalent to reading machine statuses, the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	matches := func(semver.Version) bool { return true }
+
+	if req.GetConstraint() != "" {
+		versionRange, err := semver.ParseRange(req.GetConstraint())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid version constraint %q: %s", req.GetConstraint(), err)
+		}
+
+		matches = versionRange
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+
+	response := &management.ListMachinesByTalosVersionResponse{}
+	groups := map[string]*management.ListMachinesByTalosVersionResponse_Group{}
+	versions := map[string]semver.Version{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		allowed, err := canRead(iter.Value().TypedSpec().Value.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		machineID := iter.Value().Metadata().ID()
+		talosVersion := strings.TrimPrefix(iter.Value().TypedSpec().Value.TalosVersion, "v")
+
+		version, err := semver.Parse(talosVersion)
+		if err != nil {
+			response.UnknownMachineIds = append(response.UnknownMachineIds, machineID)
+
+			continue
+		}
+
+		if !matches(version) {
+			continue
+		}
+
+		group, ok := groups[talosVersion]
+		if !ok {
+			group = &management.ListMachinesByTalosVersionResponse_Group{
+				Version: talosVersion,
+			}
+
+			groups[talosVersion] = group
+			versions[talosVersion] = version
+
+			response.Groups = append(response.Groups, group)
+		}
+
+		group.MachineIds = append(group.MachineIds, machineID)
+	}
+
+	slices.SortFunc(response.Groups, func(a, b *management.ListMachinesByTalosVersionResponse_Group) int {
+		return versions[a.Version].Compare(versions[b.Version])
+	})
+
+	return response, nil
+}
+
+// ListUnhealthyMachines returns the machines which are disconnected or failed their last poll.
+//
+// Only the machines the user can read are returned: the cluster machines are checked against the cluster access policies.
+func (s *managementServer) ListUnhealthyMachines(ctx context.Context, _ *emptypb.Empty) (*management.ListUnhealthyMachinesResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+
+	response := &management.ListUnhealthyMachinesResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		machine := unhealthyMachine(iter.Value())
+		if machine == nil {
+			continue
+		}
+
+		allowed, err := canRead(machine.Cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		if allowed {
+			response.Machines = append(response.Machines, machine)
+		}
+	}
+
+	return response, nil
+}
+
+// ListMachinesByUptime returns the connected machines which didn't disconnect for at least the given duration.
+//
+// Only the machines the user can read are returned, the machines with the unknown connection time are skipped.
+func (s *managementServer) ListMachinesByUptime(ctx context.Context, req *management.ListMachinesByUptimeRequest) (*management.ListMachinesByUptimeResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	minConnectedDuration := req.GetMinConnectedDuration().AsDuration()
+	if minConnectedDuration < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min connected duration can not be negative")
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+	now := time.Now()
+
+	response := &management.ListMachinesByUptimeResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		if !spec.GetConnected() || spec.GetConnectivityChangedAt() == nil {
+			continue
+		}
+
+		connectedDuration := now.Sub(spec.GetConnectivityChangedAt().AsTime())
+		if connectedDuration < minConnectedDuration {
+			continue
+		}
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		response.Machines = append(response.Machines, &management.ListMachinesByUptimeResponse_Machine{
+			MachineId:         iter.Value().Metadata().ID(),
+			Cluster:           spec.GetCluster(),
+			ConnectedSince:    spec.GetConnectivityChangedAt(),
+			ConnectedDuration: durationpb.New(connectedDuration),
+		})
+	}
+
+	slices.SortStableFunc(response.Machines, func(a, b *management.ListMachinesByUptimeResponse_Machine) int {
+		return a.ConnectedSince.AsTime().Compare(b.ConnectedSince.AsTime())
+	})
+
+	return response, nil
+}
+
+// ListMachinesWithHardwareChanges returns the machines which hardware inventory changed since the given time, the most recently changed first.
+func (s *managementServer) ListMachinesWithHardwareChanges(ctx context.Context, req *management.ListMachinesWithHardwareChangesRequest) (*management.ListMachinesWithHardwareChangesResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+	redactStatus := s.machineStatusRedactor(ctx)
+
+	response := &management.ListMachinesWithHardwareChangesResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		change := spec.GetHardwareChange()
+		if change == nil {
+			continue
+		}
+
+		if req.GetSince() != nil && change.GetChangedAt().AsTime().Before(req.GetSince().AsTime()) {
+			continue
+		}
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		// the descriptions of the disks include their serial numbers
+		if spec, err = redactStatus(spec); err != nil {
+			return nil, err
+		}
+
+		response.Machines = append(response.Machines, &management.ListMachinesWithHardwareChangesResponse_Machine{
+			MachineId: iter.Value().Metadata().ID(),
+			Cluster:   spec.GetCluster(),
+			ChangedAt: change.GetChangedAt(),
+			Changes:   spec.GetHardwareChange().GetChanges(),
+		})
+	}
+
+	slices.SortStableFunc(response.Machines, func(a, b *management.ListMachinesWithHardwareChangesResponse_Machine) int {
+		return b.ChangedAt.AsTime().Compare(a.ChangedAt.AsTime())
+	})
+
+	return response, nil
+}
+
+// ListMachinesPendingReboot returns the machines which need a reboot to apply the staged configuration, and the machines which are rebooting now.
+func (s *managementServer) ListMachinesPendingReboot(ctx context.Context, _ *emptypb.Empty) (*management.ListMachinesPendingRebootResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+
+	response := &management.ListMachinesPendingRebootResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		rebootStatus := spec.GetRebootStatus()
+		if rebootStatus.GetState() == specs.MachineStatusSpec_RebootStatus_NONE {
+			continue
+		}
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		response.Machines = append(response.Machines, &management.ListMachinesPendingRebootResponse_Machine{
+			MachineId: iter.Value().Metadata().ID(),
+			Cluster:   spec.GetCluster(),
+			State:     rebootStatus.GetState(),
+			Reason:    rebootStatus.GetReason(),
+		})
+	}
+
+	return response, nil
+}
+
+// QueryMachineStatuses returns the statuses of the machines matching the label selector.
+//
+// Only the machines the user can read are returned, so the pages are built after the access check.
+func (s *managementServer) QueryMachineStatuses(ctx context.Context, req *management.QueryMachineStatusesRequest) (*management.QueryMachineStatusesResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	var listOptions []state.ListOption
+
+	if req.GetLabelSelector() != "" {
+		query, err := labels.ParseQuery(req.GetLabelSelector())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %s", req.GetLabelSelector(), err)
+		}
+
+		listOptions = append(listOptions, state.WithLabelQuery(resource.RawLabelQuery(*query)))
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState, listOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+	redactStatus := s.machineStatusRedactor(ctx)
+
+	var machines []*management.QueryMachineStatusesResponse_Machine
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		if spec, err = redactStatus(spec); err != nil {
+			return nil, err
+		}
+
+		machines = append(machines, &management.QueryMachineStatusesResponse_Machine{
+			MachineId: iter.Value().Metadata().ID(),
+			Labels:    iter.Value().Metadata().Labels().Raw(),
+			Status:    spec,
+		})
+	}
+
+	page, err := paginate(machines, req.GetOffset(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.QueryMachineStatusesResponse{
+		Machines: page,
+		Total:    int32(len(machines)),
+	}, nil
+}
+
+// clusterReadAccessChecker returns the function which checks if the machines of the cluster can be read.
+//
+// The access is checked once per cluster, the empty cluster name stands for the unallocated machines,
+// which are not accessible to the users limited to a single cluster.
+func (s *managementServer) clusterReadAccessChecker(ctx context.Context) func(clusterName string) (bool, error) {
+	return s.clusterRoleChecker(ctx, role.Reader)
+}
+
+// clusterRoleChecker returns the function which checks if the user has the required role for the cluster.
+func (s *managementServer) clusterRoleChecker(ctx context.Context, requiredRole role.Role) func(clusterName string) (bool, error) {
+	access := map[string]bool{}
+
+	return func(clusterName string) (bool, error) {
+		if allowed, ok := access[clusterName]; ok {
+			return allowed, nil
+		}
+
+		clusterCtx := ctx
+
+		var checkErr error
+
+		if clusterName != "" {
+			clusterCtx, checkErr = s.applyClusterAccessPolicy(ctx, clusterName)
+		} else {
+			checkErr = auth.CheckClusterScope(ctx, clusterName)
+		}
+
+		// the users limited to a single cluster are denied the access to all other clusters by the access policy
+		if checkErr == nil {
+			_, checkErr = s.authCheckGRPC(clusterCtx, auth.WithRole(requiredRole))
+		}
+
+		if checkErr != nil && status.Code(checkErr) != codes.PermissionDenied {
+			return false, checkErr
+		}
+
+		access[clusterName] = checkErr == nil
+
+		return access[clusterName], nil
+	}
+}
+
+// getReadableMachineStatus returns the machine status if the user can read the machine.
+func (s *managementServer) getReadableMachineStatus(ctx context.Context, machineID string) (*omnires.MachineStatus, error) {
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	allowed, err := s.clusterReadAccessChecker(ctx)(machineStatus.TypedSpec().Value.GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "access to the machine %q is denied", machineID)
+	}
+
+	return machineStatus, nil
+}
+
+// unhealthyMachine describes the machine problems, it returns nil if the machine is healthy.
+func unhealthyMachine(machineStatus *omnires.MachineStatus) *management.ListUnhealthyMachinesResponse_Machine {
+	spec := machineStatus.TypedSpec().Value
+
+	var failedPolls []string
+
+	for category, pollStatus := range spec.GetPollStatuses() {
+		if pollStatus == specs.MachineStatusSpec_ERROR {
+			failedPolls = append(failedPolls, category)
+		}
+	}
+
+	slices.Sort(failedPolls)
+
+	var reasons []string
+
+	if !spec.GetConnected() {
+		reasons = append(reasons, "machine is disconnected")
+	}
+
+	if spec.GetLastError() != "" {
+		reasons = append(reasons, "last poll failed: "+spec.GetLastError())
+	}
+
+	if len(failedPolls) > 0 {
+		reasons = append(reasons, "failed to poll "+strings.Join(failedPolls, ", "))
+	}
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	return &management.ListUnhealthyMachinesResponse_Machine{
+		MachineId:   machineStatus.Metadata().ID(),
+		Cluster:     spec.GetCluster(),
+		Connected:   spec.GetConnected(),
+		FailedPolls: failedPolls,
+		Reason:      strings.Join(reasons, "; "),
+	}
+}
+
+// GetMachineDmesg streams the kernel ring buffer of the machine.
+func (s *managementServer) GetMachineDmesg(req *management.GetMachineDmesgRequest, srv management.ManagementService_GetMachineDmesgServer) error {
+	ctx := srv.Context()
+
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return err
+	}
+
+	clusterName := machineStatus.TypedSpec().Value.GetCluster()
+
+	if clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return err
+		}
+	}
+
+	// reading the kernel logs exposes the low-level machine details, so it is limited to the operators
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	stream, err := talosClient.Dmesg(ctx, false, false)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to read dmesg: %s", err)
+	}
+
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = srv.Send(&common.Data{
+			Bytes: data.GetBytes(),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// getMachineTalosClient returns the Talos client for the machine and the context which targets the machine.
+//
+// The machines in the maintenance mode are reached using the insecure Talos API, the cluster machines use the cluster Talos API credentials.
+// The returned function should be called to release the client.
+func (s *managementServer) getMachineTalosClient(ctx context.Context, machineStatus *omnires.MachineStatus) (context.Context, *client.Client, func(), error) {
+	address := machineStatus.TypedSpec().Value.GetManagementAddress()
+	if !machineStatus.TypedSpec().Value.GetConnected() || address == "" {
+		return nil, nil, nil, status.Errorf(codes.Unavailable, "machine %q is not connected", machineStatus.Metadata().ID())
+	}
+
+	clusterName := machineStatus.TypedSpec().Value.GetCluster()
+
+	if machineStatus.TypedSpec().Value.GetMaintenance() || clusterName == "" {
+		talosClient, err := client.New(ctx,
+			client.WithTLSConfig(&tls.Config{
+				InsecureSkipVerify: true,
+			}),
+			client.WithEndpoints(address),
+		)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create maintenance client: %w", err)
+		}
+
+		return ctx, talosClient, func() { talosClient.Close() }, nil //nolint:errcheck
+	}
+
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clusterClient, err := talosRuntime.GetClient(ctx, clusterName)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Unavailable, "error getting talos client: %s", err)
+	}
+
+	// the cluster client is shared, so it is not closed here
+	return client.WithNode(ctx, address), clusterClient.Client, func() {}, nil
+}
+
+// GetKubeletStatus returns the kubelet service status and the effective kubelet configuration of the cluster machine.
+func (s *managementServer) GetKubeletStatus(ctx context.Context, req *management.GetKubeletStatusRequest) (*management.GetKubeletStatusResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	clusterName := machineStatus.TypedSpec().Value.GetCluster()
+	if clusterName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is not part of a cluster", machineID)
+	}
+
+	if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+		return nil, err
+	}
+
+	// reading the kubelet config exposes the low-level node details, so it is limited to the operators
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeClient()
+
+	services, err := talosClient.ServiceInfo(ctx, "kubelet")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubelet service status: %w", err)
+	}
+
+	response := &management.GetKubeletStatusResponse{}
+
+	for _, service := range services {
+		response.Service = &management.GetKubeletStatusResponse_Service{
+			State:         service.Service.GetState(),
+			Healthy:       service.Service.GetHealth().GetHealthy(),
+			HealthUnknown: service.Service.GetHealth().GetUnknown(),
+			LastMessage:   service.Service.GetHealth().GetLastMessage(),
+		}
+	}
+
+	kubeletSpec, err := safe.StateGetByID[*k8s.KubeletSpec](ctx, talosClient.COSI, k8s.KubeletID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get kubelet spec: %w", err)
+	}
+
+	// the kubelet spec is missing until the machine config is applied
+	if kubeletSpec != nil {
+		spec := kubeletSpec.TypedSpec()
+
+		config, err := yaml.Marshal(spec.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal kubelet config: %w", err)
+		}
+
+		response.Image = spec.Image
+		response.Args = spec.Args
+		response.ExpectedNodename = spec.ExpectedNodename
+		response.Config = string(config)
+
+		if cgroupDriver, ok := spec.Config["cgroupDriver"].(string); ok {
+			response.CgroupDriver = cgroupDriver
+		}
+	}
+
+	nodeIP, err := safe.StateGetByID[*k8s.NodeIP](ctx, talosClient.COSI, k8s.KubeletID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get kubelet node IPs: %w", err)
+	}
+
+	if nodeIP != nil {
+		for _, addr := range nodeIP.TypedSpec().Addresses {
+			response.NodeIps = append(response.NodeIps, addr.String())
+		}
+	}
+
+	return response, nil
+}
+
+// GetMachineResource returns the Talos resource of the machine in YAML format.
+//
+// The resource is read directly from the machine, so each access is logged.
+func (s *managementServer) GetMachineResource(ctx context.Context, req *management.GetMachineResourceRequest) (*management.GetMachineResourceResponse, error) {
+	// any Talos resource can be read including the secrets, so it is limited to the admins
+	authCheckResult, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if req.GetNamespace() == "" || req.GetType() == "" || req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource namespace, type and id are required")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](ctx, s.omniState, omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	// the users limited to a single cluster can't read the machines of the other clusters and the unallocated machines
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		_, err = s.applyClusterAccessPolicy(ctx, clusterName)
+	} else {
+		err = auth.CheckClusterScope(ctx, clusterName)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reading machine resource",
+		zap.String("machine", machineID),
+		zap.String("namespace", req.GetNamespace()),
+		zap.String("type", req.GetType()),
+		zap.String("id", req.GetId()),
+		zap.String("identity", authCheckResult.Identity),
+	)
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeClient()
+
+	res, err := talosClient.COSI.Get(ctx, resource.NewMetadata(req.GetNamespace(), req.GetType(), req.GetId(), resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "resource %s/%s/%s not found", req.GetNamespace(), req.GetType(), req.GetId())
+		}
+
+		return nil, fmt.Errorf("failed to get resource: %w", err)
+	}
+
+	out, err := resource.MarshalYAML(res)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := yaml.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	return &management.GetMachineResourceResponse{
+		Body: string(body),
+	}, nil
+}
+
+// ReapplyMachineConfig pushes the machine config generated by Omni to the cluster machine once.
+//
+// It allows to fix the config drift without waiting for the next reconcile of the machine config.
+func (s *managementServer) ReapplyMachineConfig(ctx context.Context, req *management.ReapplyMachineConfigRequest) (*management.ReapplyMachineConfigResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	clusterName := machineStatus.TypedSpec().Value.GetCluster()
+	if clusterName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is not part of a cluster", machineID)
+	}
+
+	if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+		return nil, err
+	}
+
+	// applying the config might reboot the machine
+	authCheckResult, err := s.authCheckMethod(ctx, role.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineConfig, err := safe.StateGet[*omnires.ClusterMachineConfig](ctx, s.omniState, omnires.NewClusterMachineConfig(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "machine %q config is not generated yet", machineID)
+		}
+
+		return nil, err
+	}
+
+	if generationError := machineConfig.TypedSpec().Value.GetGenerationError(); generationError != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q config generation failed: %s", machineID, generationError)
+	}
+
+	if len(machineConfig.TypedSpec().Value.GetData()) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q config is not generated yet", machineID)
+	}
+
+	s.logger.Info("reapplying machine config",
+		zap.String("machine", machineID),
+		zap.String("cluster", clusterName),
+		zap.Stringer("config_version", machineConfig.Metadata().Version()),
+		zap.String("identity", authCheckResult.Identity),
+	)
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeClient()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	resp, err := talosClient.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+		Data: machineConfig.TypedSpec().Value.GetData(),
+		Mode: machine.ApplyConfigurationRequest_AUTO,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to apply config to machine %q: %s", machineID, err)
+	}
+
+	if len(resp.GetMessages()) != 1 {
+		return nil, fmt.Errorf("unexpected number of responses: %d", len(resp.GetMessages()))
+	}
+
+	mode := resp.GetMessages()[0].GetMode()
+
+	return &management.ReapplyMachineConfigResponse{
+		Mode:           mode.String(),
+		ModeDetails:    resp.GetMessages()[0].GetModeDetails(),
+		RebootRequired: mode == machine.ApplyConfigurationRequest_REBOOT,
+	}, nil
+}
+
+// maintenanceValidationMode is the config validation mode of the machines running in the maintenance mode,
+// the applied config installs Talos, so the install section is required.
+type maintenanceValidationMode struct{}
+
+func (maintenanceValidationMode) String() string { return "metal" }
+
+func (maintenanceValidationMode) RequiresInstall() bool { return true }
+
+// ApplyMaintenanceConfig validates the machine config and applies it to the machine running in the maintenance mode.
+//
+// It allows to customize the machine (e.g. the disk layout) before it joins a cluster.
+func (s *managementServer) ApplyMaintenanceConfig(ctx context.Context, req *management.ApplyMaintenanceConfigRequest) (*management.ApplyMaintenanceConfigResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if req.GetConfig() == "" {
+		return nil, status.Error(codes.InvalidArgument, "config is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// applying the config installs Talos and reboots the machine
+	authCheckResult, err := s.authCheckMethod(ctx, role.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !machineStatus.TypedSpec().Value.GetMaintenance() {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is not running in the maintenance mode", machineID)
+	}
+
+	machineConfig, err := configloader.NewFromBytes([]byte(req.GetConfig()))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to load config: %s", err)
+	}
+
+	// the config is validated outside the machine, so the install disk existence can't be checked
+	warnings, err := machineConfig.Validate(maintenanceValidationMode{}, validation.WithLocal())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid config: %s", err)
+	}
+
+	s.logger.Info("applying maintenance config",
+		zap.String("machine", machineID),
+		zap.String("identity", authCheckResult.Identity),
+	)
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeClient()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	resp, err := talosClient.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+		Data: []byte(req.GetConfig()),
+		Mode: machine.ApplyConfigurationRequest_AUTO,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to apply config to machine %q: %s", machineID, err)
+	}
+
+	if len(resp.GetMessages()) != 1 {
+		return nil, fmt.Errorf("unexpected number of responses: %d", len(resp.GetMessages()))
+	}
+
+	return &management.ApplyMaintenanceConfigResponse{
+		ModeDetails: resp.GetMessages()[0].GetModeDetails(),
+		Warnings:    warnings,
+	}, nil
+}
+
+// GetMachineStatusAt returns the machine status snapshot closest to the requested time, taken at or before it.
+func (s *managementServer) GetMachineStatusAt(ctx context.Context, req *management.GetMachineStatusAtRequest) (*management.GetMachineStatusAtResponse, error) {
+	// the history is a past version of the machine status, so the machine status read access is checked below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if req.GetTimestamp() == nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp is required")
+	}
+
+	if config.Config.MachineStatusHistory.Depth == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "machine status history is disabled")
+	}
+
+	if _, err := s.getReadableMachineStatus(ctx, req.GetMachineId()); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	history, err := safe.StateGet[*omnires.MachineStatusHistory](ctx, s.omniState, omnires.NewMachineStatusHistory(resources.DefaultNamespace, req.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "no status history for machine %q", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	timestamp := req.GetTimestamp().AsTime()
+	snapshots := history.TypedSpec().Value.GetSnapshots()
+
+	// the snapshots are ordered from the oldest to the newest
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if snapshots[i].GetTakenAt().AsTime().After(timestamp) {
+			continue
+		}
+
+		// the snapshot is the past version of the machine status, so it is redacted the same way
+		machineStatus, err := s.machineStatusRedactor(ctx)(snapshots[i].GetStatus())
+		if err != nil {
+			return nil, err
+		}
+
+		return &management.GetMachineStatusAtResponse{
+			TakenAt: snapshots[i].GetTakenAt(),
+			Status:  machineStatus,
+		}, nil
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no status snapshot of machine %q at or before %s", req.GetMachineId(), timestamp.Format(time.RFC3339))
+}
+
+const (
+	defaultTimeSeriesResolution = time.Minute
+	minTimeSeriesResolution     = time.Second
+	maxTimeSeriesRange          = 31 * 24 * time.Hour
+	maxTimeSeriesSamples        = 1000
+)
+
+// GetMachineStatusTimeSeries samples the metric of the machine status history.
+//
+// The history is a set of the snapshots taken on the changes, so each sample is the value of the latest snapshot taken at or before the sample time.
+//
+//nolint:gocyclo,cyclop
+func (s *managementServer) GetMachineStatusTimeSeries(ctx context.Context, req *management.GetMachineStatusTimeSeriesRequest) (*management.GetMachineStatusTimeSeriesResponse, error) {
+	// the history is a past version of the machine status, so the machine status read access is checked below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if req.GetMetric() == management.GetMachineStatusTimeSeriesRequest_UNKNOWN {
+		return nil, status.Error(codes.InvalidArgument, "metric is required")
+	}
+
+	if req.GetFrom() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+
+	from := req.GetFrom().AsTime()
+	to := time.Now()
+
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	if to.Sub(from) > maxTimeSeriesRange {
+		return nil, status.Errorf(codes.InvalidArgument, "the time range can not be longer than %s", maxTimeSeriesRange)
+	}
+
+	resolution := defaultTimeSeriesResolution
+
+	if req.GetResolution() != nil {
+		resolution = req.GetResolution().AsDuration()
+
+		if resolution < minTimeSeriesResolution {
+			return nil, status.Errorf(codes.InvalidArgument, "resolution must be at least %s", minTimeSeriesResolution)
+		}
+	}
+
+	if to.Sub(from)/resolution >= maxTimeSeriesSamples {
+		return nil, status.Errorf(codes.InvalidArgument, "the time range and resolution produce more than %d samples", maxTimeSeriesSamples)
+	}
+
+	if config.Config.MachineStatusHistory.Depth == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "machine status history is disabled")
+	}
+
+	if _, err := s.getReadableMachineStatus(ctx, req.GetMachineId()); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	history, err := safe.StateGet[*omnires.MachineStatusHistory](ctx, s.omniState, omnires.NewMachineStatusHistory(resources.DefaultNamespace, req.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "no status history for machine %q", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	snapshots := history.TypedSpec().Value.GetSnapshots()
+	response := &management.GetMachineStatusTimeSeriesResponse{}
+
+	// the snapshots are ordered from the oldest to the newest, so both the snapshots and the samples are walked once
+	current := -1
+
+	for sampleTime := from; !sampleTime.After(to); sampleTime = sampleTime.Add(resolution) {
+		for current+1 < len(snapshots) && !snapshots[current+1].GetTakenAt().AsTime().After(sampleTime) {
+			current++
+		}
+
+		if current < 0 {
+			continue
+		}
+
+		value, ok := machineStatusMetric(snapshots[current].GetStatus(), req.GetMetric())
+		if !ok {
+			continue
+		}
+
+		response.Samples = append(response.Samples, &management.GetMachineStatusTimeSeriesResponse_Sample{
+			Timestamp: timestamppb.New(sampleTime),
+			Value:     value,
+		})
+	}
+
+	return response, nil
+}
+
+func machineStatusMetric(spec *specs.MachineStatusSpec, metric management.GetMachineStatusTimeSeriesRequest_Metric) (float64, bool) {
+	boolValue := func(b bool) float64 {
+		if b {
+			return 1
+		}
+
+		return 0
+	}
+
+	switch metric {
+	case management.GetMachineStatusTimeSeriesRequest_CONNECTED:
+		return boolValue(spec.GetConnected()), true
+	case management.GetMachineStatusTimeSeriesRequest_KUBERNETES_NODE_READY:
+		if spec.GetKubernetesNodeStatus() == nil {
+			return 0, false
+		}
+
+		return boolValue(spec.GetKubernetesNodeStatus().GetReady()), true
+	case management.GetMachineStatusTimeSeriesRequest_NETWORK_LINKS_UP:
+		if spec.GetNetwork() == nil {
+			return 0, false
+		}
+
+		var up int
+
+		for _, link := range spec.GetNetwork().GetNetworkLinks() {
+			if link.GetLinkUp() {
+				up++
+			}
+		}
+
+		return float64(up), true
+	case management.GetMachineStatusTimeSeriesRequest_CLOCK_DRIFT_SECONDS:
+		if spec.GetClockStatus() == nil {
+			return 0, false
+		}
+
+		return spec.GetClockStatus().GetDrift().AsDuration().Seconds(), true
+	case management.GetMachineStatusTimeSeriesRequest_UNKNOWN:
+	}
+
+	return 0, false
+}
+
+// defaultClockDriftThreshold is the clock drift which is likely to break the certificate validation and etcd.
+const defaultClockDriftThreshold = 5 * time.Second
+
+// GetClockDrift returns the difference between the last time reported by the machine and the Omni time.
+func (s *managementServer) GetClockDrift(ctx context.Context, req *management.GetClockDriftRequest) (*management.GetClockDriftResponse, error) {
+	// the clock is reported in the machine status, so the machine status read access is checked below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	threshold := defaultClockDriftThreshold
+
+	if req.GetThreshold() != nil {
+		threshold = req.GetThreshold().AsDuration()
+
+		if threshold <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "threshold must be positive")
+		}
+	}
+
+	machineStatus, err := s.getReadableMachineStatus(ctx, req.GetMachineId())
+	if err != nil {
+		return nil, err
+	}
+
+	clockStatus := machineStatus.TypedSpec().Value.GetClockStatus()
+	if clockStatus == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q didn't report its time yet", req.GetMachineId())
+	}
+
+	drift := clockStatus.GetDrift().AsDuration()
+
+	return &management.GetClockDriftResponse{
+		Drift:             clockStatus.GetDrift(),
+		ThresholdExceeded: drift.Abs() > threshold,
+	}, nil
+}
+
+// GetMachineEvents returns the recent machine status events, the newest first.
+func (s *managementServer) GetMachineEvents(ctx context.Context, req *management.GetMachineEventsRequest) (*management.GetMachineEventsResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// the events are recorded in the machine status snapshot readable by the readers
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	snapshot, err := safe.StateGet[*omnires.MachineStatusSnapshot](ctx, s.omniState, omnires.NewMachineStatusSnapshot(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return &management.GetMachineEventsResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	recentEvents := snapshot.TypedSpec().Value.GetRecentEvents()
+	response := &management.GetMachineEventsResponse{}
+
+	for i := len(recentEvents) - 1; i >= 0; i-- {
+		if req.GetLimit() > 0 && len(response.Events) == int(req.GetLimit()) {
+			break
+		}
+
+		event := recentEvents[i]
+
+		responseEvent := &management.GetMachineEventsResponse_Event{
+			ReceivedAt: event.GetReceivedAt(),
+			Stage:      event.GetMachineStatus().GetStage().String(),
+			Ready:      event.GetMachineStatus().GetStatus().GetReady(),
+		}
+
+		for _, condition := range event.GetMachineStatus().GetStatus().GetUnmetConditions() {
+			responseEvent.UnmetConditions = append(responseEvent.UnmetConditions, fmt.Sprintf("%s: %s", condition.GetName(), condition.GetReason()))
+		}
+
+		response.Events = append(response.Events, responseEvent)
+	}
+
+	return response, nil
+}
@@ -6,16 +6,19 @@
 package grpc
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -23,6 +26,7 @@ import (
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-api-signature/pkg/pgp"
 	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
 	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
@@ -42,11 +46,13 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
 	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	ctlcfg "github.com/siderolabs/omni/client/pkg/omnictl/config"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/backend/oidc/external"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
@@ -56,6 +62,9 @@ import (
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/configlint"
+	"github.com/siderolabs/omni/internal/pkg/configschema"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
 )
 
@@ -84,6 +93,9 @@ func (s *managementServer) gateway(ctx context.Context, mux *gateway.ServeMux, a
 	return management.RegisterManagementServiceHandlerFromEndpoint(ctx, mux, address, opts)
 }
 
+// minOIDCKubeconfigTTL is the shortest lifetime of the time-limited OIDC kubeconfig.
+const minOIDCKubeconfigTTL = time.Minute
+
 func (s *managementServer) Kubeconfig(ctx context.Context, req *management.KubeconfigRequest) (*management.KubeconfigResponse, error) {
 	commonContext := router.ExtractContext(ctx)
 
@@ -98,18 +110,34 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 	}
 
 	if req.GetServiceAccount() {
+		if req.GetTtl() != nil {
+			return nil, status.Error(codes.InvalidArgument, "ttl is not supported for the service account kubeconfig, use service account ttl instead")
+		}
+
 		return s.serviceAccountKubeconfig(ctx, req)
 	}
 
 	// not a service account, generate OIDC (user) kubeconfig
 
-	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+	var expiresAt time.Time
+
+	if req.GetTtl() != nil {
+		ttl := req.GetTtl().AsDuration()
+
+		if ttl < minOIDCKubeconfigTTL || ttl > external.OIDCTokenLifetime {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl should be between %s and %s", minOIDCKubeconfigTTL, external.OIDCTokenLifetime)
+		}
+
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	authResult, err := s.authCheckMethod(ctx, role.Reader)
 	if err != nil {
 		return nil, err
 	}
 
 	type oidcRuntime interface {
-		GetOIDCKubeconfig(context *commonOmni.Context, identity string) ([]byte, error)
+		GetOIDCKubeconfig(context *commonOmni.Context, identity string, expiresAt time.Time) ([]byte, error)
 	}
 
 	r, err := runtime.LookupInterface[oidcRuntime](kubernetes.Name)
@@ -117,7 +145,7 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 		return nil, err
 	}
 
-	kubeconfig, err := r.GetOIDCKubeconfig(commonContext, authResult.Identity)
+	kubeconfig, err := r.GetOIDCKubeconfig(commonContext, authResult.Identity, expiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +158,7 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 func (s *managementServer) Talosconfig(ctx context.Context, request *management.TalosconfigRequest) (*management.TalosconfigResponse, error) {
 	// getting talosconfig is low risk, as it doesn't contain any sensitive data
 	// real check for authentication happens in the Talos API gRPC proxy
-	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+	authResult, err := s.authCheckMethod(ctx, role.Reader)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +189,7 @@ func (s *managementServer) Talosconfig(ctx context.Context, request *management.
 
 func (s *managementServer) Omniconfig(ctx context.Context, _ *emptypb.Empty) (*management.OmniconfigResponse, error) {
 	// getting omniconfig is low risk, since it only contains parameters already known by the user
-	authResult, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true))
+	authResult, err := s.authCheckMethod(ctx, role.None)
 	if err != nil {
 		return nil, err
 	}
@@ -176,9 +204,42 @@ func (s *managementServer) Omniconfig(ctx context.Context, _ *emptypb.Empty) (*m
 	}, nil
 }
 
+// OmniconfigFor generates the omniconfig for the given identity, it is used by the admins to onboard the users.
+func (s *managementServer) OmniconfigFor(ctx context.Context, req *management.OmniconfigForRequest) (*management.OmniconfigResponse, error) {
+	authResult, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := strings.ToLower(req.GetIdentity())
+	if identity == "" {
+		return nil, status.Error(codes.InvalidArgument, "identity is required")
+	}
+
+	if _, err = safe.StateGet[*authres.Identity](actor.MarkContextAsInternalActor(ctx), s.omniState, authres.NewIdentity(resources.DefaultNamespace, identity).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "identity %q not found", identity)
+		}
+
+		return nil, err
+	}
+
+	s.logger.Info("generating omniconfig for identity", zap.String("identity", identity), zap.String("requested_by", authResult.Identity))
+
+	// the omniconfig contains only the identity and the URL, so no secrets are exposed
+	cfg, err := generateConfig(auth.CheckResult{Identity: identity}, s.omniconfigDest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.OmniconfigResponse{
+		Omniconfig: cfg,
+	}, nil
+}
+
 func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, response management.ManagementService_MachineLogsServer) error {
 	// getting machine logs is equivalent to reading machine resource
-	if _, err := auth.CheckGRPC(response.Context(), auth.WithRole(role.Reader)); err != nil {
+	if _, err := s.authCheckMethod(response.Context(), role.Reader); err != nil {
 		return err
 	}
 
@@ -219,16 +280,27 @@ func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, r
 		}
 
 		if err := response.Send(&common.Data{
-			Bytes: line,
+			Bytes: truncateLogLine(line, config.Config.MachineLogsMaxLineSize),
 		}); err != nil {
 			return err
 		}
 	}
 }
 
-func (s *managementServer) ValidateConfig(ctx context.Context, request *management.ValidateConfigRequest) (*emptypb.Empty, error) {
+// truncateLogLine cuts the line to the max size, so that a single huge line can't exceed the gRPC message size limits.
+func truncateLogLine(line []byte, maxSize int) []byte {
+	if maxSize <= 0 || len(line) <= maxSize {
+		return line
+	}
+
+	marker := fmt.Sprintf("... [truncated %d bytes]", len(line)-maxSize)
+
+	return append(line[:maxSize:maxSize], marker...)
+}
+
+func (s *managementServer) ValidateConfig(ctx context.Context, request *management.ValidateConfigRequest) (*management.ValidateConfigResponse, error) {
 	// validating machine config is low risk, require any valid signature
-	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
 		return nil, err
 	}
 
@@ -236,7 +308,53 @@ func (s *managementServer) ValidateConfig(ctx context.Context, request *manageme
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return &emptypb.Empty{}, nil
+	warnings, err := configlint.Default().Lint(request.Config, request.SuppressedLintRules...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &management.ValidateConfigResponse{
+		LintWarnings: xslices.Map(warnings, func(warning configlint.Warning) *management.ValidateConfigResponse_LintWarning {
+			return &management.ValidateConfigResponse_LintWarning{
+				RuleId:  warning.RuleID,
+				Message: warning.Message,
+			}
+		}),
+	}, nil
+}
+
+// GetConfigSchema returns the machine config JSON schema for the Talos version known to Omni.
+//
+// The schema is generated from the Talos machinery Omni is built with, as the config documents are backwards compatible.
+func (s *managementServer) GetConfigSchema(ctx context.Context, req *management.GetConfigSchemaRequest) (*management.GetConfigSchemaResponse, error) {
+	// the schema is public information, require any valid signature
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	talosVersion := strings.TrimLeft(req.GetTalosVersion(), "v")
+	if talosVersion == "" {
+		return nil, status.Error(codes.InvalidArgument, "talos version is required")
+	}
+
+	if _, err := safe.StateGet[*omnires.TalosVersion](ctx, s.omniState, omnires.NewTalosVersion(resources.DefaultNamespace, talosVersion).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown talos version %q", req.GetTalosVersion())
+		}
+
+		return nil, err
+	}
+
+	schema, err := configschema.MachineConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetConfigSchemaResponse{
+		Schema: string(schema),
+	}, nil
 }
 
 func (s *managementServer) adminTalosconfig(ctx context.Context) (*management.TalosconfigResponse, error) {
@@ -271,12 +389,62 @@ func (s *managementServer) adminTalosconfig(ctx context.Context) (*management.Ta
 	}, nil
 }
 
+// resolveServiceAccountRole returns the role of the created service account.
+//
+// The role is either the role of the caller, the explicitly requested one, or the configured default capped by the caller's role.
+// The requested role can't be above the role of the caller.
+func resolveServiceAccountRole(req *management.CreateServiceAccountRequest, callerRole role.Role) (role.Role, error) {
+	if req.GetUseUserRole() {
+		return callerRole, nil
+	}
+
+	if req.GetRole() == "" {
+		return role.Min(role.Role(config.Config.Auth.ServiceAccountDefaultRole), callerRole)
+	}
+
+	requestedRole, err := role.Parse(req.GetRole())
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = callerRole.Check(requestedRole); err != nil {
+		return "", status.Errorf(
+			codes.PermissionDenied,
+			"not enough permissions to create service account with role %q: %s",
+			req.GetRole(),
+			err.Error(),
+		)
+	}
+
+	return requestedRole, nil
+}
+
 func (s *managementServer) CreateServiceAccount(ctx context.Context, req *management.CreateServiceAccountRequest) (*management.CreateServiceAccountResponse, error) {
-	authCheckResult, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin))
+	// operators are allowed to create service accounts with the roles up to their own,
+	// so only the admins can grant the roles above the operator
+	authCheckResult, err := s.authCheckMethod(ctx, role.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccountRole, err := resolveServiceAccountRole(req, authCheckResult.Role)
 	if err != nil {
 		return nil, err
 	}
 
+	// the users limited to a single cluster can create the service accounts only for the same cluster
+	clusterScope := req.GetClusterScope()
+
+	if userClusterScope, ok := ctx.Value(auth.ClusterScopeContextKey{}).(string); ok {
+		if clusterScope == "" {
+			clusterScope = userClusterScope
+		}
+
+		if err = auth.CheckClusterScope(ctx, clusterScope); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	key, err := validatePGPPublicKey(
@@ -303,71 +471,608 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 	publicKeyResource := authres.NewPublicKey(resources.DefaultNamespace, key.id)
 	publicKeyResource.Metadata().Labels().Set(authres.LabelPublicKeyUserID, newUserID)
 
-	publicKeyResource.TypedSpec().Value.PublicKey = key.data
-	publicKeyResource.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
-	publicKeyResource.TypedSpec().Value.Role = req.Role
+	publicKeyResource.TypedSpec().Value.PublicKey = key.data
+	publicKeyResource.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
+	publicKeyResource.TypedSpec().Value.Role = string(serviceAccountRole)
+
+	// register the public key of the service account as "confirmed" because we are already authenticated
+	publicKeyResource.TypedSpec().Value.Confirmed = true
+
+	publicKeyResource.TypedSpec().Value.Identity = &specs.Identity{
+		Email: email,
+	}
+
+	err = s.omniState.Create(ctx, publicKeyResource)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the user resource representing the service account with the same scopes as the public key
+	user := authres.NewUser(resources.DefaultNamespace, newUserID)
+	user.TypedSpec().Value.Role = publicKeyResource.TypedSpec().Value.GetRole()
+
+	if clusterScope != "" {
+		user.Metadata().Labels().Set(authres.LabelClusterScope, clusterScope)
+	}
+
+	err = s.omniState.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the identity resource representing the service account
+	identity := authres.NewIdentity(resources.DefaultNamespace, email)
+	identity.TypedSpec().Value.UserId = user.Metadata().ID()
+	identity.Metadata().Labels().Set(authres.LabelIdentityUserID, newUserID)
+	identity.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
+
+	if clusterScope != "" {
+		identity.Metadata().Labels().Set(authres.LabelClusterScope, clusterScope)
+	}
+
+	err = s.omniState.Create(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.CreateServiceAccountResponse{PublicKeyId: key.id}, nil
+}
+
+// ValidateServiceAccountKey validates the PGP public key the same way CreateServiceAccount does, without creating any resources.
+func (s *managementServer) ValidateServiceAccountKey(ctx context.Context, req *management.ValidateServiceAccountKeyRequest) (*management.ValidateServiceAccountKeyResponse, error) {
+	// validating a key is low risk, require any valid signature
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	key, err := validatePGPPublicKey(
+		[]byte(req.GetArmoredPgpPublicKey()),
+		pgp.WithMaxAllowedLifetime(auth.ServiceAccountMaxAllowedLifetime),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid PGP public key: %s", err)
+	}
+
+	return &management.ValidateServiceAccountKeyResponse{
+		Username:    key.username,
+		PublicKeyId: key.id,
+		Expiration:  timestamppb.New(key.expiration),
+	}, nil
+}
+
+// TestServiceAccount verifies the proof signed with the service account private key against the stored public keys of the service account.
+//
+// The proof is the detached signature of the service account name, it confirms that the key pair works before the service account is used.
+func (s *managementServer) TestServiceAccount(ctx context.Context, req *management.TestServiceAccountRequest) (*management.TestServiceAccountResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetSignedProof()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "signed proof is required")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, req.GetName()+pkgaccess.ServiceAccountNameSuffix).Metadata())
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.GetName())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isServiceAccount := identity.Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount); !isServiceAccount {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.GetName())
+	}
+
+	keys, err := safe.StateListAll[*authres.PublicKey](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifyErr error
+
+	for iter := keys.Iterator(); iter.Next(); {
+		key := iter.Value()
+
+		if err = verifyServiceAccountProof(key, []byte(req.GetName()), req.GetSignedProof()); err != nil {
+			verifyErr = multierror.Append(verifyErr, fmt.Errorf("public key %q: %w", key.Metadata().ID(), err))
+
+			continue
+		}
+
+		return &management.TestServiceAccountResponse{
+			PublicKeyId: key.Metadata().ID(),
+			Expiration:  key.TypedSpec().Value.GetExpiration(),
+			Role:        key.TypedSpec().Value.GetRole(),
+		}, nil
+	}
+
+	if verifyErr == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "service account %q has no public keys", req.GetName())
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "the proof doesn't match any public key of the service account %q: %s", req.GetName(), verifyErr)
+}
+
+func verifyServiceAccountProof(key *authres.PublicKey, data, signature []byte) error {
+	if !key.TypedSpec().Value.GetConfirmed() {
+		return errors.New("public key is not confirmed")
+	}
+
+	pgpKey, err := pgpcrypto.NewKeyFromArmored(string(key.TypedSpec().Value.GetPublicKey()))
+	if err != nil {
+		return err
+	}
+
+	verifier, err := pgp.NewKey(pgpKey)
+	if err != nil {
+		return err
+	}
+
+	if err = verifier.Validate(); err != nil {
+		return err
+	}
+
+	return verifier.Verify(data, signature)
+}
+
+// RenewServiceAccount registers a new public key to the service account, effectively renewing it.
+//
+// If the grace period is set, the old keys are retired: they stay valid until the end of the grace period
+// or until they expire, whichever is later, then they are removed by the key pruner.
+func (s *managementServer) RenewServiceAccount(ctx context.Context, req *management.RenewServiceAccountRequest) (*management.RenewServiceAccountResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetGracePeriod() != nil && req.GetGracePeriod().AsDuration() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "grace period must be positive")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	name := req.Name + pkgaccess.ServiceAccountNameSuffix
+
+	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, name).Metadata())
+	if err != nil {
+		return nil, err
+	}
+
+	// list the old keys before adding the new one
+	oldKeys, err := safe.StateListAll[*authres.PublicKey](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := s.addServiceAccountKey(ctx, identity, req.GetArmoredPgpPublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.RenewServiceAccountResponse{PublicKeyId: keyID}
+
+	if req.GetGracePeriod() == nil {
+		return response, nil
+	}
+
+	now := time.Now()
+	gracePeriodEnd := now.Add(req.GetGracePeriod().AsDuration())
+
+	for iter := oldKeys.Iterator(); iter.Next(); {
+		oldKey := iter.Value()
+
+		validUntil := authres.PublicKeyValidUntil(oldKey.TypedSpec().Value)
+
+		// the expired keys are already removed by the key pruner
+		if oldKey.Metadata().ID() == keyID || !validUntil.After(now) {
+			continue
+		}
+
+		// the key is never retired before it expires, the keys close to the expiration are kept valid through the grace period
+		retireAt := validUntil
+		if gracePeriodEnd.After(retireAt) {
+			retireAt = gracePeriodEnd
+		}
+
+		if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, oldKey.Metadata(), func(res *authres.PublicKey) error {
+			res.TypedSpec().Value.RetireAt = timestamppb.New(retireAt)
+
+			return nil
+		}, state.WithUpdateOwner(oldKey.Metadata().Owner())); err != nil {
+			return nil, err
+		}
+
+		response.RetiredKeys = append(response.RetiredKeys, &management.RenewServiceAccountResponse_RetiredKey{
+			PublicKeyId: oldKey.Metadata().ID(),
+			RetireAt:    timestamppb.New(retireAt),
+		})
+	}
+
+	return response, nil
+}
+
+// RotateServiceAccount replaces all public keys of the service account with the new one.
+//
+// The new key is created before any of the old keys are removed, so the service account always has at least one valid key.
+func (s *managementServer) RotateServiceAccount(ctx context.Context, req *management.RotateServiceAccountRequest) (*management.RotateServiceAccountResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	name := req.Name + pkgaccess.ServiceAccountNameSuffix
+
+	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, name).Metadata())
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isServiceAccount := identity.Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount); !isServiceAccount {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.Name)
+	}
+
+	// list the old keys before adding the new one
+	oldKeys, err := safe.StateListAll[*authres.PublicKey](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := s.addServiceAccountKey(ctx, identity, req.GetArmoredPgpPublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.RotateServiceAccountResponse{
+		PublicKeyId: keyID,
+	}
+
+	var revokeErr error
+
+	for iter := oldKeys.Iterator(); iter.Next(); {
+		oldKey := iter.Value()
+
+		if oldKey.Metadata().ID() == keyID {
+			continue
+		}
+
+		if err = s.omniState.Destroy(ctx, oldKey.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			revokeErr = multierror.Append(revokeErr, err)
+
+			response.FailedPublicKeyIds = append(response.FailedPublicKeyIds, oldKey.Metadata().ID())
+
+			continue
+		}
+
+		response.RevokedPublicKeyIds = append(response.RevokedPublicKeyIds, oldKey.Metadata().ID())
+	}
+
+	if revokeErr != nil {
+		// attach the response to the error, so that the caller still gets the new key ID
+		st, err := status.New(codes.Internal, revokeErr.Error()).WithDetails(response)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, st.Err()
+	}
+
+	return response, nil
+}
+
+// addServiceAccountKey validates the PGP public key and registers it to the service account identity.
+func (s *managementServer) addServiceAccountKey(ctx context.Context, identity *authres.Identity, armoredPGPPublicKey string) (string, error) {
+	user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+	if err != nil {
+		return "", err
+	}
+
+	key, err := validatePGPPublicKey(
+		[]byte(armoredPGPPublicKey),
+		pgp.WithMaxAllowedLifetime(auth.ServiceAccountMaxAllowedLifetime),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	publicKeyResource := authres.NewPublicKey(resources.DefaultNamespace, key.id)
+	publicKeyResource.Metadata().Labels().Set(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)
+
+	publicKeyResource.TypedSpec().Value.PublicKey = key.data
+	publicKeyResource.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
+	publicKeyResource.TypedSpec().Value.Role = user.TypedSpec().Value.GetRole()
+
+	publicKeyResource.TypedSpec().Value.Confirmed = true
+
+	publicKeyResource.TypedSpec().Value.Identity = &specs.Identity{
+		Email: identity.Metadata().ID(),
+	}
+
+	err = s.omniState.Create(ctx, publicKeyResource)
+	if err != nil {
+		return "", err
+	}
+
+	return key.id, nil
+}
+
+func (s *managementServer) ListServiceAccounts(ctx context.Context, req *management.ListServiceAccountsRequest) (*management.ListServiceAccountsResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	labelQuery := []resource.LabelQueryOption{
+		resource.LabelExists(authres.LabelIdentityTypeServiceAccount),
+	}
+
+	if !req.GetIncludeInternal() {
+		labelQuery = append(labelQuery, resource.LabelExists(authres.LabelIdentityInternal, resource.NotMatches))
+	}
+
+	identityList, err := safe.StateListAll[*authres.Identity](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(labelQuery...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccounts := make([]*management.ListServiceAccountsResponse_ServiceAccount, 0, identityList.Len())
+	now := time.Now()
+
+	for iter := identityList.Iterator(); iter.Next(); {
+		identity := iter.Value()
+
+		user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeyList, err := safe.StateListAll[*authres.PublicKey](
+			ctx,
+			s.omniState,
+			state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, user.Metadata().ID())),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeys := make([]*management.ListServiceAccountsResponse_ServiceAccount_PgpPublicKey, 0, publicKeyList.Len())
+		hasActiveKeys := false
+
+		for keyIter := publicKeyList.Iterator(); keyIter.Next(); {
+			key := keyIter.Value()
+
+			expired := !authres.PublicKeyValidUntil(key.TypedSpec().Value).After(now)
+			if !expired {
+				hasActiveKeys = true
+			}
+
+			// the expired keys are flagged, but not dropped, so that the accounts with the mixed keys can be cleaned up
+			publicKeys = append(publicKeys, &management.ListServiceAccountsResponse_ServiceAccount_PgpPublicKey{
+				Id:         key.Metadata().ID(),
+				Armored:    string(key.TypedSpec().Value.GetPublicKey()),
+				Expiration: key.TypedSpec().Value.GetExpiration(),
+				Expired:    expired,
+			})
+		}
+
+		if req.GetExcludeExpired() && !hasActiveKeys {
+			continue
+		}
+
+		name := strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix)
+
+		serviceAccounts = append(serviceAccounts, &management.ListServiceAccountsResponse_ServiceAccount{
+			Name:          name,
+			PgpPublicKeys: publicKeys,
+			Role:          user.TypedSpec().Value.GetRole(),
+		})
+	}
+
+	return &management.ListServiceAccountsResponse{
+		ServiceAccounts: serviceAccounts,
+	}, nil
+}
+
+// ListAllServiceAccountKeys returns the public keys of all service accounts as a flat list.
+func (s *managementServer) ListAllServiceAccountKeys(ctx context.Context, req *management.ListAllServiceAccountKeysRequest) (*management.ListAllServiceAccountKeysResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identityList, err := safe.StateListAll[*authres.Identity](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(resource.LabelExists(authres.LabelIdentityTypeServiceAccount)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccounts := make(map[string]*authres.Identity, identityList.Len())
+
+	for iter := identityList.Iterator(); iter.Next(); {
+		serviceAccounts[iter.Value().TypedSpec().Value.UserId] = iter.Value()
+	}
+
+	publicKeyList, err := safe.StateListAll[*authres.PublicKey](ctx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*management.ListAllServiceAccountKeysResponse_Key
+
+	for iter := publicKeyList.Iterator(); iter.Next(); {
+		key := iter.Value()
+
+		userID, _ := key.Metadata().Labels().Get(authres.LabelPublicKeyUserID)
+
+		identity, ok := serviceAccounts[userID]
+		if !ok {
+			continue
+		}
+
+		_, internal := identity.Metadata().Labels().Get(authres.LabelIdentityInternal)
+
+		keys = append(keys, &management.ListAllServiceAccountKeysResponse_Key{
+			ServiceAccount: strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix),
+			Id:             key.Metadata().ID(),
+			Role:           key.TypedSpec().Value.GetRole(),
+			Expiration:     key.TypedSpec().Value.GetExpiration(),
+			Confirmed:      key.TypedSpec().Value.GetConfirmed(),
+			Internal:       internal,
+		})
+	}
+
+	slices.SortFunc(keys, func(a, b *management.ListAllServiceAccountKeysResponse_Key) int {
+		return cmp.Or(cmp.Compare(a.ServiceAccount, b.ServiceAccount), cmp.Compare(a.Id, b.Id))
+	})
+
+	page, err := paginate(keys, req.GetOffset(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.ListAllServiceAccountKeysResponse{
+		Keys:  page,
+		Total: int32(len(keys)),
+	}, nil
+}
+
+// GetServiceAccountKey returns a single public key of the service account.
+func (s *managementServer) GetServiceAccountKey(ctx context.Context, req *management.GetServiceAccountKeyRequest) (*management.GetServiceAccountKeyResponse, error) {
+	_, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetKeyId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key id is required")
+	}
+
+	key, err := s.getServiceAccountKey(actor.MarkContextAsInternalActor(ctx), req.GetName(), req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetServiceAccountKeyResponse{
+		Id:         key.Metadata().ID(),
+		Armored:    string(key.TypedSpec().Value.GetPublicKey()),
+		Expiration: key.TypedSpec().Value.GetExpiration(),
+		Role:       key.TypedSpec().Value.GetRole(),
+	}, nil
+}
+
+// ConfirmServiceAccountKey marks the public key of the service account as confirmed.
+//
+// The keys created by Omni are always confirmed, it allows to recover the service account which ended up with the unconfirmed key.
+func (s *managementServer) ConfirmServiceAccountKey(ctx context.Context, req *management.ConfirmServiceAccountKeyRequest) (*emptypb.Empty, error) {
+	authCheckResult, err := s.authCheckMethod(ctx, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetKeyId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key id is required")
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	key, err := s.getServiceAccountKey(ctx, req.GetName(), req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.TypedSpec().Value.GetConfirmed() {
+		return &emptypb.Empty{}, nil
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, key.Metadata(), func(res *authres.PublicKey) error {
+		res.TypedSpec().Value.Confirmed = true
 
-	// register the public key of the service account as "confirmed" because we are already authenticated
-	publicKeyResource.TypedSpec().Value.Confirmed = true
+		return nil
+	}); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "public key %q not found", req.GetKeyId())
+		}
 
-	publicKeyResource.TypedSpec().Value.Identity = &specs.Identity{
-		Email: email,
+		return nil, err
 	}
 
-	if req.GetUseUserRole() {
-		publicKeyResource.TypedSpec().Value.Role = string(authCheckResult.Role)
-	} else {
-		var reqRole role.Role
+	s.logger.Info("confirmed service account public key",
+		zap.String("service_account", req.GetName()),
+		zap.String("key_id", req.GetKeyId()),
+		zap.String("identity", authCheckResult.Identity),
+	)
 
-		reqRole, err = role.Parse(req.GetRole())
-		if err != nil {
-			return nil, err
-		}
+	return &emptypb.Empty{}, nil
+}
 
-		err = authCheckResult.Role.Check(reqRole)
-		if err != nil {
-			return nil, status.Errorf(
-				codes.PermissionDenied,
-				"not enough permissions to create service account with role %q: %s",
-				req.GetRole(),
-				err.Error(),
-			)
-		}
+// getServiceAccountKey returns the public key of the service account, it returns NotFound if the key belongs to another user.
+func (s *managementServer) getServiceAccountKey(ctx context.Context, serviceAccountName, keyID string) (*authres.PublicKey, error) {
+	name := serviceAccountName + pkgaccess.ServiceAccountNameSuffix
 
-		publicKeyResource.TypedSpec().Value.Role = req.GetRole()
+	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, name).Metadata())
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", serviceAccountName)
 	}
 
-	err = s.omniState.Create(ctx, publicKeyResource)
 	if err != nil {
 		return nil, err
 	}
 
-	// create the user resource representing the service account with the same scopes as the public key
-	user := authres.NewUser(resources.DefaultNamespace, newUserID)
-	user.TypedSpec().Value.Role = publicKeyResource.TypedSpec().Value.GetRole()
-
-	err = s.omniState.Create(ctx, user)
-	if err != nil {
-		return nil, err
+	if _, isServiceAccount := identity.Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount); !isServiceAccount {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", serviceAccountName)
 	}
 
-	// create the identity resource representing the service account
-	identity := authres.NewIdentity(resources.DefaultNamespace, email)
-	identity.TypedSpec().Value.UserId = user.Metadata().ID()
-	identity.Metadata().Labels().Set(authres.LabelIdentityUserID, newUserID)
-	identity.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
+	key, err := safe.StateGet[*authres.PublicKey](ctx, s.omniState, authres.NewPublicKey(resources.DefaultNamespace, keyID).Metadata())
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "public key %q not found", keyID)
+	}
 
-	err = s.omniState.Create(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	return &management.CreateServiceAccountResponse{PublicKeyId: key.id}, nil
+	if userID, _ := key.Metadata().Labels().Get(authres.LabelPublicKeyUserID); userID != identity.TypedSpec().Value.UserId {
+		return nil, status.Errorf(codes.NotFound, "public key %q doesn't belong to the service account %q", keyID, serviceAccountName)
+	}
+
+	return key, nil
 }
 
-// RenewServiceAccount registers a new public key to the service account, effectively renewing it.
-func (s *managementServer) RenewServiceAccount(ctx context.Context, req *management.RenewServiceAccountRequest) (*management.RenewServiceAccountResponse, error) {
-	_, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin))
+func (s *managementServer) DestroyServiceAccount(ctx context.Context, req *management.DestroyServiceAccountRequest) (*management.DestroyServiceAccountResponse, error) {
+	authCheckResult, err := s.authCheckMethod(ctx, role.Admin)
 	if err != nil {
 		return nil, err
 	}
@@ -377,130 +1082,198 @@ func (s *managementServer) RenewServiceAccount(ctx context.Context, req *managem
 	name := req.Name + pkgaccess.ServiceAccountNameSuffix
 
 	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, name).Metadata())
-	if err != nil {
-		return nil, err
+	if state.IsNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", name)
 	}
 
-	user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
 	if err != nil {
 		return nil, err
 	}
 
-	key, err := validatePGPPublicKey(
-		[]byte(req.GetArmoredPgpPublicKey()),
-		pgp.WithMaxAllowedLifetime(auth.ServiceAccountMaxAllowedLifetime),
-	)
+	_, isServiceAccount := identity.Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount)
+	if !isServiceAccount {
+		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.Name)
+	}
+
+	toDestroy, err := s.serviceAccountResources(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	publicKeyResource := authres.NewPublicKey(resources.DefaultNamespace, key.id)
-	publicKeyResource.Metadata().Labels().Set(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)
-
-	publicKeyResource.TypedSpec().Value.PublicKey = key.data
-	publicKeyResource.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
-	publicKeyResource.TypedSpec().Value.Role = user.TypedSpec().Value.GetRole()
+	response := &management.DestroyServiceAccountResponse{}
 
-	publicKeyResource.TypedSpec().Value.Confirmed = true
+	if req.DryRun {
+		for _, ptr := range toDestroy {
+			response.Resources = append(response.Resources, destroyServiceAccountResource(ptr))
+		}
 
-	publicKeyResource.TypedSpec().Value.Identity = &specs.Identity{
-		Email: name,
+		return response, nil
 	}
 
-	err = s.omniState.Create(ctx, publicKeyResource)
-	if err != nil {
+	if err = s.requireApproval(ctx, config.ApprovalOperationDestroyServiceAccount, authCheckResult.Identity, req.Name); err != nil {
 		return nil, err
 	}
 
-	return &management.RenewServiceAccountResponse{PublicKeyId: key.id}, nil
+	if destroyErr := s.destroyServiceAccountResources(ctx, toDestroy, response); destroyErr != nil {
+		// attach the response to the error, so that the caller knows which resources were actually destroyed
+		st, err := status.New(codes.Internal, destroyErr.Error()).WithDetails(response)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, st.Err()
+	}
+
+	return response, nil
 }
 
-func (s *managementServer) ListServiceAccounts(ctx context.Context, _ *emptypb.Empty) (*management.ListServiceAccountsResponse, error) {
-	_, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin))
+// DestroyServiceAccounts destroys the service accounts matching the filter.
+//
+// The service accounts are destroyed one by one, so the failures are reported per service account.
+// The service accounts created by Omni for the internal use are never selected.
+func (s *managementServer) DestroyServiceAccounts(ctx context.Context, req *management.DestroyServiceAccountsRequest) (*management.DestroyServiceAccountsResponse, error) {
+	authCheckResult, err := s.authCheckMethod(ctx, role.Admin)
 	if err != nil {
 		return nil, err
 	}
 
+	filter := req.GetFilter()
+
+	if !filter.GetExpired() && filter.GetRole() == "" && filter.GetLabelSelector() == "" && len(filter.GetNames()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one filter is required")
+	}
+
+	if filter.GetRole() != "" {
+		if _, err := role.Parse(filter.GetRole()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role %q: %s", filter.GetRole(), err)
+		}
+	}
+
+	labelQuery := []resource.LabelQueryOption{
+		resource.LabelExists(authres.LabelIdentityTypeServiceAccount),
+		resource.LabelExists(authres.LabelIdentityInternal, resource.NotMatches),
+	}
+
+	if filter.GetLabelSelector() != "" {
+		query, err := labels.ParseQuery(filter.GetLabelSelector())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %s", filter.GetLabelSelector(), err)
+		}
+
+		labelQuery = append(labelQuery, resource.RawLabelQuery(*query))
+	}
+
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
-	identityList, err := safe.StateListAll[*authres.Identity](
-		ctx,
-		s.omniState,
-		state.WithLabelQuery(resource.LabelExists(authres.LabelIdentityTypeServiceAccount)),
-	)
+	identities, err := safe.StateListAll[*authres.Identity](ctx, s.omniState, state.WithLabelQuery(labelQuery...))
 	if err != nil {
 		return nil, err
 	}
 
-	serviceAccounts := make([]*management.ListServiceAccountsResponse_ServiceAccount, 0, identityList.Len())
+	response := &management.DestroyServiceAccountsResponse{}
 
-	for iter := identityList.Iterator(); iter.Next(); {
+	var destroyErr error
+
+	for iter := identities.Iterator(); iter.Next(); {
 		identity := iter.Value()
+		name := strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix)
 
-		user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+		if len(filter.GetNames()) > 0 && !slices.Contains(filter.GetNames(), name) {
+			continue
+		}
+
+		matches, err := s.serviceAccountMatches(ctx, identity, filter)
 		if err != nil {
 			return nil, err
 		}
 
-		publicKeyList, err := safe.StateListAll[*authres.PublicKey](
-			ctx,
-			s.omniState,
-			state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, user.Metadata().ID())),
-		)
+		if !matches {
+			continue
+		}
+
+		toDestroy, err := s.serviceAccountResources(ctx, identity)
 		if err != nil {
 			return nil, err
 		}
 
-		publicKeys := make([]*management.ListServiceAccountsResponse_ServiceAccount_PgpPublicKey, 0, publicKeyList.Len())
+		result := &management.DestroyServiceAccountsResponse_Result{
+			Name:   name,
+			Result: &management.DestroyServiceAccountResponse{},
+		}
 
-		for keyIter := publicKeyList.Iterator(); keyIter.Next(); {
-			key := keyIter.Value()
+		response.Results = append(response.Results, result)
 
-			publicKeys = append(publicKeys, &management.ListServiceAccountsResponse_ServiceAccount_PgpPublicKey{
-				Id:         key.Metadata().ID(),
-				Armored:    string(key.TypedSpec().Value.GetPublicKey()),
-				Expiration: key.TypedSpec().Value.GetExpiration(),
-			})
+		if req.GetDryRun() {
+			for _, ptr := range toDestroy {
+				result.Result.Resources = append(result.Result.Resources, destroyServiceAccountResource(ptr))
+			}
+
+			continue
 		}
 
-		name := strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix)
+		if err = s.requireApproval(ctx, config.ApprovalOperationDestroyServiceAccount, authCheckResult.Identity, name); err != nil {
+			destroyErr = multierror.Append(destroyErr, err)
+			result.Error = err.Error()
 
-		serviceAccounts = append(serviceAccounts, &management.ListServiceAccountsResponse_ServiceAccount{
-			Name:          name,
-			PgpPublicKeys: publicKeys,
-			Role:          user.TypedSpec().Value.GetRole(),
-		})
+			continue
+		}
+
+		if err = s.destroyServiceAccountResources(ctx, toDestroy, result.Result); err != nil {
+			destroyErr = multierror.Append(destroyErr, err)
+			result.Error = err.Error()
+		}
 	}
 
-	return &management.ListServiceAccountsResponse{
-		ServiceAccounts: serviceAccounts,
-	}, nil
-}
+	if destroyErr != nil {
+		// attach the response to the error, so that the caller knows which service accounts were actually destroyed
+		st, err := status.New(codes.Internal, destroyErr.Error()).WithDetails(response)
+		if err != nil {
+			return nil, err
+		}
 
-func (s *managementServer) DestroyServiceAccount(ctx context.Context, req *management.DestroyServiceAccountRequest) (*emptypb.Empty, error) {
-	_, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin))
-	if err != nil {
-		return nil, err
+		return nil, st.Err()
 	}
 
-	ctx = actor.MarkContextAsInternalActor(ctx)
+	return response, nil
+}
 
-	name := req.Name + pkgaccess.ServiceAccountNameSuffix
+// serviceAccountMatches checks the service account against the filters which are not expressed as the label query.
+func (s *managementServer) serviceAccountMatches(ctx context.Context, identity *authres.Identity, filter *management.DestroyServiceAccountsRequest_Filter) (bool, error) {
+	if filter.GetRole() != "" {
+		user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return false, err
+		}
 
-	identity, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, name).Metadata())
-	if state.IsNotFoundError(err) {
-		return nil, status.Errorf(codes.NotFound, "service account %q not found", name)
+		if user == nil || user.TypedSpec().Value.GetRole() != filter.GetRole() {
+			return false, nil
+		}
+	}
+
+	if !filter.GetExpired() {
+		return true, nil
 	}
 
+	publicKeys, err := safe.StateListAll[*authres.PublicKey](ctx, s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, identity.TypedSpec().Value.UserId)),
+	)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	_, isServiceAccount := identity.Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount)
-	if !isServiceAccount {
-		return nil, status.Errorf(codes.NotFound, "service account %q not found", req.Name)
+	now := time.Now()
+
+	for iter := publicKeys.Iterator(); iter.Next(); {
+		if authres.PublicKeyValidUntil(iter.Value().TypedSpec().Value).After(now) {
+			return false, nil
+		}
 	}
 
+	return true, nil
+}
+
+// serviceAccountResources returns the resources which make up the service account in the order they should be destroyed.
+func (s *managementServer) serviceAccountResources(ctx context.Context, identity *authres.Identity) ([]resource.Pointer, error) {
 	pubKeys, err := s.omniState.List(
 		ctx,
 		authres.NewPublicKey(resources.DefaultNamespace, "").Metadata(),
@@ -510,34 +1283,52 @@ func (s *managementServer) DestroyServiceAccount(ctx context.Context, req *manag
 		return nil, err
 	}
 
-	var destroyErr error
+	// the order matters: public keys first, then the identity, and the user last
+	toDestroy := make([]resource.Pointer, 0, len(pubKeys.Items)+2)
 
 	for _, pubKey := range pubKeys.Items {
-		err = s.omniState.Destroy(ctx, pubKey.Metadata())
-		if err != nil {
+		toDestroy = append(toDestroy, pubKey.Metadata())
+	}
+
+	return append(toDestroy,
+		identity.Metadata(),
+		authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata(),
+	), nil
+}
+
+// destroyServiceAccountResources destroys the resources recording the destroyed and failed ones in the response.
+//
+// It doesn't stop on the first failure, so that as much of the service account is cleaned up as possible.
+func (s *managementServer) destroyServiceAccountResources(ctx context.Context, toDestroy []resource.Pointer, response *management.DestroyServiceAccountResponse) error {
+	var destroyErr error
+
+	for _, ptr := range toDestroy {
+		if err := s.omniState.Destroy(ctx, ptr); err != nil {
 			destroyErr = multierror.Append(destroyErr, err)
+
+			response.Failures = append(response.Failures, &management.DestroyServiceAccountResponse_Failure{
+				Resource: destroyServiceAccountResource(ptr),
+				Error:    err.Error(),
+			})
+
+			continue
 		}
-	}
 
-	err = s.omniState.Destroy(ctx, identity.Metadata())
-	if err != nil {
-		destroyErr = multierror.Append(destroyErr, err)
+		response.Resources = append(response.Resources, destroyServiceAccountResource(ptr))
 	}
 
-	err = s.omniState.Destroy(ctx, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
-	if err != nil {
-		destroyErr = multierror.Append(destroyErr, err)
-	}
+	return destroyErr
+}
 
-	if destroyErr != nil {
-		return nil, destroyErr
+func destroyServiceAccountResource(ptr resource.Pointer) *management.DestroyServiceAccountResponse_Resource {
+	return &management.DestroyServiceAccountResponse_Resource{
+		Type: ptr.Type(),
+		Id:   ptr.ID(),
 	}
-
-	return &emptypb.Empty{}, nil
 }
 
 func (s *managementServer) KubernetesUpgradePreChecks(ctx context.Context, req *management.KubernetesUpgradePreChecksRequest) (*management.KubernetesUpgradePreChecksResponse, error) {
-	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
 		return nil, err
 	}
 
@@ -649,7 +1440,8 @@ func (s *managementServer) KubernetesUpgradePreChecks(ctx context.Context, req *
 func (s *managementServer) KubernetesSyncManifests(req *management.KubernetesSyncManifestRequest, srv management.ManagementService_KubernetesSyncManifestsServer) error {
 	ctx := srv.Context()
 
-	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+	authCheckResult, err := s.authCheckMethod(ctx, role.Operator)
+	if err != nil {
 		return err
 	}
 
@@ -660,6 +1452,23 @@ func (s *managementServer) KubernetesSyncManifests(req *management.KubernetesSyn
 		return status.Error(codes.InvalidArgument, "unable to extract request context")
 	}
 
+	if req.IncrementalOnly && req.RetryFailed {
+		return status.Error(codes.InvalidArgument, "incremental sync and retry of the failed sync can't be combined")
+	}
+
+	syncRecord := manifestSyncRecord{
+		identity: authCheckResult.Identity,
+		reason:   req.GetReason(),
+		dryRun:   req.GetDryRun(),
+	}
+
+	s.logger.Info("kubernetes manifest sync requested",
+		zap.String("cluster", requestContext.Name),
+		zap.String("identity", syncRecord.identity),
+		zap.String("reason", syncRecord.reason),
+		zap.Bool("dry_run", syncRecord.dryRun),
+	)
+
 	type kubernetesConfigurator interface {
 		GetKubeconfig(ctx context.Context, context *commonOmni.Context) (*rest.Config, error)
 	}
@@ -693,6 +1502,53 @@ func (s *managementServer) KubernetesSyncManifests(req *management.KubernetesSyn
 		return fmt.Errorf("failed to get manifests: %w", err)
 	}
 
+	hashes, err := manifestHashes(bootstrapManifests)
+	if err != nil {
+		return err
+	}
+
+	if req.IncrementalOnly || req.RetryFailed {
+		var appliedHashes map[string]string
+
+		if req.RetryFailed {
+			var lastSyncFailed bool
+
+			appliedHashes, lastSyncFailed, err = s.failedSyncManifestHashes(ctx, requestContext.Name)
+			if err != nil {
+				return fmt.Errorf("failed to get the last manifest sync status: %w", err)
+			}
+
+			if !lastSyncFailed {
+				return status.Error(codes.FailedPrecondition, "the last manifest sync didn't fail, nothing to retry")
+			}
+		} else {
+			appliedHashes, err = s.syncedManifestHashes(ctx, requestContext.Name)
+			if err != nil {
+				return fmt.Errorf("failed to get the last manifest sync status: %w", err)
+			}
+		}
+
+		var unchangedManifests []manifests.Manifest
+
+		bootstrapManifests, unchangedManifests = filterChangedManifests(bootstrapManifests, hashes, appliedHashes)
+
+		for _, unchanged := range unchangedManifests {
+			obj, err := yaml.Marshal(unchanged.Object)
+			if err != nil {
+				return fmt.Errorf("failed to marshal object: %w", err)
+			}
+
+			if err := srv.Send(&management.KubernetesSyncManifestResponse{
+				ResponseType: management.KubernetesSyncManifestResponse_MANIFEST,
+				Path:         manifestPath(unchanged),
+				Object:       obj,
+				Skipped:      true,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	errCh := make(chan error, 1)
 	synCh := make(chan manifests.SyncResult)
 
@@ -702,11 +1558,20 @@ func (s *managementServer) KubernetesSyncManifests(req *management.KubernetesSyn
 
 	var updatedManifests []manifests.Manifest
 
+	// the manifests applied before the failure are skipped by the retry
+	syncedHashes := map[string]string{}
+
 syncLoop:
 	for {
 		select {
 		case err := <-errCh:
 			if err != nil {
+				if !req.DryRun {
+					if storeErr := s.storeFailedManifestSync(ctx, requestContext.Name, syncedHashes); storeErr != nil {
+						s.logger.Error("failed to store the failed manifest sync status", zap.String("cluster", requestContext.Name), zap.Error(storeErr))
+					}
+				}
+
 				return fmt.Errorf("failed to sync manifests: %w", err)
 			}
 
@@ -730,12 +1595,18 @@ syncLoop:
 			if !result.Skipped {
 				updatedManifests = append(updatedManifests, result.Object)
 			}
+
+			syncedHashes[result.Path] = hashes[result.Path]
 		}
 	}
 
 	if req.DryRun {
 		// no rollout if dry run
-		return s.triggerManifestResync(ctx, requestContext)
+		return s.triggerManifestResync(ctx, requestContext, syncRecord)
+	}
+
+	if err = s.storeManifestHashes(ctx, requestContext.Name, hashes); err != nil {
+		return fmt.Errorf("failed to store the manifest sync status: %w", err)
 	}
 
 	rolloutCh := make(chan manifests.RolloutProgress)
@@ -769,10 +1640,29 @@ rolloutLoop:
 		}
 	}
 
-	return s.triggerManifestResync(ctx, requestContext)
+	return s.triggerManifestResync(ctx, requestContext, syncRecord)
+}
+
+// manifestSyncRecord describes who triggered the manifest sync and why.
+type manifestSyncRecord struct {
+	identity string
+	reason   string
+	dryRun   bool
+}
+
+// annotationPrefix keeps the dry-run and the real syncs recorded separately, so a dry run doesn't hide the last real sync.
+func (r manifestSyncRecord) annotationPrefix() string {
+	if r.dryRun {
+		return "manifest-sync-dry-run"
+	}
+
+	return "manifest-sync"
 }
 
-func (s *managementServer) triggerManifestResync(ctx context.Context, requestContext *commonOmni.Context) error {
+func (s *managementServer) triggerManifestResync(ctx context.Context, requestContext *commonOmni.Context, record manifestSyncRecord) error {
+	now := strconv.Itoa(int(time.Now().Unix()))
+	prefix := record.annotationPrefix()
+
 	// trigger fake update in KubernetesUpgradeStatusType to force re-calculating the status
 	// this is needed because the status is not updated when the rollout is finished
 	_, err := safe.StateUpdateWithConflicts(
@@ -780,7 +1670,15 @@ func (s *managementServer) triggerManifestResync(ctx context.Context, requestCon
 		s.omniState,
 		omnires.NewKubernetesUpgradeStatus(resources.DefaultNamespace, requestContext.Name).Metadata(),
 		func(res *omnires.KubernetesUpgradeStatus) error {
-			res.Metadata().Annotations().Set("manifest-rollout", strconv.Itoa(int(time.Now().Unix())))
+			res.Metadata().Annotations().Set("manifest-rollout", now)
+			res.Metadata().Annotations().Set(prefix+"-at", now)
+			res.Metadata().Annotations().Set(prefix+"-by", record.identity)
+
+			if record.reason != "" {
+				res.Metadata().Annotations().Set(prefix+"-reason", record.reason)
+			} else {
+				res.Metadata().Annotations().Delete(prefix + "-reason")
+			}
 
 			return nil
 		},
@@ -790,9 +1688,30 @@ func (s *managementServer) triggerManifestResync(ctx context.Context, requestCon
 		return fmt.Errorf("failed to update KubernetesUpgradeStatus: %w", err)
 	}
 
+	s.logger.Info("kubernetes manifest sync finished",
+		zap.String("cluster", requestContext.Name),
+		zap.String("identity", record.identity),
+		zap.String("reason", record.reason),
+		zap.Bool("dry_run", record.dryRun),
+	)
+
 	return nil
 }
 
+// authCheckMethod checks that the user is allowed to call the management method, role.None requires only a valid signature.
+//
+// The role configured in the method role overrides replaces the default role of the method.
+// The other checks done by the method, like the per-cluster role checks, always use their hard-coded roles.
+func (s *managementServer) authCheckMethod(ctx context.Context, defaultRole role.Role) (auth.CheckResult, error) {
+	requiredRole := defaultRole
+
+	if overrideRole, ok := methodRoleOverride(ctx); ok {
+		requiredRole = overrideRole
+	}
+
+	return s.authCheckGRPC(ctx, auth.WithValidSignature(true), auth.WithRole(requiredRole))
+}
+
 func (s *managementServer) authCheckGRPC(ctx context.Context, opts ...auth.CheckOption) (auth.CheckResult, error) {
 	authCheckResult, err := auth.Check(ctx, opts...)
 	if errors.Is(err, auth.ErrUnauthenticated) {
@@ -810,10 +1729,37 @@ func (s *managementServer) authCheckGRPC(ctx context.Context, opts ...auth.Check
 	return authCheckResult, nil
 }
 
+// methodRoleOverride returns the role configured to be required for the called method instead of the hard-coded one.
+func methodRoleOverride(ctx context.Context) (role.Role, bool) {
+	fullMethod, ok := grpc.Method(ctx)
+	if !ok {
+		return "", false
+	}
+
+	roleName, ok := config.Config.Auth.MethodRoleOverrides[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+	if !ok {
+		return "", false
+	}
+
+	// the overrides are validated on startup, so this never falls back
+	requiredRole, err := role.Parse(roleName)
+	if err != nil || requiredRole == role.None {
+		return "", false
+	}
+
+	return requiredRole, true
+}
+
 // applyClusterAccessPolicy checks the ACLs for the user in the context against the given cluster ID.
 // If there is a match and the matched role is higher than the user's role,
 // a child context containing the given role will be returned.
+//
+// The users limited to a single cluster are denied the access to all other clusters.
 func (s *managementServer) applyClusterAccessPolicy(ctx context.Context, clusterID resource.ID) (context.Context, error) {
+	if err := auth.CheckClusterScope(ctx, clusterID); err != nil {
+		return nil, err
+	}
+
 	clusterRole, _, err := accesspolicy.RoleForCluster(ctx, clusterID, s.omniState)
 	if err != nil {
 		return nil, err
@@ -836,6 +1782,21 @@ func (s *managementServer) applyClusterAccessPolicy(ctx context.Context, cluster
 	return context.WithValue(ctx, auth.RoleContextKey{}, newRole), nil
 }
 
+// paginate returns the page of the items, the zero limit returns all items starting from the offset.
+func paginate[T any](items []T, offset, limit int32) ([]T, error) {
+	if offset < 0 || limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+
+	items = items[min(int(offset), len(items)):]
+
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
+
 func handleError(err error) error {
 	switch {
 	case errors.Is(err, io.EOF):
@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-kubernetes/kubernetes/manifests"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// manifestPath returns the manifest identifier in the same format as the manifest sync results use.
+func manifestPath(obj manifests.Manifest) string {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+
+	gv := gvk.Version
+	if gvk.Group != "" {
+		gv = gvk.Group + "/" + gv
+	}
+
+	name := obj.GetName()
+
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+
+	return fmt.Sprintf("%s.%s/%s", gv, gvk.Kind, name)
+}
+
+// manifestHashes returns the content hashes of the manifests keyed by the manifest path.
+func manifestHashes(objs []manifests.Manifest) (map[string]string, error) {
+	hashes := make(map[string]string, len(objs))
+
+	for _, obj := range objs {
+		// JSON encoding sorts the map keys, so the hash is stable
+		data, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal manifest %q: %w", manifestPath(obj), err)
+		}
+
+		sum := sha256.Sum256(data)
+
+		hashes[manifestPath(obj)] = hex.EncodeToString(sum[:])
+	}
+
+	return hashes, nil
+}
+
+// filterChangedManifests splits the manifests into the ones which changed compared to the synced hashes, and the ones which didn't.
+func filterChangedManifests(objs []manifests.Manifest, hashes, syncedHashes map[string]string) (changed, unchanged []manifests.Manifest) {
+	for _, obj := range objs {
+		path := manifestPath(obj)
+
+		if synced, ok := syncedHashes[path]; ok && synced == hashes[path] {
+			unchanged = append(unchanged, obj)
+
+			continue
+		}
+
+		changed = append(changed, obj)
+	}
+
+	return changed, unchanged
+}
+
+// syncedManifestHashes returns the manifest hashes stored by the last successful manifest sync of the cluster.
+func (s *managementServer) syncedManifestHashes(ctx context.Context, clusterName string) (map[string]string, error) {
+	syncStatus, err := safe.StateGet[*omnires.KubernetesManifestSyncStatus](ctx, s.omniState,
+		omnires.NewKubernetesManifestSyncStatus(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return syncStatus.TypedSpec().Value.GetManifestHashes(), nil
+}
+
+// failedSyncManifestHashes returns the manifest hashes stored by the failed manifest syncs since the last successful sync of the cluster.
+//
+// The returned bool is false if the last manifest sync didn't fail.
+func (s *managementServer) failedSyncManifestHashes(ctx context.Context, clusterName string) (map[string]string, bool, error) {
+	syncStatus, err := safe.StateGet[*omnires.KubernetesManifestSyncStatus](ctx, s.omniState,
+		omnires.NewKubernetesManifestSyncStatus(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return syncStatus.TypedSpec().Value.GetFailedSyncManifestHashes(), syncStatus.TypedSpec().Value.GetLastSyncFailed(), nil
+}
+
+// storeManifestHashes records the manifest hashes of the successful manifest sync of the cluster.
+func (s *managementServer) storeManifestHashes(ctx context.Context, clusterName string, hashes map[string]string) error {
+	return createOrUpdate(ctx, s.omniState, omnires.NewKubernetesManifestSyncStatus(resources.DefaultNamespace, clusterName),
+		func(res *omnires.KubernetesManifestSyncStatus) error {
+			res.Metadata().Labels().Set(omnires.LabelCluster, clusterName)
+
+			res.TypedSpec().Value.ManifestHashes = hashes
+			res.TypedSpec().Value.LastSyncFailed = false
+			res.TypedSpec().Value.FailedSyncManifestHashes = nil
+
+			return nil
+		},
+	)
+}
+
+// storeFailedManifestSync records the manifest hashes of the manifests applied before the manifest sync of the cluster failed.
+//
+// The manifests applied by the earlier failed syncs are kept, so that the retries of the sync make progress.
+func (s *managementServer) storeFailedManifestSync(ctx context.Context, clusterName string, appliedHashes map[string]string) error {
+	return createOrUpdate(ctx, s.omniState, omnires.NewKubernetesManifestSyncStatus(resources.DefaultNamespace, clusterName),
+		func(res *omnires.KubernetesManifestSyncStatus) error {
+			res.Metadata().Labels().Set(omnires.LabelCluster, clusterName)
+
+			if res.TypedSpec().Value.FailedSyncManifestHashes == nil {
+				res.TypedSpec().Value.FailedSyncManifestHashes = make(map[string]string, len(appliedHashes))
+			}
+
+			res.TypedSpec().Value.LastSyncFailed = true
+			maps.Copy(res.TypedSpec().Value.FailedSyncManifestHashes, appliedHashes)
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const omniEndpointCheckTimeout = 10 * time.Second
+
+// ValidateOmniEndpoint checks that the URL can be used as the omniconfig destination.
+//
+// The connectivity check treats any HTTP response as success, as the endpoint is only required to respond.
+func (s *managementServer) ValidateOmniEndpoint(ctx context.Context, req *management.ValidateOmniEndpointRequest) (*management.ValidateOmniEndpointResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	// the connectivity check makes Omni send the requests to the arbitrary URLs
+	if _, err := s.authCheckMethod(ctx, role.Admin); err != nil {
+		return nil, err
+	}
+
+	dest, err := generateDest(req.GetUrl())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// generateDest accepts anything url.Parse does, but omnictl needs the absolute URL
+	parsedDest, err := url.Parse(dest)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "incorrect destination: %s", err)
+	}
+
+	if parsedDest.Scheme != "http" && parsedDest.Scheme != "https" {
+		return nil, status.Errorf(codes.InvalidArgument, "destination %q must use http or https scheme", dest)
+	}
+
+	if parsedDest.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "destination %q has no host", dest)
+	}
+
+	response := &management.ValidateOmniEndpointResponse{
+		Destination: dest,
+	}
+
+	if !req.GetCheckConnectivity() {
+		return response, nil
+	}
+
+	if err = checkOmniEndpoint(ctx, dest); err != nil {
+		response.Error = err.Error()
+
+		return response, nil
+	}
+
+	response.Reachable = true
+
+	return response, nil
+}
+
+func checkOmniEndpoint(ctx context.Context, dest string) error {
+	ctx, cancel := context.WithTimeout(ctx, omniEndpointCheckTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, dest, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetPendingMachineConfig reports if the config generated by Omni is not applied to the cluster machine yet.
+//
+// Omni only keeps the hash of the applied config, so the diff is built from the config running on the machine,
+// and it is available only while the machine is connected.
+func (s *managementServer) GetPendingMachineConfig(ctx context.Context, req *management.GetPendingMachineConfigRequest) (*management.GetPendingMachineConfigResponse, error) {
+	machineID := req.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState,
+		omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	clusterName := machineStatus.TypedSpec().Value.GetCluster()
+	if clusterName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is not part of a cluster", machineID)
+	}
+
+	if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+		return nil, err
+	}
+
+	// the configs are redacted, which is the same as reading the redacted cluster machine config
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineConfig, err := safe.StateGet[*omnires.ClusterMachineConfig](ctx, s.omniState, omnires.NewClusterMachineConfig(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "machine %q config is not generated yet", machineID)
+		}
+
+		return nil, err
+	}
+
+	response := &management.GetPendingMachineConfigResponse{
+		ConfigVersion: machineConfig.Metadata().Version().String(),
+	}
+
+	if generationError := machineConfig.TypedSpec().Value.GetGenerationError(); generationError != "" {
+		response.Pending = true
+		response.Reasons = append(response.Reasons, "config generation failed: "+generationError)
+
+		return response, nil
+	}
+
+	configStatus, err := safe.StateGet[*omnires.ClusterMachineConfigStatus](ctx, s.omniState, omnires.NewClusterMachineConfigStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	shaSum := sha256.Sum256(machineConfig.TypedSpec().Value.GetData())
+
+	if configStatus != nil {
+		response.AppliedConfigVersion = configStatus.TypedSpec().Value.GetClusterMachineConfigVersion()
+
+		if configStatus.TypedSpec().Value.GetClusterMachineConfigSha256() == hex.EncodeToString(shaSum[:]) {
+			return response, nil
+		}
+	}
+
+	response.Pending = true
+
+	switch {
+	case configStatus == nil || response.AppliedConfigVersion == "":
+		response.Reasons = append(response.Reasons, "no config was applied to the machine yet")
+	case configStatus.TypedSpec().Value.GetLastConfigError() != "":
+		response.Reasons = append(response.Reasons, "last config apply failed: "+configStatus.TypedSpec().Value.GetLastConfigError())
+	}
+
+	if !machineStatus.TypedSpec().Value.GetConnected() {
+		response.Reasons = append(response.Reasons, "machine is disconnected")
+
+		return response, nil
+	}
+
+	diff, err := s.diffMachineConfig(ctx, machineStatus, machineConfig)
+	if err != nil {
+		response.Reasons = append(response.Reasons, fmt.Sprintf("failed to compare with the machine config: %s", err))
+
+		return response, nil
+	}
+
+	response.Diff = diff
+
+	return response, nil
+}
+
+// diffMachineConfig returns the unified diff between the config running on the machine and the config generated by Omni.
+func (s *managementServer) diffMachineConfig(ctx context.Context, machineStatus *omnires.MachineStatus, machineConfig *omnires.ClusterMachineConfig) (string, error) {
+	intendedConfig, err := configloader.NewFromBytes(machineConfig.TypedSpec().Value.GetData())
+	if err != nil {
+		return "", err
+	}
+
+	intended, err := redactedMachineConfig(intendedConfig)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, talosClient, closeClient, err := s.getMachineTalosClient(ctx, machineStatus)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeClient()
+
+	res, err := talosClient.COSI.Get(ctx, resource.NewMetadata(configres.NamespaceName, configres.MachineConfigType, configres.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		return "", err
+	}
+
+	runningConfig, ok := res.(*configres.MachineConfig)
+	if !ok {
+		return "", fmt.Errorf("unexpected machine config resource type %T", res)
+	}
+
+	running, err := redactedMachineConfig(runningConfig.Provider())
+	if err != nil {
+		return "", err
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath("running"), running, intended)
+
+	return fmt.Sprint(gotextdiff.ToUnified("running", "intended", running, edits)), nil
+}
+
+func redactedMachineConfig(provider config.Provider) (string, error) {
+	data, err := provider.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
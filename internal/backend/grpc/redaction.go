@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// sensitiveDataRole is the minimum role which sees the hardware identifiers of the machines in the management responses.
+//
+// The serial numbers, the MAC addresses and the cloud instance and provider IDs allow tracking the assets,
+// so they are masked for the lower roles, while the rest of the machine status stays readable.
+const sensitiveDataRole = role.Operator
+
+// hardwareChangeIdentifierRe matches the disk identifiers in the hardware change descriptions built by the machine status controller.
+var hardwareChangeIdentifierRe = regexp.MustCompile(`, (serial|wwid) [^,]+`)
+
+// machineStatusRedactor returns the function which prepares the machine status to be returned by the management methods.
+//
+// Every management method returning the machine status, including its past versions, passes it through this function:
+// the hardware identifiers are masked if the user doesn't have sensitiveDataRole in the cluster of the machine.
+func (s *managementServer) machineStatusRedactor(ctx context.Context) func(spec *specs.MachineStatusSpec) (*specs.MachineStatusSpec, error) {
+	canReadSensitiveData := s.clusterRoleChecker(ctx, sensitiveDataRole)
+
+	return func(spec *specs.MachineStatusSpec) (*specs.MachineStatusSpec, error) {
+		allowed, err := canReadSensitiveData(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if allowed {
+			return spec, nil
+		}
+
+		return redactMachineStatus(spec), nil
+	}
+}
+
+// redactMachineStatus returns a copy of the machine status with the hardware identifiers masked.
+func redactMachineStatus(spec *specs.MachineStatusSpec) *specs.MachineStatusSpec {
+	spec = spec.CloneVT()
+
+	if hardware := spec.GetHardware(); hardware != nil {
+		for _, blockDevice := range hardware.GetBlockdevices() {
+			blockDevice.Serial = redact(blockDevice.Serial)
+			blockDevice.Uuid = redact(blockDevice.Uuid)
+			blockDevice.Wwid = redact(blockDevice.Wwid)
+		}
+
+		if firmware := hardware.GetFirmware(); firmware != nil {
+			firmware.SerialNumber = redact(firmware.SerialNumber)
+		}
+	}
+
+	for _, link := range spec.GetNetwork().GetNetworkLinks() {
+		link.HardwareAddress = redact(link.HardwareAddress)
+	}
+
+	if platformMetadata := spec.GetPlatformMetadata(); platformMetadata != nil {
+		platformMetadata.InstanceId = redact(platformMetadata.InstanceId)
+		platformMetadata.ProviderId = redact(platformMetadata.ProviderId)
+	}
+
+	if hardwareChange := spec.GetHardwareChange(); hardwareChange != nil {
+		hardwareChange.Changes = redactHardwareChanges(hardwareChange.Changes)
+	}
+
+	return spec
+}
+
+// redactHardwareChanges masks the disk identifiers in the hardware change descriptions.
+func redactHardwareChanges(changes []string) []string {
+	redacted := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		redacted = append(redacted, hardwareChangeIdentifierRe.ReplaceAllString(change, ", $1 "+x509.Redacted))
+	}
+
+	return redacted
+}
+
+// redact masks the value, the empty values are kept as is, so that the unknown values are still distinguishable.
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return x509.Redacted
+}
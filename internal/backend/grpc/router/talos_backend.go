@@ -92,6 +92,10 @@ func (l *TalosBackend) GetConnection(ctx context.Context, fullMethodName string)
 		return ctx, nil, err
 	}
 
+	if err = auth.CheckClusterScope(ctx, l.name); err != nil {
+		return ctx, nil, err
+	}
+
 	hasModifyAccess := false
 
 	_, modifyErr := auth.Check(ctx, auth.WithRole(role.Operator))
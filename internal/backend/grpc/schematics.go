@@ -6,25 +6,35 @@
 package grpc
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"net/url"
 	"slices"
 	"strings"
 
+	"github.com/blang/semver/v4"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/image-factory/pkg/client"
 	"github.com/siderolabs/image-factory/pkg/schematic"
+	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/meta"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
-	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
@@ -33,10 +43,150 @@ import (
 // CreateSchematic implements ManagementServer.
 func (s *managementServer) CreateSchematic(ctx context.Context, request *management.CreateSchematicRequest) (*management.CreateSchematicResponse, error) {
 	// creating a schematic is equivalent to creating a machine
-	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
 		return nil, err
 	}
 
+	kernelArgs, err := s.connectionKernelArgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schematic, err := newSchematic(kernelArgs, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSchematic(ctx, schematic, request, nil)
+}
+
+// CreateSchematicWithProgress implements ManagementServer.
+//
+// It works the same way as CreateSchematic, but reports the progress, as building a new schematic in the image factory might take a while.
+func (s *managementServer) CreateSchematicWithProgress(request *management.CreateSchematicRequest, srv management.ManagementService_CreateSchematicWithProgressServer) error {
+	ctx := srv.Context()
+
+	// creating a schematic is equivalent to creating a machine
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return err
+	}
+
+	sendStage := func(stage management.CreateSchematicProgress_Stage) error {
+		return srv.Send(&management.CreateSchematicProgress{
+			Stage: stage,
+		})
+	}
+
+	if err := sendStage(management.CreateSchematicProgress_VALIDATING); err != nil {
+		return err
+	}
+
+	kernelArgs, err := s.connectionKernelArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	schematic, err := newSchematic(kernelArgs, request)
+	if err != nil {
+		return err
+	}
+
+	// the stage is not reported if Omni already knows the schematic
+	response, err := s.createSchematic(ctx, schematic, request, func() error {
+		return sendStage(management.CreateSchematicProgress_CONTACTING_FACTORY)
+	})
+	if err != nil {
+		return err
+	}
+
+	return srv.Send(&management.CreateSchematicProgress{
+		Stage:     management.CreateSchematicProgress_DONE,
+		Schematic: response,
+	})
+}
+
+// CreateSchematics implements ManagementServer.
+//
+// All schematics are validated before any of them is created, the identical schematics are created once.
+func (s *managementServer) CreateSchematics(ctx context.Context, request *management.CreateSchematicsRequest) (*management.CreateSchematicsResponse, error) {
+	// creating a schematic is equivalent to creating a machine
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	if len(request.GetSchematics()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one schematic is required")
+	}
+
+	kernelArgs, err := s.connectionKernelArgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schematics := make([]schematic.Schematic, 0, len(request.GetSchematics()))
+
+	for i, schematicRequest := range request.GetSchematics() {
+		var sch schematic.Schematic
+
+		if sch, err = newSchematic(kernelArgs, schematicRequest); err != nil {
+			return nil, status.Errorf(status.Code(err), "schematic %d: %s", i, status.Convert(err).Message())
+		}
+
+		schematics = append(schematics, sch)
+	}
+
+	created := make(map[string]*management.CreateSchematicResponse, len(schematics))
+	response := &management.CreateSchematicsResponse{
+		Schematics: make([]*management.CreateSchematicResponse, 0, len(schematics)),
+	}
+
+	for i, sch := range schematics {
+		schematicRequest := request.GetSchematics()[i]
+
+		schematicID, err := sch.ID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate schematic ID: %w", err)
+		}
+
+		resp, ok := created[schematicID]
+		if !ok {
+			if resp, err = s.createSchematic(ctx, sch, schematicRequest, nil); err != nil {
+				return nil, err
+			}
+
+			created[schematicID] = resp
+		}
+
+		// secure boot is selected by the image profile, so the same schematic can be used in both modes
+		response.Schematics = append(response.Schematics, &management.CreateSchematicResponse{
+			SchematicId: resp.SchematicId,
+			PxeUrl:      resp.PxeUrl,
+			SecureBoot:  schematicRequest.SecureBoot,
+		})
+	}
+
+	return response, nil
+}
+
+// GetJoinKernelArgs returns the kernel args which connect the machine to Omni, so they can be used for the custom boot media.
+func (s *managementServer) GetJoinKernelArgs(ctx context.Context, _ *emptypb.Empty) (*management.GetJoinKernelArgsResponse, error) {
+	// the kernel args contain the join token, so they are as sensitive as creating a machine
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	kernelArgs, err := s.connectionKernelArgs(actor.MarkContextAsInternalActor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetJoinKernelArgsResponse{
+		KernelArgs: strings.Join(kernelArgs, " "),
+	}, nil
+}
+
+// connectionKernelArgs returns the kernel args which connect the machine to Omni, they are added to every schematic.
+func (s *managementServer) connectionKernelArgs(ctx context.Context) ([]string, error) {
 	params, err := safe.StateGet[*siderolink.ConnectionParams](ctx, s.omniState, siderolink.NewConnectionParams(
 		resources.DefaultNamespace,
 		siderolink.ConfigID,
@@ -45,16 +195,27 @@ func (s *managementServer) CreateSchematic(ctx context.Context, request *managem
 		return nil, fmt.Errorf("failed to get Omni connection params for the extra kernel arguments: %w", err)
 	}
 
+	return strings.Split(params.TypedSpec().Value.Args, " "), nil
+}
+
+// newSchematic validates the request and builds the schematic from it.
+func newSchematic(kernelArgs []string, request *management.CreateSchematicRequest) (schematic.Schematic, error) {
 	customization := schematic.Customization{
-		ExtraKernelArgs: append(strings.Split(params.TypedSpec().Value.Args, " "), request.ExtraKernelArgs...),
+		ExtraKernelArgs: append(slices.Clone(kernelArgs), request.ExtraKernelArgs...),
 		SystemExtensions: schematic.SystemExtensions{
 			OfficialExtensions: request.Extensions,
 		},
 	}
 
+	if request.SecureBoot {
+		if err := validateSecureBootKernelArgs(request.ExtraKernelArgs); err != nil {
+			return schematic.Schematic{}, err
+		}
+	}
+
 	for key, value := range request.MetaValues {
-		if !meta.CanSetMetaKey(int(key)) {
-			return nil, status.Errorf(codes.InvalidArgument, "meta key %s is not allowed to be set in the schematic, as it's reserved by Talos", runtime.MetaKeyTagToID(uint8(key)))
+		if err := validateMetaValue(key, value); err != nil {
+			return schematic.Schematic{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 
 		customization.Meta = append(customization.Meta, schematic.MetaValue{
@@ -74,10 +235,80 @@ func (s *managementServer) CreateSchematic(ctx context.Context, request *managem
 		return 0
 	})
 
-	schematic := schematic.Schematic{
+	return schematic.Schematic{
 		Customization: customization,
+	}, nil
+}
+
+// validateMetaValue checks that the meta value can be put into the schematic.
+func validateMetaValue(key uint32, value string) error {
+	if key > math.MaxUint8 {
+		return fmt.Errorf("meta key %d is out of range, the keys are 8-bit", key)
+	}
+
+	if !meta.CanSetMetaKey(int(key)) {
+		return fmt.Errorf("meta key %s is not allowed to be set in the schematic, as it's reserved by Talos", runtime.MetaKeyTagToID(uint8(key)))
+	}
+
+	switch key {
+	case meta.LabelsMeta:
+		if _, err := meta.ParseLabels([]byte(value)); err != nil {
+			return fmt.Errorf("meta key %s value is not valid machine labels: %w", runtime.MetaKeyTagToID(uint8(key)), err)
+		}
+	case meta.MetalNetworkPlatformConfig:
+		var config any
+
+		if err := yaml.Unmarshal([]byte(value), &config); err != nil {
+			return fmt.Errorf("meta key %s value is not valid network config YAML: %w", runtime.MetaKeyTagToID(uint8(key)), err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMetaValues checks the meta values the same way CreateSchematic does, without creating the schematic.
+func (s *managementServer) ValidateMetaValues(ctx context.Context, request *management.ValidateMetaValuesRequest) (*management.ValidateMetaValuesResponse, error) {
+	// validating meta values is low risk, require any valid signature
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	response := &management.ValidateMetaValuesResponse{
+		Valid: true,
 	}
 
+	for key, value := range request.GetMetaValues() {
+		result := &management.ValidateMetaValuesResponse_Result{
+			Key:   key,
+			Valid: true,
+		}
+
+		if key <= math.MaxUint8 {
+			result.KeyId = runtime.MetaKeyTagToID(uint8(key))
+		}
+
+		if err := validateMetaValue(key, value); err != nil {
+			result.Valid = false
+			result.Message = err.Error()
+			response.Valid = false
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	slices.SortFunc(response.Results, func(a, b *management.ValidateMetaValuesResponse_Result) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return response, nil
+}
+
+// createSchematic creates the schematic in the image factory, unless Omni already knows about it.
+//
+// The optional beforeFactoryCall is called only if the image factory is contacted.
+func (s *managementServer) createSchematic(ctx context.Context, schematic schematic.Schematic, request *management.CreateSchematicRequest,
+	beforeFactoryCall func() error,
+) (*management.CreateSchematicResponse, error) {
 	schematicID, err := schematic.ID()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate schematic ID: %w", err)
@@ -92,20 +323,34 @@ func (s *managementServer) CreateSchematic(ctx context.Context, request *managem
 		return nil, err
 	}
 
-	pxeURL, err := config.Config.GetImageFactoryPXEBaseURL()
+	pxeURL, err := schematicPXEURL(schematicID)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &management.CreateSchematicResponse{
 		SchematicId: schematicID,
-		PxeUrl:      pxeURL.JoinPath("pxe", schematicID).String(),
+		PxeUrl:      pxeURL,
+		SecureBoot:  request.SecureBoot,
 	}
 
 	if res != nil {
+		// the name can be given to the schematic created earlier
+		if request.Name != "" {
+			if err = s.setSchematicName(ctx, schematicID, request.Name, request.Description); err != nil {
+				return nil, err
+			}
+		}
+
 		return response, nil
 	}
 
+	if beforeFactoryCall != nil {
+		if err = beforeFactoryCall(); err != nil {
+			return nil, err
+		}
+	}
+
 	client, err := client.New(config.Config.ImageFactoryBaseURL)
 	if err != nil {
 		return nil, err
@@ -117,6 +362,9 @@ func (s *managementServer) CreateSchematic(ctx context.Context, request *managem
 	}
 
 	schematicResource.TypedSpec().Value.Extensions = request.Extensions
+	schematicResource.TypedSpec().Value.ExtraKernelArgs = schematic.Customization.ExtraKernelArgs
+	schematicResource.TypedSpec().Value.Name = request.Name
+	schematicResource.TypedSpec().Value.Description = request.Description
 
 	if err = s.omniState.Create(actor.MarkContextAsInternalActor(ctx), schematicResource); err != nil && !state.IsConflictError(err) {
 		return nil, err
@@ -124,3 +372,586 @@ func (s *managementServer) CreateSchematic(ctx context.Context, request *managem
 
 	return response, nil
 }
+
+// SetSchematicName implements ManagementServer.
+//
+// The name is the metadata of the schematic resource, it doesn't change the schematic ID.
+func (s *managementServer) SetSchematicName(ctx context.Context, request *management.SetSchematicNameRequest) (*emptypb.Empty, error) {
+	if !isValidSchematicID(request.GetSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schematic id %q", request.GetSchematicId())
+	}
+
+	// naming a schematic is equivalent to creating it
+	if _, err := s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	if err := s.setSchematicName(ctx, request.GetSchematicId(), request.GetName(), request.GetDescription()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *managementServer) setSchematicName(ctx context.Context, schematicID, name, description string) error {
+	_, err := safe.StateUpdateWithConflicts(actor.MarkContextAsInternalActor(ctx), s.omniState, omni.NewSchematic(resources.DefaultNamespace, schematicID).Metadata(),
+		func(res *omni.Schematic) error {
+			res.TypedSpec().Value.Name = name
+			res.TypedSpec().Value.Description = description
+
+			return nil
+		},
+	)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "schematic %q was not created by Omni", schematicID)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// secureBootIncompatibleKernelArgs are the kernel args which disable the protections enforced in the secure boot mode.
+//
+// Secure boot is selected by the image factory artifact profile, the schematic is the same for both modes,
+// so the only thing to check is that the schematic doesn't break the secure boot chain.
+var secureBootIncompatibleKernelArgs = []string{
+	"lockdown=none",
+	"module.sig_enforce=0",
+	"efi=disable_early_pci_dma",
+}
+
+func validateSecureBootKernelArgs(args []string) error {
+	for _, arg := range args {
+		if slices.Contains(secureBootIncompatibleKernelArgs, arg) {
+			return status.Errorf(codes.InvalidArgument, "kernel arg %q can not be used with secure boot", arg)
+		}
+	}
+
+	return nil
+}
+
+// GetSchematicPXEURL implements ManagementServer.
+func (s *managementServer) GetSchematicPXEURL(ctx context.Context, request *management.GetSchematicPXEURLRequest) (*management.GetSchematicPXEURLResponse, error) {
+	// building the PXE URL doesn't read or modify any resources
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	if !isValidSchematicID(request.GetSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schematic id %q", request.GetSchematicId())
+	}
+
+	pxeURL, err := schematicPXEURL(request.GetSchematicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetSchematicPXEURLResponse{
+		PxeUrl: pxeURL,
+	}, nil
+}
+
+// GetInstallerImageURL implements ManagementServer.
+func (s *managementServer) GetInstallerImageURL(ctx context.Context, request *management.GetInstallerImageURLRequest) (*management.GetInstallerImageURLResponse, error) {
+	// building the installer image reference doesn't read or modify any resources
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	if !isValidSchematicID(request.GetSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schematic id %q", request.GetSchematicId())
+	}
+
+	if _, err := semver.Parse(strings.TrimPrefix(request.GetTalosVersion(), "v")); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Talos version %q: %s", request.GetTalosVersion(), err)
+	}
+
+	image, err := omni.GetInstallImage(config.Config.TalosRegistry, config.Config.ImageFactoryBaseURL, request.GetSchematicId(), request.GetTalosVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetInstallerImageURLResponse{
+		Image: image,
+	}, nil
+}
+
+// GetSchematicISOURL returns the image factory URL of the ISO built from the schematic.
+func (s *managementServer) GetSchematicISOURL(ctx context.Context, request *management.GetSchematicISOURLRequest) (*management.GetSchematicISOURLResponse, error) {
+	// building the ISO URL doesn't read or modify any resources
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	if !isValidSchematicID(request.GetSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schematic id %q", request.GetSchematicId())
+	}
+
+	talosVersion, err := semver.Parse(strings.TrimPrefix(request.GetTalosVersion(), "v"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Talos version %q: %s", request.GetTalosVersion(), err)
+	}
+
+	if !slices.Contains(imageFactoryArchitectures, request.GetArch()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported architecture %q, expected one of: %s", request.GetArch(), strings.Join(imageFactoryArchitectures, ", "))
+	}
+
+	factoryURL, err := url.Parse(config.Config.ImageFactoryBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.GetSchematicISOURLResponse{
+		Url: factoryURL.JoinPath("image", request.GetSchematicId(), "v"+talosVersion.String(), "metal-"+request.GetArch()+".iso").String(),
+	}, nil
+}
+
+func schematicPXEURL(schematicID string) (string, error) {
+	pxeURL, err := config.Config.GetImageFactoryPXEBaseURL()
+	if err != nil {
+		return "", err
+	}
+
+	return pxeURL.JoinPath("pxe", schematicID).String(), nil
+}
+
+// isValidSchematicID checks that the ID looks like the image factory schematic ID, which is a hex-encoded SHA256 hash.
+func isValidSchematicID(id string) bool {
+	if len(id) != hex.EncodedLen(sha256.Size) {
+		return false
+	}
+
+	_, err := hex.DecodeString(id)
+
+	return err == nil
+}
+
+// CompareKernelArgs implements ManagementServer.
+func (s *managementServer) CompareKernelArgs(ctx context.Context, request *management.CompareKernelArgsRequest) (*management.CompareKernelArgsResponse, error) {
+	// comparing kernel args is equivalent to reading machine status
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	if request.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	machineStatus, err := safe.StateGet[*omni.MachineStatus](ctx, s.omniState, omni.NewMachineStatus(resources.DefaultNamespace, request.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", request.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	kernelCmdline := machineStatus.TypedSpec().Value.KernelCmdline
+	if kernelCmdline == "" {
+		return nil, status.Error(codes.FailedPrecondition, "machine kernel command line is not known yet")
+	}
+
+	schematicID := machineStatus.TypedSpec().Value.GetSchematic().GetId()
+	if schematicID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "machine schematic is not known yet")
+	}
+
+	schematicResource, err := safe.StateGet[*omni.Schematic](ctx, s.omniState, omni.NewSchematic(resources.DefaultNamespace, schematicID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "schematic %q was not created by Omni", schematicID)
+		}
+
+		return nil, err
+	}
+
+	intendedArgs := schematicResource.TypedSpec().Value.ExtraKernelArgs
+	actualArgs := strings.Fields(kernelCmdline)
+
+	missingArgs := xslices.Filter(intendedArgs, func(arg string) bool {
+		return !slices.Contains(actualArgs, arg)
+	})
+
+	extraArgs := xslices.Filter(actualArgs, func(arg string) bool {
+		return !slices.Contains(intendedArgs, arg) && !isSiderolinkKernelArg(arg)
+	})
+
+	return &management.CompareKernelArgsResponse{
+		IntendedArgs: intendedArgs,
+		ActualArgs:   actualArgs,
+		MissingArgs:  missingArgs,
+		ExtraArgs:    extraArgs,
+	}, nil
+}
+
+// siderolinkKernelArgs are the kernel args Omni adds to connect the machine, they are never part of the schematic.
+var siderolinkKernelArgs = []string{
+	talosconstants.KernelParamSideroLink,
+	talosconstants.KernelParamEventsSink,
+	talosconstants.KernelParamLoggingKernel,
+}
+
+func isSiderolinkKernelArg(arg string) bool {
+	key, _, _ := strings.Cut(arg, "=")
+
+	return slices.Contains(siderolinkKernelArgs, key)
+}
+
+// CompareSchematicToBaseline implements ManagementServer.
+func (s *managementServer) CompareSchematicToBaseline(ctx context.Context, request *management.CompareSchematicToBaselineRequest) (*management.CompareSchematicToBaselineResponse, error) {
+	// comparing schematics is equivalent to reading machine status
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	if request.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if !isValidSchematicID(request.GetBaselineSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid baseline schematic id %q", request.GetBaselineSchematicId())
+	}
+
+	machineStatus, err := safe.StateGet[*omni.MachineStatus](ctx, s.omniState, omni.NewMachineStatus(resources.DefaultNamespace, request.GetMachineId()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", request.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	machineSchematic := machineStatus.TypedSpec().Value.GetSchematic()
+
+	if machineSchematic.GetInvalid() {
+		return &management.CompareSchematicToBaselineResponse{
+			InvalidSchematic: true,
+		}, nil
+	}
+
+	if machineSchematic.GetId() == "" {
+		return nil, status.Error(codes.FailedPrecondition, "machine schematic is not known yet")
+	}
+
+	response := &management.CompareSchematicToBaselineResponse{
+		SchematicId: machineSchematic.GetId(),
+	}
+
+	if machineSchematic.GetId() == request.GetBaselineSchematicId() {
+		return response, nil
+	}
+
+	schematicExtensions := func(schematicID string) ([]string, error) {
+		schematicResource, err := s.getSchematic(ctx, schematicID)
+		if err != nil {
+			return nil, err
+		}
+
+		return schematicResource.TypedSpec().Value.Extensions, nil
+	}
+
+	machineExtensions, err := schematicExtensions(machineSchematic.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	baselineExtensions, err := schematicExtensions(request.GetBaselineSchematicId())
+	if err != nil {
+		return nil, err
+	}
+
+	response.AddedExtensions = xslices.Filter(machineExtensions, func(extension string) bool {
+		return !slices.Contains(baselineExtensions, extension)
+	})
+
+	response.RemovedExtensions = xslices.Filter(baselineExtensions, func(extension string) bool {
+		return !slices.Contains(machineExtensions, extension)
+	})
+
+	return response, nil
+}
+
+// DiffSchematics implements ManagementServer.
+//
+// The differences are reported as the changes from the schematic A to the schematic B.
+func (s *managementServer) DiffSchematics(ctx context.Context, request *management.DiffSchematicsRequest) (*management.DiffSchematicsResponse, error) {
+	for _, schematicID := range []string{request.GetSchematicIdA(), request.GetSchematicIdB()} {
+		if !isValidSchematicID(schematicID) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid schematic id %q", schematicID)
+		}
+	}
+
+	// schematics are readable by everyone who can read the machines
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	schematicA, err := s.getSchematic(ctx, request.GetSchematicIdA())
+	if err != nil {
+		return nil, err
+	}
+
+	schematicB, err := s.getSchematic(ctx, request.GetSchematicIdB())
+	if err != nil {
+		return nil, err
+	}
+
+	diff := func(from, to []string) (added, removed []string) {
+		added = xslices.Filter(to, func(item string) bool {
+			return !slices.Contains(from, item)
+		})
+
+		removed = xslices.Filter(from, func(item string) bool {
+			return !slices.Contains(to, item)
+		})
+
+		return added, removed
+	}
+
+	response := &management.DiffSchematicsResponse{}
+
+	response.AddedExtensions, response.RemovedExtensions = diff(schematicA.TypedSpec().Value.Extensions, schematicB.TypedSpec().Value.Extensions)
+	response.AddedKernelArgs, response.RemovedKernelArgs = diff(schematicA.TypedSpec().Value.ExtraKernelArgs, schematicB.TypedSpec().Value.ExtraKernelArgs)
+
+	return response, nil
+}
+
+// ListInvalidSchematicMachines implements ManagementServer.
+//
+// The schematic is invalid if the machine has the extensions installed bypassing the image factory.
+func (s *managementServer) ListInvalidSchematicMachines(ctx context.Context, _ *emptypb.Empty) (*management.ListInvalidSchematicMachinesResponse, error) {
+	// the role is checked for each machine below
+	if _, err := s.authCheckMethod(ctx, role.None); err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omni.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+
+	response := &management.ListInvalidSchematicMachinesResponse{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		if !spec.GetSchematic().GetInvalid() {
+			continue
+		}
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		response.Machines = append(response.Machines, &management.ListInvalidSchematicMachinesResponse_Machine{
+			MachineId:  iter.Value().Metadata().ID(),
+			Cluster:    spec.GetCluster(),
+			Extensions: spec.GetSchematic().GetExtensions(),
+		})
+	}
+
+	return response, nil
+}
+
+// ListSchematicsWithStaleArgs implements ManagementServer.
+//
+// The schematic is stale if it doesn't embed the current connection kernel args, so the machines booted from it
+// won't be able to join Omni after a reboot to the new image.
+// The schematics created before Omni started recording the kernel args are skipped, as they can't be checked.
+func (s *managementServer) ListSchematicsWithStaleArgs(ctx context.Context, _ *emptypb.Empty) (*management.ListSchematicsWithStaleArgsResponse, error) {
+	// schematics are readable by everyone who can read the machines, the machines are checked below
+	if _, err := s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	kernelArgs, err := s.connectionKernelArgs(internalCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	schematics, err := safe.StateListAll[*omni.Schematic](internalCtx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omni.MachineStatus](internalCtx, s.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	canRead := s.clusterReadAccessChecker(ctx)
+	schematicMachines := map[string][]string{}
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		schematicID := spec.GetSchematic().GetId()
+		if schematicID == "" {
+			continue
+		}
+
+		allowed, err := canRead(spec.GetCluster())
+		if err != nil {
+			return nil, err
+		}
+
+		if allowed {
+			schematicMachines[schematicID] = append(schematicMachines[schematicID], iter.Value().Metadata().ID())
+		}
+	}
+
+	response := &management.ListSchematicsWithStaleArgsResponse{}
+
+	for iter := schematics.Iterator(); iter.Next(); {
+		spec := iter.Value().TypedSpec().Value
+
+		if len(spec.ExtraKernelArgs) == 0 {
+			continue
+		}
+
+		var staleArgs []string
+
+		for _, arg := range kernelArgs {
+			if !slices.Contains(spec.ExtraKernelArgs, arg) {
+				name, _, _ := strings.Cut(arg, "=")
+
+				staleArgs = append(staleArgs, name)
+			}
+		}
+
+		if len(staleArgs) == 0 {
+			continue
+		}
+
+		response.Schematics = append(response.Schematics, &management.ListSchematicsWithStaleArgsResponse_Schematic{
+			SchematicId: iter.Value().Metadata().ID(),
+			Name:        spec.Name,
+			StaleArgs:   staleArgs,
+			MachineIds:  schematicMachines[iter.Value().Metadata().ID()],
+		})
+	}
+
+	return response, nil
+}
+
+// imageFactoryArchitectures are the architectures the image factory builds the images for.
+var imageFactoryArchitectures = []string{"amd64", "arm64"}
+
+// ValidateSchematicUpgrade implements ManagementServer.
+//
+// The upgrade to the target schematic installer image keeps the Talos version of the machine,
+// so the target schematic extensions should be available for the Talos version and architecture the machine is running.
+func (s *managementServer) ValidateSchematicUpgrade(ctx context.Context, request *management.ValidateSchematicUpgradeRequest) (*management.ValidateSchematicUpgradeResponse, error) {
+	machineID := request.GetMachineId()
+	if machineID == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine id is required")
+	}
+
+	if !isValidSchematicID(request.GetTargetSchematicId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target schematic id %q", request.GetTargetSchematicId())
+	}
+
+	machineStatus, err := safe.StateGet[*omni.MachineStatus](actor.MarkContextAsInternalActor(ctx), s.omniState, omni.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+		}
+
+		return nil, err
+	}
+
+	if clusterName := machineStatus.TypedSpec().Value.GetCluster(); clusterName != "" {
+		if ctx, err = s.applyClusterAccessPolicy(ctx, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	// validating the upgrade is equivalent to reading machine status, the upgrade itself is not triggered
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	targetSchematic, err := s.getSchematic(ctx, request.GetTargetSchematicId())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := machineStatus.TypedSpec().Value
+
+	if spec.GetTalosVersion() == "" || spec.GetHardware().GetArch() == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q Talos version and architecture are not known yet", machineID)
+	}
+
+	response := &management.ValidateSchematicUpgradeResponse{
+		TalosVersion: spec.GetTalosVersion(),
+		Arch:         spec.GetHardware().GetArch(),
+	}
+
+	if !slices.Contains(imageFactoryArchitectures, response.Arch) {
+		response.Issues = append(response.Issues, fmt.Sprintf("architecture %q is not supported by the image factory", response.Arch))
+	}
+
+	switch {
+	case spec.GetMaintenance():
+		response.Issues = append(response.Issues, "machine is running in maintenance mode, Talos has to be installed instead of upgraded")
+	case spec.GetBootStatus() != nil && !spec.GetBootStatus().GetInstalled():
+		response.Issues = append(response.Issues, fmt.Sprintf("machine is booted from %s without Talos installed", spec.GetBootStatus().GetMethod()))
+	}
+
+	if spec.GetSchematic().GetInvalid() {
+		response.Issues = append(response.Issues, "machine has extensions installed bypassing the image factory, the upgrade would remove them")
+	}
+
+	talosVersion := strings.TrimLeft(spec.GetTalosVersion(), "v")
+
+	talosExtensions, err := safe.StateGet[*omni.TalosExtensions](ctx, s.omniState, omni.NewTalosExtensions(resources.DefaultNamespace, talosVersion).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	switch {
+	case talosExtensions == nil && len(targetSchematic.TypedSpec().Value.Extensions) > 0:
+		response.Issues = append(response.Issues, fmt.Sprintf("extensions available for Talos %s are not known", talosVersion))
+	case talosExtensions != nil:
+		available := xslices.ToSet(xslices.Map(talosExtensions.TypedSpec().Value.Items, func(info *specs.TalosExtensionsSpec_Info) string {
+			return info.GetName()
+		}))
+
+		for _, extension := range targetSchematic.TypedSpec().Value.Extensions {
+			if _, ok := available[extension]; !ok {
+				response.Issues = append(response.Issues, fmt.Sprintf("extension %q is not available for Talos %s", extension, talosVersion))
+			}
+		}
+	}
+
+	response.Feasible = len(response.Issues) == 0
+
+	return response, nil
+}
+
+func (s *managementServer) getSchematic(ctx context.Context, schematicID string) (*omni.Schematic, error) {
+	schematicResource, err := safe.StateGet[*omni.Schematic](ctx, s.omniState, omni.NewSchematic(resources.DefaultNamespace, schematicID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "schematic %q was not created by Omni", schematicID)
+		}
+
+		return nil, err
+	}
+
+	return schematicResource, nil
+}
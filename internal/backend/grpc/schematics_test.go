@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/julienschmidt/httprouter"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/image-factory/pkg/schematic"
@@ -27,8 +28,10 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/meta"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -41,6 +44,7 @@ type imageFactoryMock struct {
 	schematics map[string]schematic.Schematic
 	eg         errgroup.Group
 	address    string
+	created    int
 
 	schematicMu sync.Mutex
 }
@@ -140,6 +144,7 @@ func (m *imageFactoryMock) saveSchematic(r *http.Request) (string, error) {
 	}
 
 	m.schematics[id] = *cfg
+	m.created++
 
 	return id, nil
 }
@@ -212,6 +217,27 @@ func (suite *GrpcSuite) TestSchematicCreate() {
 				},
 			},
 		},
+		{
+			name: "secure boot",
+			request: &management.CreateSchematicRequest{
+				ExtraKernelArgs: []string{
+					"console=ttyS0",
+				},
+				SecureBoot: true,
+			},
+		},
+		{
+			name: "fail to disable lockdown with secure boot",
+			request: &management.CreateSchematicRequest{
+				ExtraKernelArgs: []string{
+					"lockdown=none",
+				},
+				SecureBoot: true,
+			},
+			expectedError: func(t *testing.T, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
 		{
 			name: "fail to set protected meta key",
 			request: &management.CreateSchematicRequest{
@@ -239,6 +265,7 @@ func (suite *GrpcSuite) TestSchematicCreate() {
 
 			require.NoError(t, err)
 			require.NotEmpty(t, resp.SchematicId)
+			require.Equal(t, req.SecureBoot, resp.SecureBoot)
 
 			rtestutils.AssertResource(ctx, t, suite.state, resp.SchematicId, func(res *omni.Schematic, assert *assert.Assertions) {
 				assert.EqualValues(req.Extensions, res.TypedSpec().Value.Extensions)
@@ -259,3 +286,738 @@ func (suite *GrpcSuite) TestSchematicCreate() {
 		})
 	}
 }
+
+func (suite *GrpcSuite) TestSchematicsCreate() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	params := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	params.TypedSpec().Value.Args = "arg=value"
+
+	suite.Require().NoError(suite.state.Create(ctx, params))
+
+	factory := imageFactoryMock{}
+	suite.Require().NoError(factory.run())
+
+	factory.serve(ctx)
+
+	defer func() {
+		cancel()
+
+		suite.Require().NoError(factory.eg.Wait())
+	}()
+
+	config.Config.ImageFactoryBaseURL = factory.address
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.CreateSchematics(ctx, &management.CreateSchematicsRequest{})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// nothing is created if any of the schematics is invalid
+	_, err = client.CreateSchematics(ctx, &management.CreateSchematicsRequest{
+		Schematics: []*management.CreateSchematicRequest{
+			{
+				Extensions: []string{"github.com/my/bulk-extension"},
+			},
+			{
+				MetaValues: map[uint32]string{
+					meta.StateEncryptionConfig: "",
+				},
+			},
+		},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	factory.schematicMu.Lock()
+	suite.Require().Zero(factory.created)
+	factory.schematicMu.Unlock()
+
+	resp, err := client.CreateSchematics(ctx, &management.CreateSchematicsRequest{
+		Schematics: []*management.CreateSchematicRequest{
+			{
+				Extensions: []string{"github.com/my/bulk-extension"},
+			},
+			{
+				Extensions: []string{"github.com/my/other-bulk-extension"},
+			},
+			{
+				Extensions: []string{"github.com/my/bulk-extension"},
+				SecureBoot: true,
+			},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Schematics, 3)
+
+	suite.Require().NotEqual(resp.Schematics[0].SchematicId, resp.Schematics[1].SchematicId)
+	suite.Require().Equal(resp.Schematics[0].SchematicId, resp.Schematics[2].SchematicId)
+	suite.Require().False(resp.Schematics[0].SecureBoot)
+	suite.Require().True(resp.Schematics[2].SecureBoot)
+
+	for _, schematicResp := range resp.Schematics {
+		suite.Require().True(strings.HasSuffix(schematicResp.PxeUrl, "/pxe/"+schematicResp.SchematicId))
+
+		rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{schematicResp.SchematicId}, func(*omni.Schematic, *assert.Assertions) {})
+	}
+
+	factory.schematicMu.Lock()
+	suite.Require().Equal(2, factory.created)
+	factory.schematicMu.Unlock()
+
+	// the schematics known to Omni are not created in the image factory again
+	_, err = client.CreateSchematics(ctx, &management.CreateSchematicsRequest{
+		Schematics: []*management.CreateSchematicRequest{
+			{
+				Extensions: []string{"github.com/my/other-bulk-extension"},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	factory.schematicMu.Lock()
+	suite.Require().Equal(2, factory.created)
+	factory.schematicMu.Unlock()
+}
+
+func (suite *GrpcSuite) TestSchematicCreateWithProgress() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	params := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	params.TypedSpec().Value.Args = "arg=value"
+
+	suite.Require().NoError(suite.state.Create(ctx, params))
+
+	factory := imageFactoryMock{}
+	suite.Require().NoError(factory.run())
+
+	factory.serve(ctx)
+
+	defer func() {
+		cancel()
+
+		suite.Require().NoError(factory.eg.Wait())
+	}()
+
+	config.Config.ImageFactoryBaseURL = factory.address
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	create := func(request *management.CreateSchematicRequest) ([]management.CreateSchematicProgress_Stage, *management.CreateSchematicResponse, error) {
+		cli, err := client.CreateSchematicWithProgress(ctx, request)
+		suite.Require().NoError(err)
+
+		var stages []management.CreateSchematicProgress_Stage
+
+		for {
+			msg, err := cli.Recv()
+			if err != nil {
+				return stages, nil, err
+			}
+
+			stages = append(stages, msg.Stage)
+
+			if msg.Stage == management.CreateSchematicProgress_DONE {
+				return stages, msg.Schematic, nil
+			}
+		}
+	}
+
+	request := &management.CreateSchematicRequest{
+		Extensions: []string{"github.com/my/progress-extension"},
+	}
+
+	stages, resp, err := create(request)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]management.CreateSchematicProgress_Stage{
+		management.CreateSchematicProgress_VALIDATING,
+		management.CreateSchematicProgress_CONTACTING_FACTORY,
+		management.CreateSchematicProgress_DONE,
+	}, stages)
+	suite.Require().True(strings.HasSuffix(resp.PxeUrl, "/pxe/"+resp.SchematicId))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{resp.SchematicId}, func(*omni.Schematic, *assert.Assertions) {})
+
+	// the schematic is known to Omni, so the image factory is not contacted
+	stages, cachedResp, err := create(request)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]management.CreateSchematicProgress_Stage{
+		management.CreateSchematicProgress_VALIDATING,
+		management.CreateSchematicProgress_DONE,
+	}, stages)
+	suite.Require().Equal(resp.SchematicId, cachedResp.SchematicId)
+
+	_, _, err = create(&management.CreateSchematicRequest{
+		MetaValues: map[uint32]string{
+			meta.StateEncryptionConfig: "",
+		},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	factory.schematicMu.Lock()
+	suite.Require().Equal(1, factory.created)
+	factory.schematicMu.Unlock()
+}
+
+func (suite *GrpcSuite) TestValidateMetaValues() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	resp, err := client.ValidateMetaValues(ctx, &management.ValidateMetaValuesRequest{
+		MetaValues: map[uint32]string{
+			meta.LabelsMeta:                 "machineLabels:\n  a: b\n",
+			meta.MetalNetworkPlatformConfig: "{}",
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().True(resp.Valid)
+	suite.Require().Len(resp.Results, 2)
+	suite.Assert().Equal("0x0a", resp.Results[0].KeyId)
+	suite.Assert().Equal("0x0c", resp.Results[1].KeyId)
+
+	resp, err = client.ValidateMetaValues(ctx, &management.ValidateMetaValuesRequest{
+		MetaValues: map[uint32]string{
+			meta.StateEncryptionConfig: "",
+			meta.LabelsMeta:            "machineLabels: [a",
+			meta.UserReserved2:         "anything",
+			300:                        "",
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Assert().False(resp.Valid)
+	suite.Require().Len(resp.Results, 4)
+
+	results := map[uint32]*management.ValidateMetaValuesResponse_Result{}
+
+	for _, result := range resp.Results {
+		results[result.Key] = result
+	}
+
+	suite.Assert().False(results[meta.StateEncryptionConfig].Valid)
+	suite.Assert().Contains(results[meta.StateEncryptionConfig].Message, "reserved by Talos")
+	suite.Assert().False(results[meta.LabelsMeta].Valid)
+	suite.Assert().Contains(results[meta.LabelsMeta].Message, "not valid machine labels")
+	suite.Assert().True(results[meta.UserReserved2].Valid)
+	suite.Assert().False(results[300].Valid)
+	suite.Assert().Empty(results[300].KeyId)
+
+	// the schematic creation uses the same checks
+	params := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	params.TypedSpec().Value.Args = "arg=value"
+
+	suite.Require().NoError(suite.state.Create(ctx, params))
+
+	_, err = client.CreateSchematic(ctx, &management.CreateSchematicRequest{
+		MetaValues: map[uint32]string{
+			meta.LabelsMeta: "machineLabels: [a",
+		},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *GrpcSuite) TestGetSchematicPXEURL() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	config.Config.ImageFactoryPXEBaseURL = "https://pxe.factory.test"
+
+	defer func() {
+		config.Config.ImageFactoryPXEBaseURL = ""
+	}()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicID := strings.Repeat("ab", 32)
+
+	resp, err := client.GetSchematicPXEURL(ctx, &management.GetSchematicPXEURLRequest{
+		SchematicId: schematicID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("https://pxe.factory.test/pxe/"+schematicID, resp.PxeUrl)
+
+	for _, id := range []string{"", "abc", strings.Repeat("zz", 32)} {
+		_, err = client.GetSchematicPXEURL(ctx, &management.GetSchematicPXEURLRequest{
+			SchematicId: id,
+		})
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (suite *GrpcSuite) TestGetJoinKernelArgs() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetJoinKernelArgs(ctx, &emptypb.Empty{})
+	suite.Require().Error(err)
+
+	params := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	params.TypedSpec().Value.Args = "siderolink.api=grpc://omni.test?jointoken=token talos.events.sink=[fdae::1]:8090"
+
+	suite.Require().NoError(suite.state.Create(ctx, params))
+
+	resp, err := client.GetJoinKernelArgs(ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.TypedSpec().Value.Args, resp.KernelArgs)
+}
+
+func (suite *GrpcSuite) TestGetAvailableExtensionUpdates() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	_, err := client.GetAvailableExtensionUpdates(ctx, &management.GetAvailableExtensionUpdatesRequest{MachineId: "extensions"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "extensions")
+	machineStatus.TypedSpec().Value.TalosVersion = "v1.7.0"
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+
+	_, err = client.GetAvailableExtensionUpdates(ctx, &management.GetAvailableExtensionUpdatesRequest{MachineId: "extensions"})
+	suite.Require().Equal(codes.FailedPrecondition, status.Code(err))
+
+	for version, extensions := range map[string][]*specs.TalosExtensionsSpec_Info{
+		"1.6.0": {
+			{Name: "siderolabs/gvisor", Version: "20240101.0"},
+		},
+		"1.7.0": {
+			{Name: "siderolabs/gvisor", Version: "20240301.0"},
+			{Name: "siderolabs/iscsi-tools", Version: "v0.1.4"},
+		},
+		"1.8.0": {
+			{Name: "siderolabs/gvisor", Version: "20240501.0"},
+			{Name: "siderolabs/iscsi-tools", Version: "v0.1.4"},
+			{Name: "siderolabs/hello-world-service", Version: "v1.0.0"},
+		},
+	} {
+		talosExtensions := omni.NewTalosExtensions(resources.DefaultNamespace, version)
+		talosExtensions.TypedSpec().Value.Items = extensions
+
+		suite.Require().NoError(suite.state.Create(ctx, talosExtensions))
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+			Id: "abc",
+			InstalledExtensions: []*specs.MachineStatusSpec_Schematic_InstalledExtension{
+				{Name: "gvisor", Version: "20240301.0"},
+				{Name: "iscsi-tools", Version: "v0.1.4"},
+			},
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err := client.GetAvailableExtensionUpdates(ctx, &management.GetAvailableExtensionUpdatesRequest{MachineId: "extensions"})
+	suite.Require().NoError(err)
+
+	suite.Require().False(resp.Unresolvable)
+	suite.Require().Len(resp.Updates, 1)
+	suite.Assert().Equal("gvisor", resp.Updates[0].Name)
+	suite.Assert().Equal("20240301.0", resp.Updates[0].InstalledVersion)
+	suite.Assert().Equal("20240501.0", resp.Updates[0].AvailableVersion)
+	suite.Assert().Equal("1.8.0", resp.Updates[0].TalosVersion)
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+			Invalid:    true,
+			Extensions: []string{"gvisor"},
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err = client.GetAvailableExtensionUpdates(ctx, &management.GetAvailableExtensionUpdatesRequest{MachineId: "extensions"})
+	suite.Require().NoError(err)
+
+	suite.Assert().True(resp.Unresolvable)
+	suite.Assert().Empty(resp.Updates)
+}
+
+func (suite *GrpcSuite) TestValidateSchematicUpgrade() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	targetSchematicID := strings.Repeat("cd", 32)
+
+	_, err := client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: "abc"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "upgrade")
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+
+	_, err = client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	targetSchematic := omni.NewSchematic(resources.DefaultNamespace, targetSchematicID)
+	targetSchematic.TypedSpec().Value.Extensions = []string{"siderolabs/gvisor", "siderolabs/hello-world-service"}
+
+	suite.Require().NoError(suite.state.Create(ctx, targetSchematic))
+
+	_, err = client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.TalosVersion = "v1.9.0"
+		res.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{Arch: "amd64"}
+		res.TypedSpec().Value.Maintenance = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err := client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().NoError(err)
+
+	suite.Assert().False(resp.Feasible)
+	suite.Assert().Equal("v1.9.0", resp.TalosVersion)
+	suite.Assert().Equal("amd64", resp.Arch)
+	suite.Assert().Equal([]string{
+		"machine is running in maintenance mode, Talos has to be installed instead of upgraded",
+		"extensions available for Talos 1.9.0 are not known",
+	}, resp.Issues)
+
+	talosExtensions := omni.NewTalosExtensions(resources.DefaultNamespace, "1.9.0")
+	talosExtensions.TypedSpec().Value.Items = []*specs.TalosExtensionsSpec_Info{
+		{Name: "siderolabs/gvisor", Version: "20240501.0"},
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, talosExtensions))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Maintenance = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err = client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().NoError(err)
+
+	suite.Assert().False(resp.Feasible)
+	suite.Assert().Equal([]string{`extension "siderolabs/hello-world-service" is not available for Talos 1.9.0`}, resp.Issues)
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, talosExtensions.Metadata(), func(res *omni.TalosExtensions) error {
+		res.TypedSpec().Value.Items = append(res.TypedSpec().Value.Items, &specs.TalosExtensionsSpec_Info{Name: "siderolabs/hello-world-service", Version: "v1.0.0"})
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	resp, err = client.ValidateSchematicUpgrade(ctx, &management.ValidateSchematicUpgradeRequest{MachineId: "upgrade", TargetSchematicId: targetSchematicID})
+	suite.Require().NoError(err)
+
+	suite.Assert().True(resp.Feasible)
+	suite.Assert().Empty(resp.Issues)
+}
+
+func (suite *GrpcSuite) TestGetInstallerImageURL() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	config.Config.ImageFactoryBaseURL = "https://factory.test"
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicID := strings.Repeat("ab", 32)
+
+	for _, version := range []string{"1.6.0", "v1.6.0"} {
+		resp, err := client.GetInstallerImageURL(ctx, &management.GetInstallerImageURLRequest{
+			SchematicId:  schematicID,
+			TalosVersion: version,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal("factory.test/installer/"+schematicID+":v1.6.0", resp.Image)
+	}
+
+	for _, tt := range []struct {
+		schematicID  string
+		talosVersion string
+	}{
+		{schematicID: "", talosVersion: "1.6.0"},
+		{schematicID: schematicID, talosVersion: ""},
+		{schematicID: schematicID, talosVersion: "latest"},
+		{schematicID: schematicID, talosVersion: "1.6"},
+	} {
+		_, err := client.GetInstallerImageURL(ctx, &management.GetInstallerImageURLRequest{
+			SchematicId:  tt.schematicID,
+			TalosVersion: tt.talosVersion,
+		})
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (suite *GrpcSuite) TestGetSchematicISOURL() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	config.Config.ImageFactoryBaseURL = "https://factory.test"
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicID := strings.Repeat("ab", 32)
+
+	for _, version := range []string{"1.6.0", "v1.6.0"} {
+		resp, err := client.GetSchematicISOURL(ctx, &management.GetSchematicISOURLRequest{
+			SchematicId:  schematicID,
+			TalosVersion: version,
+			Arch:         "arm64",
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal("https://factory.test/image/"+schematicID+"/v1.6.0/metal-arm64.iso", resp.Url)
+	}
+
+	for _, tt := range []struct {
+		schematicID  string
+		talosVersion string
+		arch         string
+	}{
+		{schematicID: "", talosVersion: "1.6.0", arch: "amd64"},
+		{schematicID: schematicID, talosVersion: "", arch: "amd64"},
+		{schematicID: schematicID, talosVersion: "1.6", arch: "amd64"},
+		{schematicID: schematicID, talosVersion: "1.6.0", arch: ""},
+		{schematicID: schematicID, talosVersion: "1.6.0", arch: "riscv64"},
+	} {
+		_, err := client.GetSchematicISOURL(ctx, &management.GetSchematicISOURLRequest{
+			SchematicId:  tt.schematicID,
+			TalosVersion: tt.talosVersion,
+			Arch:         tt.arch,
+		})
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (suite *GrpcSuite) TestCompareSchematicToBaseline() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	baselineID := strings.Repeat("aa", 32)
+	machineSchematicID := strings.Repeat("bb", 32)
+
+	baseline := omni.NewSchematic(resources.DefaultNamespace, baselineID)
+	baseline.TypedSpec().Value.Extensions = []string{"siderolabs/iscsi-tools", "siderolabs/util-linux-tools"}
+
+	suite.Require().NoError(suite.state.Create(ctx, baseline))
+
+	machineSchematic := omni.NewSchematic(resources.DefaultNamespace, machineSchematicID)
+	machineSchematic.TypedSpec().Value.Extensions = []string{"siderolabs/iscsi-tools", "siderolabs/nvidia-container-toolkit"}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSchematic))
+
+	createMachine := func(id string, schematic *specs.MachineStatusSpec_Schematic) {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.Schematic = schematic
+
+		suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	}
+
+	createMachine("drifted", &specs.MachineStatusSpec_Schematic{Id: machineSchematicID})
+	createMachine("standard", &specs.MachineStatusSpec_Schematic{Id: baselineID})
+	createMachine("invalid", &specs.MachineStatusSpec_Schematic{Invalid: true})
+	createMachine("unknown", nil)
+
+	resp, err := client.CompareSchematicToBaseline(ctx, &management.CompareSchematicToBaselineRequest{
+		MachineId:           "drifted",
+		BaselineSchematicId: baselineID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(machineSchematicID, resp.SchematicId)
+	suite.Require().Equal([]string{"siderolabs/nvidia-container-toolkit"}, resp.AddedExtensions)
+	suite.Require().Equal([]string{"siderolabs/util-linux-tools"}, resp.RemovedExtensions)
+
+	resp, err = client.CompareSchematicToBaseline(ctx, &management.CompareSchematicToBaselineRequest{
+		MachineId:           "standard",
+		BaselineSchematicId: baselineID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.AddedExtensions)
+	suite.Require().Empty(resp.RemovedExtensions)
+
+	resp, err = client.CompareSchematicToBaseline(ctx, &management.CompareSchematicToBaselineRequest{
+		MachineId:           "invalid",
+		BaselineSchematicId: baselineID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.InvalidSchematic)
+
+	for _, tt := range []struct {
+		machineID  string
+		baselineID string
+		code       codes.Code
+	}{
+		{machineID: "", baselineID: baselineID, code: codes.InvalidArgument},
+		{machineID: "drifted", baselineID: "abc", code: codes.InvalidArgument},
+		{machineID: "missing", baselineID: baselineID, code: codes.NotFound},
+		{machineID: "unknown", baselineID: baselineID, code: codes.FailedPrecondition},
+		{machineID: "drifted", baselineID: strings.Repeat("cc", 32), code: codes.NotFound},
+	} {
+		_, err = client.CompareSchematicToBaseline(ctx, &management.CompareSchematicToBaselineRequest{
+			MachineId:           tt.machineID,
+			BaselineSchematicId: tt.baselineID,
+		})
+		suite.Require().Equal(tt.code, status.Code(err), "machine %q, baseline %q", tt.machineID, tt.baselineID)
+	}
+}
+
+func (suite *GrpcSuite) TestCompareKernelArgs() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	schematicID := strings.Repeat("dd", 32)
+
+	machineSchematic := omni.NewSchematic(resources.DefaultNamespace, schematicID)
+	machineSchematic.TypedSpec().Value.ExtraKernelArgs = []string{"console=ttyS0", "net.ifnames=0"}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSchematic))
+
+	createMachine := func(id, kernelCmdline, schematicID string) {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.KernelCmdline = kernelCmdline
+
+		if schematicID != "" {
+			machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{Id: schematicID}
+		}
+
+		suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	}
+
+	createMachine("kernel-args", "console=ttyS0 talos.platform=metal siderolink.api=https://omni?jointoken=secret talos.events.sink=[fdae::1]:8090 talos.logging.kernel=tcp://[fdae::1]:8092", schematicID)
+	createMachine("kernel-args-no-cmdline", "", schematicID)
+	createMachine("kernel-args-no-schematic", "console=ttyS0", "")
+	createMachine("kernel-args-unknown-schematic", "console=ttyS0", strings.Repeat("ee", 32))
+
+	resp, err := client.CompareKernelArgs(ctx, &management.CompareKernelArgsRequest{MachineId: "kernel-args"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"console=ttyS0", "net.ifnames=0"}, resp.IntendedArgs)
+	suite.Require().Equal([]string{"net.ifnames=0"}, resp.MissingArgs)
+	suite.Require().Equal([]string{"talos.platform=metal"}, resp.ExtraArgs)
+
+	for _, tt := range []struct {
+		machineID string
+		code      codes.Code
+	}{
+		{machineID: "", code: codes.InvalidArgument},
+		{machineID: "missing", code: codes.NotFound},
+		{machineID: "kernel-args-no-cmdline", code: codes.FailedPrecondition},
+		{machineID: "kernel-args-no-schematic", code: codes.FailedPrecondition},
+		{machineID: "kernel-args-unknown-schematic", code: codes.NotFound},
+	} {
+		_, err = client.CompareKernelArgs(ctx, &management.CompareKernelArgsRequest{MachineId: tt.machineID})
+		suite.Require().Equal(tt.code, status.Code(err), "machine %q", tt.machineID)
+	}
+}
+
+func (suite *GrpcSuite) TestListInvalidSchematicMachines() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	for id, spec := range map[string]*specs.MachineStatusSpec{
+		"invalid-schematic-free": {
+			Schematic: &specs.MachineStatusSpec_Schematic{Invalid: true, Extensions: []string{"gvisor"}},
+		},
+		"invalid-schematic-allocated": {
+			Cluster:   "invalid-schematic",
+			Schematic: &specs.MachineStatusSpec_Schematic{Invalid: true},
+		},
+		"invalid-schematic-valid": {
+			Schematic: &specs.MachineStatusSpec_Schematic{Id: strings.Repeat("aa", 32)},
+		},
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value = spec
+
+		suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	}
+
+	resp, err := client.ListInvalidSchematicMachines(ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	machines := map[string]*management.ListInvalidSchematicMachinesResponse_Machine{}
+
+	for _, machine := range resp.Machines {
+		if strings.HasPrefix(machine.MachineId, "invalid-schematic-") {
+			machines[machine.MachineId] = machine
+		}
+	}
+
+	suite.Require().Len(machines, 2)
+
+	suite.Assert().Equal([]string{"gvisor"}, machines["invalid-schematic-free"].Extensions)
+	suite.Assert().Empty(machines["invalid-schematic-free"].Cluster)
+	suite.Assert().Equal("invalid-schematic", machines["invalid-schematic-allocated"].Cluster)
+}
+
+func (suite *GrpcSuite) TestListSchematicsWithStaleArgs() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	client := management.NewManagementServiceClient(suite.conn)
+
+	params := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	params.TypedSpec().Value.Args = "siderolink.api=grpc://omni.test?jointoken=new talos.events.sink=[fdae::1]:8090"
+
+	suite.Require().NoError(suite.state.Create(ctx, params))
+
+	staleID := strings.Repeat("a1", 32)
+	currentID := strings.Repeat("b2", 32)
+	unknownID := strings.Repeat("c3", 32)
+
+	for id, spec := range map[string]*specs.SchematicSpec{
+		staleID: {
+			Name:            "stale",
+			ExtraKernelArgs: []string{"siderolink.api=grpc://omni.test?jointoken=old", "talos.events.sink=[fdae::1]:8090", "console=ttyS0"},
+		},
+		currentID: {
+			ExtraKernelArgs: []string{"siderolink.api=grpc://omni.test?jointoken=new", "talos.events.sink=[fdae::1]:8090"},
+		},
+		unknownID: {},
+	} {
+		schematic := omni.NewSchematic(resources.DefaultNamespace, id)
+		schematic.TypedSpec().Value = spec
+
+		suite.Require().NoError(suite.state.Create(ctx, schematic))
+	}
+
+	for id, schematicID := range map[string]string{
+		"stale-args-1":       staleID,
+		"stale-args-2":       staleID,
+		"stale-args-current": currentID,
+	} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{Id: schematicID}
+
+		suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	}
+
+	resp, err := client.ListSchematicsWithStaleArgs(ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.Schematics, 1)
+
+	suite.Assert().Equal(staleID, resp.Schematics[0].SchematicId)
+	suite.Assert().Equal("stale", resp.Schematics[0].Name)
+	suite.Assert().Equal([]string{"siderolink.api"}, resp.Schematics[0].StaleArgs)
+	suite.Assert().ElementsMatch([]string{"stale-args-1", "stale-args-2"}, resp.Schematics[0].MachineIds)
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/uuid"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// serviceAccountSystemLabels are the identity labels managed by Omni, they are not exported.
+var serviceAccountSystemLabels = []string{
+	authres.LabelIdentityUserID,
+	authres.LabelIdentityTypeServiceAccount,
+	authres.LabelIdentityInternal,
+}
+
+// ExportServiceAccounts returns all service accounts, except the internal ones, in the form ImportServiceAccounts accepts.
+//
+// Only the public keys are exported, the expired keys are skipped as they can't be imported back.
+func (s *managementServer) ExportServiceAccounts(ctx context.Context, _ *emptypb.Empty) (*management.ExportServiceAccountsResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Admin); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identityList, err := safe.StateListAll[*authres.Identity](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(
+			resource.LabelExists(authres.LabelIdentityTypeServiceAccount),
+			resource.LabelExists(authres.LabelIdentityInternal, resource.NotMatches),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	serviceAccounts := make([]*management.ServiceAccountExport, 0, identityList.Len())
+
+	for iter := identityList.Iterator(); iter.Next(); {
+		identity := iter.Value()
+
+		user, err := safe.StateGet[*authres.User](ctx, s.omniState, authres.NewUser(resources.DefaultNamespace, identity.TypedSpec().Value.UserId).Metadata())
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeyList, err := safe.StateListAll[*authres.PublicKey](
+			ctx,
+			s.omniState,
+			state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, user.Metadata().ID())),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var armoredKeys []string
+
+		for keyIter := publicKeyList.Iterator(); keyIter.Next(); {
+			key := keyIter.Value()
+
+			if !authres.PublicKeyValidUntil(key.TypedSpec().Value).After(now) {
+				continue
+			}
+
+			armoredKeys = append(armoredKeys, string(key.TypedSpec().Value.GetPublicKey()))
+		}
+
+		var labels map[string]string
+
+		for key, value := range identity.Metadata().Labels().Raw() {
+			if slices.Contains(serviceAccountSystemLabels, key) {
+				continue
+			}
+
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels[key] = value
+		}
+
+		serviceAccounts = append(serviceAccounts, &management.ServiceAccountExport{
+			Name:                 strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix),
+			Role:                 user.TypedSpec().Value.GetRole(),
+			Labels:               labels,
+			ArmoredPgpPublicKeys: armoredKeys,
+		})
+	}
+
+	return &management.ExportServiceAccountsResponse{
+		ServiceAccounts: serviceAccounts,
+	}, nil
+}
+
+// ImportServiceAccounts recreates the service accounts returned by ExportServiceAccounts.
+//
+// All service accounts are validated before any of them is created, the service accounts which already exist are skipped.
+func (s *managementServer) ImportServiceAccounts(ctx context.Context, req *management.ImportServiceAccountsRequest) (*management.ImportServiceAccountsResponse, error) {
+	if _, err := s.authCheckMethod(ctx, role.Admin); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if len(req.GetServiceAccounts()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one service account is required")
+	}
+
+	keys := make([][]publicKey, 0, len(req.GetServiceAccounts()))
+	seen := make(map[string]struct{}, len(req.GetServiceAccounts()))
+
+	for _, serviceAccount := range req.GetServiceAccounts() {
+		serviceAccountKeys, err := validateServiceAccountExport(serviceAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[serviceAccount.GetName()]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "service account %q is specified more than once", serviceAccount.GetName())
+		}
+
+		seen[serviceAccount.GetName()] = struct{}{}
+
+		keys = append(keys, serviceAccountKeys)
+	}
+
+	response := &management.ImportServiceAccountsResponse{}
+
+	for i, serviceAccount := range req.GetServiceAccounts() {
+		email := serviceAccount.GetName() + pkgaccess.ServiceAccountNameSuffix
+
+		_, err := s.omniState.Get(ctx, authres.NewIdentity(resources.DefaultNamespace, email).Metadata())
+		if err == nil {
+			response.Skipped = append(response.Skipped, serviceAccount.GetName())
+
+			continue
+		}
+
+		if !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if err = s.importServiceAccount(ctx, serviceAccount, keys[i]); err != nil {
+			return nil, err
+		}
+
+		response.Imported = append(response.Imported, serviceAccount.GetName())
+	}
+
+	return response, nil
+}
+
+// validateServiceAccountExport validates the exported service account, and returns its parsed public keys.
+func validateServiceAccountExport(serviceAccount *management.ServiceAccountExport) ([]publicKey, error) {
+	name := serviceAccount.GetName()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "service account name is required")
+	}
+
+	if _, err := role.Parse(serviceAccount.GetRole()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "service account %q: %s", name, err)
+	}
+
+	if len(serviceAccount.GetArmoredPgpPublicKeys()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "service account %q has no public keys", name)
+	}
+
+	keys := make([]publicKey, 0, len(serviceAccount.GetArmoredPgpPublicKeys()))
+
+	for _, armored := range serviceAccount.GetArmoredPgpPublicKeys() {
+		key, err := validatePGPPublicKey(
+			[]byte(armored),
+			pgp.WithMaxAllowedLifetime(auth.ServiceAccountMaxAllowedLifetime),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "service account %q: invalid PGP public key: %s", name, err)
+		}
+
+		if key.username != name {
+			return nil, status.Errorf(codes.InvalidArgument, "service account %q: PGP public key %q belongs to %q", name, key.id, key.username)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// importServiceAccount creates the resources of the service account the same way CreateServiceAccount does.
+func (s *managementServer) importServiceAccount(ctx context.Context, serviceAccount *management.ServiceAccountExport, keys []publicKey) error {
+	email := serviceAccount.GetName() + pkgaccess.ServiceAccountNameSuffix
+	newUserID := uuid.New().String()
+
+	for _, key := range keys {
+		publicKeyResource := authres.NewPublicKey(resources.DefaultNamespace, key.id)
+		publicKeyResource.Metadata().Labels().Set(authres.LabelPublicKeyUserID, newUserID)
+
+		publicKeyResource.TypedSpec().Value.PublicKey = key.data
+		publicKeyResource.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
+		publicKeyResource.TypedSpec().Value.Role = serviceAccount.GetRole()
+		publicKeyResource.TypedSpec().Value.Confirmed = true
+
+		publicKeyResource.TypedSpec().Value.Identity = &specs.Identity{
+			Email: email,
+		}
+
+		if err := s.omniState.Create(ctx, publicKeyResource); err != nil {
+			return err
+		}
+	}
+
+	user := authres.NewUser(resources.DefaultNamespace, newUserID)
+	user.TypedSpec().Value.Role = serviceAccount.GetRole()
+
+	// the cluster scope is exported as the identity label, but it is enforced using the user label
+	if clusterScope, ok := serviceAccount.GetLabels()[authres.LabelClusterScope]; ok {
+		user.Metadata().Labels().Set(authres.LabelClusterScope, clusterScope)
+	}
+
+	if err := s.omniState.Create(ctx, user); err != nil {
+		return err
+	}
+
+	identity := authres.NewIdentity(resources.DefaultNamespace, email)
+	identity.TypedSpec().Value.UserId = newUserID
+
+	for key, value := range serviceAccount.GetLabels() {
+		// the system labels are never taken from the import
+		if slices.Contains(serviceAccountSystemLabels, key) {
+			continue
+		}
+
+		identity.Metadata().Labels().Set(key, value)
+	}
+
+	identity.Metadata().Labels().Set(authres.LabelIdentityUserID, newUserID)
+	identity.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
+
+	return s.omniState.Create(ctx, identity)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// ValidateUpgradeImageReachability pulls the installer image of the Talos version to each cluster machine, and reports the machines which can't pull it.
+//
+// The image is pulled to the system containerd namespace, the same way the Talos upgrade does, so the successful check also pre-pulls the image.
+func (s *managementServer) ValidateUpgradeImageReachability(ctx context.Context, req *management.ValidateUpgradeImageReachabilityRequest) (*management.ValidateUpgradeImageReachabilityResponse, error) {
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	if _, err := semver.Parse(strings.TrimPrefix(req.GetVersion(), "v")); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Talos version %q: %s", req.GetVersion(), err)
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// pulling the images changes the machines state, so it is equivalent to the upgrade itself
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName)))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.ValidateUpgradeImageReachabilityResponse{
+		Nodes: make([]*management.ValidateUpgradeImageReachabilityResponse_Node, 0, clusterMachines.Len()),
+	}
+
+	addresses := make([]string, 0, clusterMachines.Len())
+
+	for it := clusterMachines.Iterator(); it.Next(); {
+		node, address, err := s.upgradeImageNode(ctx, it.Value().Metadata().ID(), req.GetVersion())
+		if err != nil {
+			return nil, err
+		}
+
+		response.Nodes = append(response.Nodes, node)
+		addresses = append(addresses, address)
+	}
+
+	// the client is only created if there is any machine to check
+	if !slices.ContainsFunc(addresses, func(address string) bool { return address != "" }) {
+		return response, nil
+	}
+
+	talosClient, err := s.clusterTalosClient(ctx, clusterName)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error getting talos client: %s", err)
+	}
+
+	// the errors are reported per node, so the group never fails
+	var eg errgroup.Group
+
+	for i, node := range response.Nodes {
+		if addresses[i] == "" {
+			continue
+		}
+
+		eg.Go(func() error {
+			if err := talosClient.ImagePull(client.WithNode(ctx, addresses[i]), common.ContainerdNamespace_NS_SYSTEM, node.Image); err != nil {
+				node.Error = err.Error()
+
+				return nil
+			}
+
+			node.Reachable = true
+
+			return nil
+		})
+	}
+
+	eg.Wait() //nolint:errcheck
+
+	return response, nil
+}
+
+// upgradeImageNode builds the installer image reference for the cluster machine, and returns the machine address if the machine is ready to pull it.
+func (s *managementServer) upgradeImageNode(ctx context.Context, machineID, version string) (*management.ValidateUpgradeImageReachabilityResponse_Node, string, error) {
+	node := &management.ValidateUpgradeImageReachabilityResponse_Node{
+		MachineId: machineID,
+	}
+
+	machineStatus, err := safe.StateGet[*omnires.MachineStatus](ctx, s.omniState, omnires.NewMachineStatus(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			node.Error = "machine status is not known yet"
+
+			return node, "", nil
+		}
+
+		return nil, "", err
+	}
+
+	// the upgrade keeps the schematic the machine is configured to use
+	schematicID := machineStatus.TypedSpec().Value.GetSchematic().GetId()
+
+	talosVersion, err := safe.StateGet[*omnires.ClusterMachineTalosVersion](ctx, s.omniState, omnires.NewClusterMachineTalosVersion(resources.DefaultNamespace, machineID).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, "", err
+	}
+
+	if talosVersion != nil && talosVersion.TypedSpec().Value.SchematicId != "" {
+		schematicID = talosVersion.TypedSpec().Value.SchematicId
+	}
+
+	if node.Image, err = omnires.GetInstallImage(config.Config.TalosRegistry, config.Config.ImageFactoryBaseURL, schematicID, version); err != nil {
+		return nil, "", err
+	}
+
+	address := machineStatus.TypedSpec().Value.GetManagementAddress()
+
+	if !machineStatus.TypedSpec().Value.GetConnected() || address == "" {
+		node.Error = "machine is not connected"
+
+		return node, "", nil
+	}
+
+	return node, address, nil
+}
+
+// clusterTalosClient returns the Talos client of the cluster.
+func (s *managementServer) clusterTalosClient(ctx context.Context, clusterName string) (*talos.Client, error) {
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return talosRuntime.GetClient(ctx, clusterName)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// WatchUpgradeProgress streams the per-node states of the Talos and Kubernetes upgrades of the cluster.
+//
+// The current state of each node is sent first, then only the state transitions are sent.
+func (s *managementServer) WatchUpgradeProgress(req *management.WatchUpgradeProgressRequest, srv management.ManagementService_WatchUpgradeProgressServer) error {
+	ctx := srv.Context()
+
+	clusterName := req.GetClusterName()
+	if clusterName == "" {
+		return status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// the upgrade progress is derived from the cluster resources
+	if _, err = s.authCheckMethod(ctx, role.Reader); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan state.Event)
+
+	// the watches are started before reading the initial state, so no transition is missed
+	for _, resourceType := range []resource.Type{
+		omnires.ClusterMachineType,
+		omnires.ClusterMachineStatusType,
+		omnires.ClusterMachineConfigStatusType,
+		omnires.ClusterMachineTalosVersionType,
+	} {
+		if err = s.omniState.WatchKind(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, "", resource.VersionUndefined), events,
+			state.WatchWithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName)),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, resourceType := range []resource.Type{
+		omnires.ClusterType,
+		omnires.TalosUpgradeStatusType,
+		omnires.KubernetesUpgradeStatusType,
+		omnires.KubernetesStatusType,
+	} {
+		if err = s.omniState.Watch(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, clusterName, resource.VersionUndefined), events); err != nil {
+			return err
+		}
+	}
+
+	type nodeKey struct {
+		node      string
+		component management.WatchUpgradeProgressResponse_Component
+	}
+
+	sent := map[nodeKey]*management.WatchUpgradeProgressResponse{}
+
+	for {
+		progress, err := s.upgradeProgress(ctx, clusterName)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range progress {
+			key := nodeKey{node: node.Node, component: node.Component}
+
+			if proto.Equal(sent[key], node) {
+				continue
+			}
+
+			if err = srv.Send(node); err != nil {
+				return err
+			}
+
+			sent[key] = node
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if event.Type == state.Errored {
+				return event.Error
+			}
+		}
+	}
+}
+
+// upgradeProgress derives the upgrade states of the cluster nodes.
+//
+// The Talos upgrade state is reported for each cluster machine, the Kubernetes upgrade state is reported for each Kubernetes node.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (s *managementServer) upgradeProgress(ctx context.Context, clusterName string) ([]*management.WatchUpgradeProgressResponse, error) {
+	cluster, err := safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterName))
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachineStatuses, err := listByID[*omnires.ClusterMachineStatus](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	configStatuses, err := listByID[*omnires.ClusterMachineConfigStatus](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	talosVersions, err := listByID[*omnires.ClusterMachineTalosVersion](ctx, s.omniState, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	kubernetesUpgradeStatus, err := safe.StateGet[*omnires.KubernetesUpgradeStatus](ctx, s.omniState,
+		omnires.NewKubernetesUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	kubernetesStatus, err := safe.StateGet[*omnires.KubernetesStatus](ctx, s.omniState, omnires.NewKubernetesStatus(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	var progress []*management.WatchUpgradeProgressResponse
+
+	talosVersion := cluster.TypedSpec().Value.TalosVersion
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		id := iter.Value().Metadata().ID()
+
+		node := &management.WatchUpgradeProgressResponse{
+			Component: management.WatchUpgradeProgressResponse_TALOS,
+			Node:      id,
+			Version:   talosVersion,
+		}
+
+		configStatus := configStatuses[id]
+		machineTalosVersion := talosVersions[id]
+		machineStatus := clusterMachineStatuses[id]
+
+		// the upgrade controller sets the desired version of the machines one by one, the config status reports the applied version
+		switch {
+		case configStatus != nil && configStatus.TypedSpec().Value.TalosVersion == talosVersion:
+			node.State = management.WatchUpgradeProgressResponse_DONE
+		case configStatus != nil && configStatus.TypedSpec().Value.LastConfigError != "":
+			node.State = management.WatchUpgradeProgressResponse_FAILED
+			node.Error = configStatus.TypedSpec().Value.LastConfigError
+		case machineTalosVersion != nil && machineTalosVersion.TypedSpec().Value.TalosVersion == talosVersion,
+			machineStatus != nil && machineStatus.TypedSpec().Value.Stage == specs.ClusterMachineStatusSpec_UPGRADING:
+			node.State = management.WatchUpgradeProgressResponse_UPGRADING
+		default:
+			node.State = management.WatchUpgradeProgressResponse_PENDING
+		}
+
+		progress = append(progress, node)
+	}
+
+	if kubernetesStatus == nil {
+		return progress, nil
+	}
+
+	kubernetesVersion := cluster.TypedSpec().Value.KubernetesVersion
+
+	staticPods := map[string][]*specs.KubernetesStatusSpec_StaticPodStatus{}
+
+	for _, nodePods := range kubernetesStatus.TypedSpec().Value.StaticPods {
+		staticPods[nodePods.Nodename] = nodePods.StaticPods
+	}
+
+	for _, nodeStatus := range kubernetesStatus.TypedSpec().Value.Nodes {
+		node := &management.WatchUpgradeProgressResponse{
+			Component: management.WatchUpgradeProgressResponse_KUBERNETES,
+			Node:      nodeStatus.Nodename,
+			Version:   kubernetesVersion,
+		}
+
+		// the control plane static pods are upgraded first, then the kubelet
+		versions := []string{nodeStatus.KubeletVersion}
+
+		for _, pod := range staticPods[nodeStatus.Nodename] {
+			versions = append(versions, pod.Version)
+		}
+
+		var upgraded int
+
+		for _, version := range versions {
+			if version == kubernetesVersion {
+				upgraded++
+			}
+		}
+
+		switch {
+		case upgraded == len(versions):
+			node.State = management.WatchUpgradeProgressResponse_DONE
+		case kubernetesUpgradeStatus != nil && kubernetesUpgradeStatus.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Failed:
+			node.State = management.WatchUpgradeProgressResponse_FAILED
+			node.Error = kubernetesUpgradeStatus.TypedSpec().Value.Error
+		case upgraded > 0:
+			node.State = management.WatchUpgradeProgressResponse_UPGRADING
+		default:
+			node.State = management.WatchUpgradeProgressResponse_PENDING
+		}
+
+		progress = append(progress, node)
+	}
+
+	return progress, nil
+}
+
+// listByID lists the resources and indexes them by ID.
+func listByID[T generic.ResourceWithRD](ctx context.Context, st state.State, opts ...state.ListOption) (map[resource.ID]T, error) {
+	list, err := safe.StateListAll[T](ctx, st, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[resource.ID]T, list.Len())
+
+	for iter := list.Iterator(); iter.Next(); {
+		byID[iter.Value().Metadata().ID()] = iter.Value()
+	}
+
+	return byID, nil
+}
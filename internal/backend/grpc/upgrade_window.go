@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// SetKubernetesUpgradeWindow sets the time window the Kubernetes upgrades of the cluster are allowed to roll out in.
+func (s *managementServer) SetKubernetesUpgradeWindow(ctx context.Context, req *management.SetKubernetesUpgradeWindowRequest) (*emptypb.Empty, error) {
+	ctx, err := s.checkUpgradeWindowAccess(ctx, req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &specs.KubernetesUpgradeWindowSpec{
+		Days:     req.GetDays(),
+		Start:    req.GetStart(),
+		Duration: req.GetDuration(),
+	}
+
+	if err = omnires.ValidateKubernetesUpgradeWindow(spec); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = createOrUpdate(ctx, s.omniState, omnires.NewKubernetesUpgradeWindow(resources.DefaultNamespace, req.GetClusterName()), func(res *omnires.KubernetesUpgradeWindow) error {
+		res.Metadata().Labels().Set(omnires.LabelCluster, req.GetClusterName())
+
+		res.TypedSpec().Value = spec
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteKubernetesUpgradeWindow removes the upgrade window of the cluster, so that the upgrades are allowed at any time.
+func (s *managementServer) DeleteKubernetesUpgradeWindow(ctx context.Context, req *management.DeleteKubernetesUpgradeWindowRequest) (*emptypb.Empty, error) {
+	ctx, err := s.checkUpgradeWindowAccess(ctx, req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.omniState.Destroy(ctx, omnires.NewKubernetesUpgradeWindow(resources.DefaultNamespace, req.GetClusterName()).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q has no upgrade window", req.GetClusterName())
+		}
+
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *managementServer) checkUpgradeWindowAccess(ctx context.Context, clusterName string) (context.Context, error) {
+	if clusterName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// changing the upgrade window is equivalent to changing the cluster
+	if _, err = s.authCheckMethod(ctx, role.Operator); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if _, err = safe.StateGet[*omnires.Cluster](ctx, s.omniState, omnires.NewCluster(resources.DefaultNamespace, clusterName).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterName)
+		}
+
+		return nil, err
+	}
+
+	return ctx, nil
+}
@@ -23,6 +23,10 @@ type claims struct {
 	// ClusterUUID is the UUID of the cluster.
 	ClusterUUID string `json:"cluster_uuid"`
 
+	// KubeconfigExpiresAt is set if the token was issued for the time-limited kubeconfig.
+	// The token is rejected after that time even if it is not expired yet.
+	KubeconfigExpiresAt *jwt.NumericDate `json:"kubeconfig_expires_at,omitempty"`
+
 	// Groups are the groups the subject belongs to.
 	Groups []string `json:"groups"`
 }
@@ -36,6 +40,10 @@ func (claims *claims) Valid() error {
 		return jwt.NewValidationError("expiration is empty", jwt.ValidationErrorClaimsInvalid)
 	}
 
+	if claims.KubeconfigExpiresAt != nil && !jwt.TimeFunc().Before(claims.KubeconfigExpiresAt.Time) {
+		return jwt.NewValidationError("kubeconfig has expired", jwt.ValidationErrorExpired)
+	}
+
 	if claims.Cluster == "" {
 		return jwt.NewValidationError("cluster is empty", jwt.ValidationErrorClaimsInvalid)
 	}
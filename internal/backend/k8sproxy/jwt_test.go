@@ -101,6 +101,20 @@ func TestJWTValidation(t *testing.T) {
 			},
 			expectedErrorContains: "token is not valid yet",
 		},
+		{
+			name: "kubeconfig-expired",
+			claims: k8sproxy.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    "issuer",
+					Subject:   "subject-a",
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				},
+				Cluster:             "cluster-a",
+				KubeconfigExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+				Groups:              []string{"group-a"},
+			},
+			expectedErrorContains: "kubeconfig has expired",
+		},
 		{
 			name: "valid",
 			claims: k8sproxy.Claims{
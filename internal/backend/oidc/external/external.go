@@ -14,6 +14,9 @@ const DefaultClientID = "native"
 // ScopeClusterPrefix defines the scope prefix to specify cluster name.
 const ScopeClusterPrefix = "cluster:"
 
+// ScopeKubeconfigExpiresPrefix defines the scope prefix to specify the expiration of the kubeconfig as a Unix timestamp.
+const ScopeKubeconfigExpiresPrefix = "kubeconfig-expires:"
+
 // OIDCTokenLifetime specifies the lifetime of the JWT token.
 const OIDCTokenLifetime = 12 * time.Hour
 
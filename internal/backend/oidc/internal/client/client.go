@@ -102,7 +102,7 @@ func (Client) RestrictAdditionalAccessTokenScopes() func(scopes []string) []stri
 // IsScopeAllowed enables Client specific custom scopes validation
 // in this example we allow the CustomScope for all clients.
 func (Client) IsScopeAllowed(scope string) bool {
-	return strings.HasPrefix(scope, external.ScopeClusterPrefix)
+	return strings.HasPrefix(scope, external.ScopeClusterPrefix) || strings.HasPrefix(scope, external.ScopeKubeconfigExpiresPrefix)
 }
 
 // IDTokenUserinfoClaimsAssertion allows specifying if claims of scope profile, email, phone and address are asserted into the id_token
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +37,9 @@ import (
 // Lifetime of the token.
 const Lifetime = 5 * time.Minute
 
+// kubeconfigExpiresAtClaim is the claim which limits the lifetime of the kubeconfig the token was issued for.
+const kubeconfigExpiresAtClaim = "kubeconfig_expires_at"
+
 // Storage implements storing and handling OIDC access tokens, handling roles and claims.
 type Storage struct {
 	clock  clock.Clock
@@ -239,12 +243,35 @@ func (s *Storage) setUserinfo(ctx context.Context, userInfo oidc.UserInfoSetter,
 
 			userInfo.AppendClaims("cluster", cluster)
 			userInfo.AppendClaims("groups", impersonateGroups)
+		case strings.HasPrefix(currentScope, external.ScopeKubeconfigExpiresPrefix):
+			expiresAt, err := s.kubeconfigExpiration(currentScope)
+			if err != nil {
+				return err
+			}
+
+			userInfo.AppendClaims(kubeconfigExpiresAtClaim, expiresAt.Unix())
 		}
 	}
 
 	return nil
 }
 
+// kubeconfigExpiration parses the kubeconfig expiration scope, the tokens are not issued for the expired kubeconfigs.
+func (s *Storage) kubeconfigExpiration(scope string) (time.Time, error) {
+	timestamp, err := strconv.ParseInt(scope[len(external.ScopeKubeconfigExpiresPrefix):], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid kubeconfig expiration: %w", err)
+	}
+
+	expiresAt := time.Unix(timestamp, 0)
+
+	if !s.clock.Now().Before(expiresAt) {
+		return time.Time{}, errors.New("kubeconfig has expired")
+	}
+
+	return expiresAt, nil
+}
+
 func (s *Storage) getImpersonateGroups(ctx context.Context, cluster, userID string) ([]string, error) {
 	groupSet := map[string]struct{}{}
 
@@ -350,6 +377,15 @@ func (s *Storage) GetPrivateClaimsFromScopes(ctx context.Context, userID, _ stri
 			claims["cluster"] = cluster
 			claims["groups"] = impersonateGroups
 		}
+
+		if strings.HasPrefix(scope, external.ScopeKubeconfigExpiresPrefix) {
+			expiresAt, err := s.kubeconfigExpiration(scope)
+			if err != nil {
+				return nil, err
+			}
+
+			claims[kubeconfigExpiresAtClaim] = expiresAt.Unix()
+		}
 	}
 
 	return claims, nil
@@ -365,7 +401,7 @@ func (s *Storage) ValidateJWTProfileScopes(_ context.Context, _ string, scopes [
 		switch {
 		case scope == oidc.ScopeOpenID:
 			allowedScopes = append(allowedScopes, scope)
-		case strings.HasPrefix(scope, external.ScopeClusterPrefix):
+		case strings.HasPrefix(scope, external.ScopeClusterPrefix), strings.HasPrefix(scope, external.ScopeKubeconfigExpiresPrefix):
 			allowedScopes = append(allowedScopes, scope)
 		}
 	}
@@ -311,3 +311,40 @@ func TestTerminateSession(t *testing.T) {
 	err = s.SetUserinfoFromToken(ctx, nil, tokenID, "", "")
 	assert.Error(t, err)
 }
+
+func TestKubeconfigExpirationScope(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	clk := clock.NewMock()
+	clk.Set(time.Unix(1000, 0))
+
+	s := token.NewStorage(clk, state.WrapCore(namespaced.NewState(inmem.Build)))
+
+	scopes := []string{
+		oidc.ScopeOpenID,
+		external.ScopeKubeconfigExpiresPrefix + "2000",
+	}
+
+	claims, err := s.GetPrivateClaimsFromScopes(ctx, "test-user", "", scopes)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"kubeconfig_expires_at": int64(2000),
+	}, claims)
+
+	var mockUserInfo mockUserInfoSetter
+
+	require.NoError(t, s.SetUserinfoFromScopes(ctx, &mockUserInfo, "test-user", "", scopes))
+	assert.Equal(t, int64(2000), mockUserInfo.claims["kubeconfig_expires_at"])
+
+	clk.Add(time.Hour)
+
+	_, err = s.GetPrivateClaimsFromScopes(ctx, "test-user", "", scopes)
+	require.ErrorContains(t, err, "kubeconfig has expired")
+
+	require.ErrorContains(t, s.SetUserinfoFromScopes(ctx, &mockUserInfo, "test-user", "", scopes), "kubeconfig has expired")
+
+	_, err = s.GetPrivateClaimsFromScopes(ctx, "test-user", "", []string{external.ScopeKubeconfigExpiresPrefix + "soon"})
+	require.ErrorContains(t, err, "invalid kubeconfig expiration")
+}
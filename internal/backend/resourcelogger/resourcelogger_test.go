@@ -167,9 +167,9 @@ func TestResourceLogger(t *testing.T) {
          addresses:
              - 1.2.3.4
              - 5.6.7.8
-@@ -25,10 +25,10 @@
-               linkup: true
-               description: hello
+@@ -28,10 +28,10 @@
+               downsince: null
+         siderolinkaddress: ""
      lasterror: ""
 -    managementaddress: some-address
 +    managementaddress: some-address-updated
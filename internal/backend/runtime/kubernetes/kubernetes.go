@@ -256,13 +256,18 @@ users:
         - --oidc-issuer-url={{ .EndpointOIDC }}
         - --oidc-client-id={{ .ClientID }}
         - --oidc-extra-scope=cluster:{{ .ClusterName }}
+{{- if .ExpiresAt }}
+        - --oidc-extra-scope=kubeconfig-expires:{{ .ExpiresAt }}
+{{- end }}
       command: kubectl
       env: null
       provideClusterInfo: false
 `) + "\n"
 
 // GetOIDCKubeconfig generates kubeconfig for the user using Omni as OIDC Proxy.
-func (r *Runtime) GetOIDCKubeconfig(context *common.Context, identity string) ([]byte, error) {
+//
+// If expiresAt is set, Omni stops issuing the tokens for the kubeconfig and rejects the issued tokens after that time.
+func (r *Runtime) GetOIDCKubeconfig(context *common.Context, identity string, expiresAt time.Time) ([]byte, error) {
 	tmpl, err := template.New("kubeconfig").Parse(oidcKubeConfigTemplate)
 	if err != nil {
 		return nil, err
@@ -275,15 +280,16 @@ func (r *Runtime) GetOIDCKubeconfig(context *common.Context, identity string) ([
 		return nil, err
 	}
 
-	if err = tmpl.Execute(&buf, struct {
+	data := struct {
 		InstanceName string
 		ClusterName  string
 
 		KubernetesProxyEndpoint string
 		EndpointOIDC            string
 
-		ClientID string
-		Identity string
+		ClientID  string
+		Identity  string
+		ExpiresAt int64
 	}{
 		InstanceName: config.Config.Name,
 		ClusterName:  context.Name,
@@ -293,7 +299,13 @@ func (r *Runtime) GetOIDCKubeconfig(context *common.Context, identity string) ([
 
 		ClientID: external.DefaultClientID,
 		Identity: identity,
-	}); err != nil {
+	}
+
+	if !expiresAt.IsZero() {
+		data.ExpiresAt = expiresAt.Unix()
+	}
+
+	if err = tmpl.Execute(&buf, data); err != nil {
 		return nil, err
 	}
 
@@ -9,6 +9,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
@@ -49,21 +50,31 @@ var oidcKubeconfig1 []byte
 //go:embed testdata/oidc-kubeconfig2.yaml
 var oidcKubeconfig2 []byte
 
+//go:embed testdata/oidc-kubeconfig3.yaml
+var oidcKubeconfig3 []byte
+
 func TestOIDCKubeconfig(t *testing.T) {
 	r, err := kubernetes.New(nil)
 	require.NoError(t, err)
 
 	kubeconfig, err := r.GetOIDCKubeconfig(&common.Context{
 		Name: "cluster1",
-	}, "test@example.com")
+	}, "test@example.com", time.Time{})
 	require.NoError(t, err)
 
 	assert.Equal(t, string(oidcKubeconfig1), string(kubeconfig))
 
 	kubeconfig, err = r.GetOIDCKubeconfig(&common.Context{
 		Name: "cluster1",
-	}, "")
+	}, "", time.Time{})
 	require.NoError(t, err)
 
 	assert.Equal(t, string(oidcKubeconfig2), string(kubeconfig))
+
+	kubeconfig, err = r.GetOIDCKubeconfig(&common.Context{
+		Name: "cluster1",
+	}, "test@example.com", time.Unix(1700000000, 0))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(oidcKubeconfig3), string(kubeconfig))
 }
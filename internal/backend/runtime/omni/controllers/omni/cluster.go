@@ -85,6 +85,12 @@ func NewClusterController() *ClusterController {
 					cleanup.RemoveOutputs[*omni.SchematicConfiguration](func(cluster *omni.Cluster) state.ListOption {
 						return state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
 					}),
+					cleanup.RemoveOutputs[*omni.KubernetesManifestSyncStatus](func(cluster *omni.Cluster) state.ListOption {
+						return state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
+					}),
+					cleanup.RemoveOutputs[*omni.KubernetesUpgradeWindow](func(cluster *omni.Cluster) state.ListOption {
+						return state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
+					}),
 					cleanup.HasNoOutputs[*omni.ClusterMachine](func(cluster *omni.Cluster) state.ListOption {
 						return state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
 					}),
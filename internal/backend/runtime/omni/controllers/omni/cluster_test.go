@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/siderolabs/go-retry/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -81,6 +82,34 @@ func (suite *ClusterSuite) TestReconcile() {
 		}))
 }
 
+func (suite *ClusterSuite) TestRemoveUpgradeWindow() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterController()))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "upgrade-window")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	window := omni.NewKubernetesUpgradeWindow(resources.DefaultNamespace, cluster.Metadata().ID())
+	window.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, window))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertions *assert.Assertions) {
+		assertions.False(res.Metadata().Finalizers().Empty())
+	})
+
+	_, err := suite.state.Teardown(suite.ctx, cluster.Metadata())
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.KubernetesUpgradeWindow](suite.ctx, suite.T(), suite.state, window.Metadata().ID())
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertions *assert.Assertions) {
+		assertions.True(res.Metadata().Finalizers().Empty())
+	})
+}
+
 func TestClusterSuite(t *testing.T) {
 	suite.Run(t, new(ClusterSuite))
 }
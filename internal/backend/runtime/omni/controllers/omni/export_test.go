@@ -6,10 +6,14 @@
 package omni
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/siderolabs/talos/pkg/machinery/compatibility"
 	"github.com/siderolabs/talos/pkg/machinery/config"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
@@ -28,3 +32,18 @@ func GetMachineSetNodeSortFunction(machineStatuses map[resource.ID]*omni.Machine
 func StripTalosAPIAccessOSAdminRole(cfg config.Provider) (config.Provider, error) {
 	return stripTalosAPIAccessOSAdminRole(cfg)
 }
+
+func DNSStatus(pods []*corev1.Pod) *specs.KubernetesStatusSpec_DNSStatus {
+	return dnsStatus(pods)
+}
+
+func TrackNetworkLinksDown(previous, current []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus, now time.Time,
+) []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus {
+	return trackNetworkLinksDown(previous, current, now)
+}
+
+func HardwareChanges(previous *specs.MachineStatusSpec_HardwareStatus, processors []*specs.MachineStatusSpec_HardwareStatus_Processor,
+	memoryModules []*specs.MachineStatusSpec_HardwareStatus_MemoryModule, blockdevices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice,
+) []string {
+	return hardwareChanges(previous, processors, memoryModules, blockdevices)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ImportedMachineController creates omni.Machines for the machines imported from the clusters not managed by Omni.
+type ImportedMachineController = qtransform.QController[*omni.ImportedMachine, *omni.Machine]
+
+// NewImportedMachineController instantiates the imported machine controller.
+func NewImportedMachineController() *ImportedMachineController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ImportedMachine, *omni.Machine]{
+			Name: "ImportedMachineController",
+			MapMetadataFunc: func(importedMachine *omni.ImportedMachine) *omni.Machine {
+				return omni.NewMachine(resources.DefaultNamespace, importedMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(machine *omni.Machine) *omni.ImportedMachine {
+				return omni.NewImportedMachine(resources.DefaultNamespace, machine.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, importedMachine *omni.ImportedMachine, machine *omni.Machine) error {
+				name, _ := importedMachine.Metadata().Labels().Get(omni.LabelExternallyManaged)
+
+				// the machine is reachable as long as there are credentials to access it, the poller reports the errors otherwise
+				talosConfig, err := safe.ReaderGetByID[*omni.ImportedTalosConfig](ctx, r, name)
+				if err != nil && !state.IsNotFoundError(err) {
+					return err
+				}
+
+				spec := machine.TypedSpec().Value
+
+				spec.ManagementAddress = importedMachine.TypedSpec().Value.ManagementAddress
+				spec.Connected = talosConfig != nil && talosConfig.Metadata().Phase() == resource.PhaseRunning
+
+				machine.Metadata().Labels().Set(omni.MachineAddressLabel, spec.ManagementAddress)
+				machine.Metadata().Labels().Set(omni.LabelExternallyManaged, name)
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, talosConfig *omni.ImportedTalosConfig) ([]resource.Pointer, error) {
+				importedMachines, err := safe.ReaderListAll[*omni.ImportedMachine](ctx, r, state.WithLabelQuery(
+					resource.LabelEqual(omni.LabelExternallyManaged, talosConfig.Metadata().ID()),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				return safe.Map(importedMachines, func(importedMachine *omni.ImportedMachine) (resource.Pointer, error) {
+					return importedMachine.Metadata(), nil
+				})
+			},
+		),
+		// the machines connected over SideroLink are managed by the MachineController
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ImportedMachineSuite struct {
+	OmniSuite
+}
+
+func (suite *ImportedMachineSuite) TestReconcile() {
+	require := suite.Require()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewMachineController()))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewImportedMachineController()))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewImportedTalosConfigController()))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewTalosConfigController(time.Hour)))
+
+	importedMachine := omni.NewImportedMachine(resources.DefaultNamespace, "imported")
+	importedMachine.Metadata().Labels().Set(omni.LabelExternallyManaged, "dev")
+	importedMachine.TypedSpec().Value.ManagementAddress = "10.5.0.2"
+
+	require.NoError(suite.state.Create(suite.ctx, importedMachine))
+
+	// the machine can't be polled until the talosconfig is imported
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"imported"}, func(res *omni.Machine, assert *assert.Assertions) {
+		assert.Equal("10.5.0.2", res.TypedSpec().Value.ManagementAddress)
+		assert.False(res.TypedSpec().Value.Connected)
+
+		name, _ := res.Metadata().Labels().Get(omni.LabelExternallyManaged)
+		assert.Equal("dev", name)
+	})
+
+	importedTalosConfig := omni.NewImportedTalosConfig(resources.DefaultNamespace, "dev")
+	importedTalosConfig.TypedSpec().Value.Ca = "ca"
+	importedTalosConfig.TypedSpec().Value.Crt = "crt"
+	importedTalosConfig.TypedSpec().Value.Key = "key"
+
+	require.NoError(suite.state.Create(suite.ctx, importedTalosConfig))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ImportedTalosConfigID("dev")}, func(res *omni.TalosConfig, assert *assert.Assertions) {
+		assert.Equal("ca", res.TypedSpec().Value.Ca)
+		assert.Equal("crt", res.TypedSpec().Value.Crt)
+		assert.Equal("key", res.TypedSpec().Value.Key)
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"imported"}, func(res *omni.Machine, assert *assert.Assertions) {
+		assert.True(res.TypedSpec().Value.Connected)
+	})
+
+	// the cluster with the same name as the import gets its own talosconfig
+	cluster := omni.NewCluster(resources.DefaultNamespace, "dev")
+	cluster.TypedSpec().Value.TalosVersion = "1.2.0"
+
+	require.NoError(suite.state.Create(suite.ctx, cluster))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.ControlPlanesResourceID(cluster.Metadata().ID()))
+	machineSet.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+	require.NoError(suite.state.Create(suite.ctx, machineSet))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"dev"}, func(res *omni.TalosConfig, assert *assert.Assertions) {
+		assert.NotEqual("ca", res.TypedSpec().Value.Ca)
+	})
+
+	// the machines connected over SideroLink are still managed by the machine controller
+	link := siderolink.NewLink(resources.DefaultNamespace, "connected", &specs.SiderolinkSpec{
+		NodeSubnet: netip.MustParsePrefix("fdae:41e4:649b:9303:7396:c9b3:213a:a86f/64").String(),
+		Connected:  true,
+	})
+
+	require.NoError(suite.state.Create(suite.ctx, link))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"connected"}, func(res *omni.Machine, assert *assert.Assertions) {
+		assert.Equal("fdae:41e4:649b:9303:7396:c9b3:213a:a86f", res.TypedSpec().Value.ManagementAddress)
+		assert.True(res.TypedSpec().Value.Connected)
+	})
+
+	rtestutils.Destroy[*omni.ImportedTalosConfig](suite.ctx, suite.T(), suite.state, []resource.ID{"dev"})
+
+	rtestutils.AssertNoResource[*omni.TalosConfig](suite.ctx, suite.T(), suite.state, omni.ImportedTalosConfigID("dev"))
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"dev"}, func(*omni.TalosConfig, *assert.Assertions) {})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"imported"}, func(res *omni.Machine, assert *assert.Assertions) {
+		assert.False(res.TypedSpec().Value.Connected)
+	})
+
+	rtestutils.Destroy[*omni.ImportedMachine](suite.ctx, suite.T(), suite.state, []resource.ID{"imported"})
+
+	rtestutils.AssertNoResource[*omni.Machine](suite.ctx, suite.T(), suite.state, "imported")
+}
+
+func TestImportedMachineSuite(t *testing.T) {
+	suite.Run(t, new(ImportedMachineSuite))
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ImportedTalosConfigController creates omni.TalosConfigs the imported machines are accessed with.
+//
+// The TalosConfig ID is built from the import name, so it never collides with the TalosConfig of a cluster.
+type ImportedTalosConfigController = qtransform.QController[*omni.ImportedTalosConfig, *omni.TalosConfig]
+
+// NewImportedTalosConfigController instantiates the imported talosconfig controller.
+func NewImportedTalosConfigController() *ImportedTalosConfigController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ImportedTalosConfig, *omni.TalosConfig]{
+			Name: "ImportedTalosConfigController",
+			MapMetadataFunc: func(importedTalosConfig *omni.ImportedTalosConfig) *omni.TalosConfig {
+				return omni.NewTalosConfig(resources.DefaultNamespace, omni.ImportedTalosConfigID(importedTalosConfig.Metadata().ID()))
+			},
+			UnmapMetadataFunc: func(talosConfig *omni.TalosConfig) *omni.ImportedTalosConfig {
+				return omni.NewImportedTalosConfig(resources.DefaultNamespace, strings.TrimSuffix(talosConfig.Metadata().ID(), omni.ImportedTalosConfigIDSuffix))
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, _ *zap.Logger, importedTalosConfig *omni.ImportedTalosConfig, talosConfig *omni.TalosConfig) error {
+				talosConfig.Metadata().Labels().Set(omni.LabelExternallyManaged, importedTalosConfig.Metadata().ID())
+
+				talosConfig.TypedSpec().Value.Ca = importedTalosConfig.TypedSpec().Value.Ca
+				talosConfig.TypedSpec().Value.Crt = importedTalosConfig.TypedSpec().Value.Crt
+				talosConfig.TypedSpec().Value.Key = importedTalosConfig.TypedSpec().Value.Key
+
+				return nil
+			},
+		},
+		// the talosconfigs of the clusters are managed by the TalosConfigController
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
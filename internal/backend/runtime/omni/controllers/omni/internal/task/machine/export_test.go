@@ -0,0 +1,16 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"context"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func (spec CollectTaskSpec) Poll(ctx context.Context, c *client.Client, pollers []string) (Info, error) {
+	return spec.poll(ctx, c, pollers)
+}
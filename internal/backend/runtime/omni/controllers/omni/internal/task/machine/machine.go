@@ -21,6 +21,7 @@ import (
 	"github.com/siderolabs/gen/channel"
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/talos/pkg/machinery/client"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -46,17 +47,32 @@ type Info struct { //nolint:govet
 	NetworkLinks    []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus
 	ImageLabels     map[string]string
 
+	// SideroLinkAddress is only collected if IncludeSideroLinkAddress is set.
+	SideroLinkAddress string
+
 	Processors    []*specs.MachineStatusSpec_HardwareStatus_Processor
 	MemoryModules []*specs.MachineStatusSpec_HardwareStatus_MemoryModule
 	Blockdevices  []*specs.MachineStatusSpec_HardwareStatus_BlockDevice
+	Firmware      *specs.MachineStatusSpec_HardwareStatus_Firmware
 
 	PlatformMetadata *specs.MachineStatusSpec_PlatformMetadata
 	Schematic        *specs.MachineStatusSpec_Schematic
+	KernelCmdline    *string
+	BootStatus       *specs.MachineStatusSpec_BootStatus
+	ClockStatus      *specs.MachineStatusSpec_ClockStatus
+	EncryptionStatus *specs.MachineStatusSpec_EncryptionStatus
+	InstallImage     *specs.MachineStatusSpec_InstallImage
+	RebootStatus     *specs.MachineStatusSpec_RebootStatus
+
+	// PollStatuses contains the outcome for each poller which was run.
+	PollStatuses map[string]specs.MachineStatusSpec_PollStatus
 
 	LastError       error
 	MachineID       string
 	MaintenanceMode bool
 	NoAccess        bool
+
+	IncludeSideroLinkAddress bool
 }
 
 // InfoChan is a channel for sending machine info from tasks back to the controller.
@@ -72,6 +88,9 @@ type CollectTaskSpec struct {
 	MachineID     string
 
 	MaintenanceMode bool
+
+	// IncludeSideroLinkAddress enables collecting the SideroLink address of the machine.
+	IncludeSideroLinkAddress bool
 }
 
 func resourceEqual[T any, S interface {
@@ -93,7 +112,7 @@ func resourceEqual[T any, S interface {
 //
 // If the task spec changes, the task will be restarted.
 func (spec CollectTaskSpec) Equal(other CollectTaskSpec) bool {
-	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode {
+	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode || spec.IncludeSideroLinkAddress != other.IncludeSideroLinkAddress {
 		return false
 	}
 
@@ -176,13 +195,21 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, _ *zap.Logger, notifyCh
 	defer cancel()
 
 	const (
-		disksPollInterval = 5 * time.Minute
-		minPolInterval    = time.Second
+		disksPollInterval        = 5 * time.Minute
+		clockPollInterval        = 5 * time.Minute
+		rebootStatusPollInterval = 5 * time.Minute
+		minPolInterval           = time.Second
 	)
 
 	disksTicker := time.NewTicker(disksPollInterval)
 	defer disksTicker.Stop()
 
+	clockTicker := time.NewTicker(clockPollInterval)
+	defer clockTicker.Stop()
+
+	rebootStatusTicker := time.NewTicker(rebootStatusPollInterval)
+	defer rebootStatusTicker.Stop()
+
 	pollTicker := time.NewTicker(minPolInterval)
 	defer pollTicker.Stop()
 
@@ -234,6 +261,9 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, _ *zap.Logger, notifyCh
 		hardware.MemoryModuleType: {
 			namespace: hardware.NamespaceName,
 		},
+		hardware.SystemInformationType: {
+			namespace: hardware.NamespaceName,
+		},
 		runtime.PlatformMetadataType: {
 			namespace: runtime.NamespaceName,
 		},
@@ -243,6 +273,17 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, _ *zap.Logger, notifyCh
 		runtime.ExtensionStatusType: {
 			namespace: runtime.NamespaceName,
 		},
+		runtime.MountStatusType: {
+			namespace:  runtime.NamespaceName,
+			filterFunc: typedFilter(filterMountStatuses),
+		},
+		configres.MachineConfigType: {
+			namespace:  configres.NamespaceName,
+			filterFunc: typedFilter(filterMachineConfigs),
+		},
+		runtime.MachineStatusType: {
+			namespace: runtime.NamespaceName,
+		},
 	}
 
 	for resourceType, watcher := range watchers {
@@ -250,6 +291,11 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, _ *zap.Logger, notifyCh
 			continue
 		}
 
+		// the machine config and status are not accessible in the maintenance mode
+		if (resourceType == configres.MachineConfigType || resourceType == runtime.MachineStatusType) && spec.MaintenanceMode {
+			continue
+		}
+
 		if err = c.COSI.WatchKind(ctx, resource.NewMetadata(watcher.namespace, resourceType, "", resource.VersionUndefined), watchCh); err != nil {
 			return fmt.Errorf("error watching COSI resource: %w", err)
 		}
@@ -293,6 +339,14 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, _ *zap.Logger, notifyCh
 			case <-disksTicker.C:
 				// poll disks as we have no way to watch for changes
 				dirtyPollers["disks"] = struct{}{}
+			case <-clockTicker.C:
+				// the clock drifts without any events
+				dirtyPollers["clock"] = struct{}{}
+			case <-rebootStatusTicker.C:
+				// the staged config is written to the disk without any events
+				if _, registered := registeredTypes[runtime.MachineStatusType]; registered {
+					dirtyPollers[runtime.MachineStatusType] = struct{}{}
+				}
 			case <-pollTicker.C:
 				break waitLoop
 			case event := <-watchCh:
@@ -323,15 +377,31 @@ func (spec CollectTaskSpec) poll(ctx context.Context, c *client.Client, pollers
 		MaintenanceMode: spec.MaintenanceMode,
 		// set this early to make pollers act on the machine labels
 		MachineLabels: spec.MachineLabels,
+		// set this early to make pollers collect the SideroLink address
+		IncludeSideroLinkAddress: spec.IncludeSideroLinkAddress,
+		PollStatuses:             make(map[string]specs.MachineStatusSpec_PollStatus, len(pollers)),
 	}
 
+	var pollErrors []error
+
+	// a failing poller doesn't stop the others, so the data they gathered is still reported
 	for _, poller := range pollers {
 		if err := poll(ctx, poller, c, &info); err != nil {
-			return info, err
+			info.PollStatuses[poller] = specs.MachineStatusSpec_ERROR
+
+			pollErrors = append(pollErrors, fmt.Errorf("poller %q failed: %w", poller, err))
+
+			continue
+		}
+
+		if pollerHasData(poller, &info) {
+			info.PollStatuses[poller] = specs.MachineStatusSpec_DATA
+		} else {
+			info.PollStatuses[poller] = specs.MachineStatusSpec_EMPTY
 		}
 	}
 
-	return info, nil
+	return info, errors.Join(pollErrors...)
 }
 
 var insecureTLSConfig = &tls.Config{
@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	machinetask "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/task/machine"
+)
+
+type machineService struct {
+	machine.UnimplementedMachineServiceServer
+	storage.UnimplementedStorageServiceServer
+}
+
+func (machineService) Disks(context.Context, *emptypb.Empty) (*storage.DisksResponse, error) {
+	return nil, status.Error(codes.Internal, "disks are not available")
+}
+
+func (machineService) Version(context.Context, *emptypb.Empty) (*machine.VersionResponse, error) {
+	return &machine.VersionResponse{
+		Messages: []*machine.Version{
+			{
+				Version: &machine.VersionInfo{
+					Tag: "v1.7.0",
+				},
+			},
+		},
+	}, nil
+}
+
+func TestPollPartialFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	socketPath := filepath.Join(t.TempDir(), "machine.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+
+	machine.RegisterMachineServiceServer(server, machineService{})
+	storage.RegisterStorageServiceServer(server, machineService{})
+
+	go server.Serve(listener) //nolint:errcheck
+
+	defer server.Stop()
+
+	c, err := client.New(ctx, client.WithUnixSocket(socketPath), client.WithGRPCDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	require.NoError(t, err)
+
+	defer c.Close() //nolint:errcheck
+
+	info, err := machinetask.CollectTaskSpec{}.Poll(ctx, c, []string{"disks", "version"})
+	require.ErrorContains(t, err, "disks are not available")
+
+	// the failed poller doesn't prevent the next pollers from reporting their data
+	assert.Equal(t, specs.MachineStatusSpec_ERROR, info.PollStatuses["disks"])
+	assert.Equal(t, specs.MachineStatusSpec_DATA, info.PollStatuses["version"])
+
+	require.NotNil(t, info.TalosVersion)
+	assert.Equal(t, "v1.7.0", *info.TalosVersion)
+}
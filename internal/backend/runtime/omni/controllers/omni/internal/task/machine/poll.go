@@ -6,20 +6,31 @@
 package machine
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/siderolabs/gen/value"
 	"github.com/siderolabs/go-pointer"
+	imagefactoryconstants "github.com/siderolabs/image-factory/pkg/constants"
 	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	omnimeta "github.com/siderolabs/omni/client/pkg/meta"
@@ -29,20 +40,27 @@ import (
 type machinePollFunction func(ctx context.Context, c *client.Client, info *Info) error
 
 var resourcePollers = map[string]machinePollFunction{
-	network.HostnameStatusType:   pollHostname,
-	network.RouteStatusType:      pollRoutes,
-	network.NodeAddressType:      pollAddresses,
-	network.LinkStatusType:       pollNetworkLinks,
-	hardware.ProcessorType:       pollProcessors,
-	hardware.MemoryModuleType:    pollMemory,
-	runtime.PlatformMetadataType: pollPlatformMetadata,
-	runtime.MetaKeyType:          pollMeta,
-	runtime.ExtensionStatusType:  pollExtensions,
+	network.HostnameStatusType:     pollHostname,
+	network.RouteStatusType:        pollRoutes,
+	network.NodeAddressType:        pollAddresses,
+	network.LinkStatusType:         pollNetworkLinks,
+	hardware.ProcessorType:         pollProcessors,
+	hardware.MemoryModuleType:      pollMemory,
+	hardware.SystemInformationType: pollFirmware,
+	runtime.PlatformMetadataType:   pollPlatformMetadata,
+	runtime.MetaKeyType:            pollMeta,
+	runtime.ExtensionStatusType:    pollExtensions,
+	runtime.MountStatusType:        pollEncryption,
+	configres.MachineConfigType:    pollInstallImage,
+	runtime.MachineStatusType:      pollRebootStatus,
 }
 
 var machinePollers = map[string]machinePollFunction{
-	"version": pollVersion,
-	"disks":   pollDisks,
+	"version":        pollVersion,
+	"disks":          pollDisks,
+	"kernel_cmdline": pollKernelCmdline,
+	"boot_status":    pollBootStatus,
+	"clock":          pollClock,
 }
 
 var allPollers = merged(resourcePollers, machinePollers)
@@ -64,6 +82,50 @@ func poll(ctx context.Context, poller string, c *client.Client, info *Info) erro
 	return f(ctx, c, info)
 }
 
+// pollerHasData reports whether the poller has gathered any data into the info.
+func pollerHasData(poller string, info *Info) bool {
+	switch poller {
+	case network.HostnameStatusType:
+		return info.Hostname != nil
+	case network.RouteStatusType:
+		return len(info.DefaultGateways) > 0
+	case network.NodeAddressType:
+		return len(info.Addresses) > 0
+	case network.LinkStatusType:
+		return len(info.NetworkLinks) > 0
+	case hardware.ProcessorType:
+		return len(info.Processors) > 0
+	case hardware.MemoryModuleType:
+		return len(info.MemoryModules) > 0
+	case hardware.SystemInformationType:
+		return info.Firmware != nil
+	case runtime.PlatformMetadataType:
+		return info.PlatformMetadata != nil
+	case runtime.MetaKeyType:
+		return len(info.ImageLabels) > 0
+	case runtime.ExtensionStatusType:
+		return info.Schematic != nil
+	case runtime.MountStatusType:
+		return info.EncryptionStatus != nil
+	case configres.MachineConfigType:
+		return info.InstallImage != nil
+	case runtime.MachineStatusType:
+		return info.RebootStatus != nil
+	case "version":
+		return info.TalosVersion != nil
+	case "disks":
+		return len(info.Blockdevices) > 0
+	case "kernel_cmdline":
+		return info.KernelCmdline != nil
+	case "boot_status":
+		return info.BootStatus != nil
+	case "clock":
+		return info.ClockStatus != nil
+	default:
+		return false
+	}
+}
+
 func pollVersion(ctx context.Context, c *client.Client, info *Info) error {
 	versionResp, err := c.Version(ctx)
 	if err != nil && client.StatusCode(err) != codes.Unimplemented {
@@ -78,6 +140,129 @@ func pollVersion(ctx context.Context, c *client.Client, info *Info) error {
 	return nil
 }
 
+func pollKernelCmdline(ctx context.Context, c *client.Client, info *Info) error {
+	cmdline, err := readKernelCmdline(ctx, c, info)
+	if err != nil || cmdline == nil {
+		return err
+	}
+
+	info.KernelCmdline = cmdline
+
+	return nil
+}
+
+func pollBootStatus(ctx context.Context, c *client.Client, info *Info) error {
+	cmdline, err := readKernelCmdline(ctx, c, info)
+	if err != nil || cmdline == nil {
+		return err
+	}
+
+	info.BootStatus = detectBootStatus(*cmdline)
+
+	return nil
+}
+
+// clockDriftResolution is the precision of the reported clock drift.
+//
+// The drift is rounded, so that the machine status is not rewritten on every poll because of the network jitter.
+const clockDriftResolution = time.Second
+
+func pollClock(ctx context.Context, c *client.Client, info *Info) error {
+	requestedAt := time.Now()
+
+	timeResp, err := c.Time(ctx)
+	if err != nil {
+		if client.StatusCode(err) == codes.Unimplemented {
+			return nil
+		}
+
+		return err
+	}
+
+	// the machine time is compared with the middle of the request to compensate for the round trip
+	omniTime := requestedAt.Add(time.Since(requestedAt) / 2)
+
+	for _, msg := range timeResp.GetMessages() {
+		if msg.GetLocaltime() == nil {
+			continue
+		}
+
+		info.ClockStatus = &specs.MachineStatusSpec_ClockStatus{
+			Drift: durationpb.New(msg.GetLocaltime().AsTime().Sub(omniTime).Round(clockDriftResolution)),
+		}
+	}
+
+	return nil
+}
+
+// detectBootStatus guesses the boot method from the kernel args set by the bootloader.
+//
+// Talos GRUB config boots the installed system from the A/B partition, while the ISO boots from /boot,
+// iPXE passes only the initrd arg without the BOOT_IMAGE.
+func detectBootStatus(cmdline string) *specs.MachineStatusSpec_BootStatus {
+	var bootImage, initrd string
+
+	for _, arg := range strings.Fields(cmdline) {
+		key, val, _ := strings.Cut(arg, "=")
+
+		switch key {
+		case "BOOT_IMAGE":
+			bootImage = val
+		case "initrd":
+			initrd = val
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(bootImage, "/A/"), strings.HasPrefix(bootImage, "/B/"):
+		return &specs.MachineStatusSpec_BootStatus{
+			Method:    specs.MachineStatusSpec_BootStatus_DISK,
+			Installed: true,
+		}
+	case strings.HasPrefix(bootImage, "/boot/"):
+		return &specs.MachineStatusSpec_BootStatus{
+			Method: specs.MachineStatusSpec_BootStatus_ISO,
+		}
+	case bootImage == "" && initrd != "":
+		return &specs.MachineStatusSpec_BootStatus{
+			Method: specs.MachineStatusSpec_BootStatus_PXE,
+		}
+	}
+
+	return nil
+}
+
+func readKernelCmdline(ctx context.Context, c *client.Client, info *Info) (*string, error) {
+	return readFile(ctx, c, info, "/proc/cmdline")
+}
+
+// readFile returns the trimmed contents of the file, it returns nil if the file can't be read from the machine.
+func readFile(ctx context.Context, c *client.Client, info *Info, path string) (*string, error) {
+	// reading files is not available in the maintenance mode
+	if info.MaintenanceMode {
+		return nil, nil //nolint:nilnil
+	}
+
+	r, err := c.Read(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	// the read errors are only returned once the stream is consumed
+	data, err := io.ReadAll(r)
+	if err != nil {
+		if code := client.StatusCode(err); code == codes.Unimplemented || code == codes.PermissionDenied || code == codes.NotFound {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return pointer.To(strings.TrimSpace(string(data))), nil
+}
+
 func pollHostname(ctx context.Context, c *client.Client, info *Info) error {
 	return forEachResource(
 		ctx,
@@ -126,8 +311,12 @@ func pollAddresses(ctx context.Context, c *client.Client, info *Info) error {
 			info.Addresses = make([]string, 0, len(r.TypedSpec().Addresses))
 
 			for _, addr := range r.TypedSpec().Addresses {
-				// skip SideroLink addresses
+				// skip SideroLink addresses, they are reported separately if enabled
 				if network.IsULA(addr.Addr(), network.ULASideroLink) {
+					if info.IncludeSideroLinkAddress {
+						info.SideroLinkAddress = addr.String()
+					}
+
 					continue
 				}
 
@@ -182,6 +371,7 @@ func pollNetworkLinks(ctx context.Context, c *client.Client, info *Info) error {
 				SpeedMbps:       uint32(r.TypedSpec().SpeedMegabits),
 				LinkUp:          r.TypedSpec().LinkState,
 				Description:     fmt.Sprintf("%s %s", r.TypedSpec().Vendor, r.TypedSpec().Product),
+				AdminUp:         r.TypedSpec().Flags&nethelpers.LinkFlags(nethelpers.LinkUp) != 0,
 			})
 
 			return nil
@@ -235,6 +425,49 @@ func pollMemory(ctx context.Context, c *client.Client, info *Info) error {
 		})
 }
 
+func pollFirmware(ctx context.Context, c *client.Client, info *Info) error {
+	firmware := &specs.MachineStatusSpec_HardwareStatus_Firmware{}
+
+	if err := forEachResource(
+		ctx,
+		c,
+		hardware.NamespaceName,
+		hardware.SystemInformationType,
+		func(r *hardware.SystemInformation) error {
+			firmware.Vendor = r.TypedSpec().Manufacturer
+			firmware.Product = r.TypedSpec().ProductName
+			firmware.SerialNumber = r.TypedSpec().SerialNumber
+
+			return nil
+		}); err != nil {
+		return err
+	}
+
+	// the BIOS information is not exposed as a resource
+	for path, field := range map[string]*string{
+		"/sys/class/dmi/id/bios_vendor":  &firmware.BiosVendor,
+		"/sys/class/dmi/id/bios_version": &firmware.BiosVersion,
+		"/sys/class/dmi/id/bios_date":    &firmware.BiosDate,
+	} {
+		value, err := readFile(ctx, c, info, path)
+		if err != nil {
+			return err
+		}
+
+		if value != nil {
+			*field = *value
+		}
+	}
+
+	if firmware.EqualVT(&specs.MachineStatusSpec_HardwareStatus_Firmware{}) {
+		return nil
+	}
+
+	info.Firmware = firmware
+
+	return nil
+}
+
 func pollPlatformMetadata(ctx context.Context, c *client.Client, info *Info) error {
 	return forEachResource(
 		ctx,
@@ -325,6 +558,27 @@ func pollExtensions(ctx context.Context, c *client.Client, info *Info) error {
 	machineSchematic := &specs.MachineStatusSpec_Schematic{}
 	info.Schematic = machineSchematic
 
+	if err := forEachResource(
+		ctx,
+		c,
+		runtime.NamespaceName,
+		runtime.ExtensionStatusType,
+		func(r *runtime.ExtensionStatus) error {
+			if r.TypedSpec().Metadata.Name == imagefactoryconstants.SchematicIDExtensionName {
+				return nil
+			}
+
+			machineSchematic.InstalledExtensions = append(machineSchematic.InstalledExtensions, &specs.MachineStatusSpec_Schematic_InstalledExtension{
+				Name:    r.TypedSpec().Metadata.Name,
+				Version: r.TypedSpec().Metadata.Version,
+			})
+
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
 	var err error
 
 	machineSchematic.Id, err = talos.GetSchematicID(ctx, c)
@@ -332,11 +586,188 @@ func pollExtensions(ctx context.Context, c *client.Client, info *Info) error {
 		if errors.Is(err, talos.ErrInvalidSchematic) {
 			machineSchematic.Invalid = true
 
+			// the extensions help to find out why the schematic can't be detected
+			for _, extension := range machineSchematic.InstalledExtensions {
+				machineSchematic.Extensions = append(machineSchematic.Extensions, extension.Name)
+			}
+
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// encryptedVolumes are the system volumes which can be encrypted.
+var encryptedVolumes = []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel}
+
+func filterMountStatuses(r *runtime.MountStatus) bool {
+	return slices.Contains(encryptedVolumes, r.Metadata().ID())
+}
+
+func pollEncryption(ctx context.Context, c *client.Client, info *Info) error {
+	var volumes []*specs.MachineStatusSpec_EncryptionStatus_Volume
+
+	if err := forEachResource(
+		ctx,
+		c,
+		runtime.NamespaceName,
+		runtime.MountStatusType,
+		func(r *runtime.MountStatus) error {
+			if !filterMountStatuses(r) {
+				return nil
+			}
+
+			volumes = append(volumes, &specs.MachineStatusSpec_EncryptionStatus_Volume{
+				Name:      r.Metadata().ID(),
+				Encrypted: r.TypedSpec().Encrypted,
+				Providers: r.TypedSpec().EncryptionProviders,
+			})
+
+			return nil
+		}); err != nil {
+		return err
+	}
+
+	// the volumes are not mounted yet (e.g. in the maintenance mode), so the encryption is unknown
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	info.EncryptionStatus = &specs.MachineStatusSpec_EncryptionStatus{
+		Volumes: volumes,
+	}
+
+	return nil
+}
+
+func filterMachineConfigs(r *configres.MachineConfig) bool {
+	return r.Metadata().ID() == configres.V1Alpha1ID
+}
+
+// pollInstallImage reads the install image from the machine config, the upgrades update it in the config as well.
+func pollInstallImage(ctx context.Context, c *client.Client, info *Info) error {
+	// the kernel cmdline and the machine config are not available in the maintenance mode, so the install image is unknown
+	if info.MaintenanceMode {
+		return nil
+	}
+
+	cmdline, err := readKernelCmdline(ctx, c, info)
+	if err != nil || cmdline == nil {
+		return err
+	}
+
+	bootStatus := detectBootStatus(*cmdline)
+	if bootStatus == nil {
+		return nil
+	}
+
+	// the machine running live wasn't installed from any image, even if the config has one
+	if !bootStatus.Installed {
+		info.InstallImage = &specs.MachineStatusSpec_InstallImage{}
+
+		return nil
+	}
+
+	return forEachResource(
+		ctx,
+		c,
+		configres.NamespaceName,
+		configres.MachineConfigType,
+		func(r *configres.MachineConfig) error {
+			if !filterMachineConfigs(r) || r.Provider() == nil || r.Provider().Machine() == nil {
+				return nil
+			}
+
+			info.InstallImage = &specs.MachineStatusSpec_InstallImage{
+				Image:     r.Provider().Machine().Install().Image(),
+				Installed: true,
+			}
+
+			return nil
+		})
+}
+
+// pollRebootStatus compares the config stored on the machine with the active one.
+//
+// The config applied in the staged mode is only written to the STATE partition, it becomes active after a reboot.
+func pollRebootStatus(ctx context.Context, c *client.Client, info *Info) error {
+	// the machine config is not accessible in the maintenance mode
+	if info.MaintenanceMode {
+		return nil
+	}
+
+	var rebooting bool
+
+	if err := forEachResource(
+		ctx,
+		c,
+		runtime.NamespaceName,
+		runtime.MachineStatusType,
+		func(r *runtime.MachineStatus) error {
+			rebooting = rebooting || r.TypedSpec().Stage == runtime.MachineStageRebooting
+
 			return nil
+		}); err != nil {
+		return err
+	}
+
+	if rebooting {
+		info.RebootStatus = &specs.MachineStatusSpec_RebootStatus{
+			State:  specs.MachineStatusSpec_RebootStatus_REBOOTING,
+			Reason: "machine is rebooting",
 		}
 
+		return nil
+	}
+
+	storedConfig, err := readFile(ctx, c, info, constants.ConfigPath)
+	if err != nil || storedConfig == nil {
+		return err
+	}
+
+	var activeConfig []byte
+
+	if err = forEachResource(
+		ctx,
+		c,
+		configres.NamespaceName,
+		configres.MachineConfigType,
+		func(r *configres.MachineConfig) error {
+			if !filterMachineConfigs(r) || r.Provider() == nil {
+				return nil
+			}
+
+			activeConfig, err = r.Provider().EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+
+			return err
+		}); err != nil {
 		return err
 	}
 
+	if activeConfig == nil {
+		return nil
+	}
+
+	// both configs are encoded the same way, so that only the actual changes are detected
+	cfg, err := configloader.NewFromBytes([]byte(*storedConfig))
+	if err != nil {
+		return fmt.Errorf("failed to load the stored config: %w", err)
+	}
+
+	stagedConfig, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return err
+	}
+
+	info.RebootStatus = &specs.MachineStatusSpec_RebootStatus{}
+
+	if !bytes.Equal(stagedConfig, activeConfig) {
+		info.RebootStatus.State = specs.MachineStatusSpec_RebootStatus_PENDING
+		info.RebootStatus.Reason = "staged configuration is applied after a reboot"
+	}
+
 	return nil
 }
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package webhook implements best-effort webhook notifications.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"go.uber.org/zap"
+)
+
+// queueSize is the number of the pending events, events are dropped when the queue is full.
+const queueSize = 256
+
+// ConnectivityEvent is the payload sent to the webhook when the machine connectivity changes.
+type ConnectivityEvent struct {
+	MachineID string `json:"machine_id"`
+	Connected bool   `json:"connected"`
+}
+
+// KeyExpirationEvent is the payload sent to the webhook when the service account key is about to expire.
+type KeyExpirationEvent struct {
+	Expiration     time.Time `json:"expiration"`
+	ServiceAccount string    `json:"service_account"`
+	KeyID          string    `json:"key_id"`
+}
+
+// Notifier delivers the events to the webhook URL without blocking the caller.
+type Notifier[T any] struct {
+	client *http.Client
+	logger *zap.Logger
+	queue  chan T
+
+	url           string
+	retryDuration time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NewNotifier creates a new Notifier.
+//
+// Each event delivery is retried until retryDuration passes, each attempt is limited by the timeout.
+func NewNotifier[T any](url string, timeout, retryDuration time.Duration, logger *zap.Logger) *Notifier[T] {
+	return &Notifier[T]{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		logger:        logger,
+		queue:         make(chan T, queueSize),
+		url:           url,
+		retryDuration: retryDuration,
+	}
+}
+
+// Run delivers the queued events until the context is canceled.
+func (n *Notifier[T]) Run(ctx context.Context) {
+	n.wg.Add(1)
+
+	go func() {
+		defer n.wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-n.queue:
+				if err := n.deliver(ctx, event); err != nil {
+					n.logger.Warn("failed to deliver webhook", zap.Any("event", event), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Wait waits for the delivery goroutine to stop.
+func (n *Notifier[T]) Wait() {
+	n.wg.Wait()
+}
+
+// Notify queues the event, the event is dropped if the queue is full.
+func (n *Notifier[T]) Notify(event T) {
+	select {
+	case n.queue <- event:
+	default:
+		n.logger.Warn("webhook queue is full, dropping the event", zap.Any("event", event))
+	}
+}
+
+func (n *Notifier[T]) deliver(ctx context.Context, event T) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return retry.Exponential(n.retryDuration, retry.WithUnits(time.Second), retry.WithJitter(100*time.Millisecond)).RetryWithContext(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := n.client.Do(req)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		resp.Body.Close() //nolint:errcheck
+
+		switch {
+		case resp.StatusCode >= http.StatusInternalServerError, resp.StatusCode == http.StatusTooManyRequests:
+			return retry.ExpectedErrorf("webhook responded with status %d", resp.StatusCode)
+		case resp.StatusCode >= http.StatusBadRequest:
+			return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+		}
+
+		return nil
+	})
+}
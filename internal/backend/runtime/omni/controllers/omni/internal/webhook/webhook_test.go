@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/webhook"
+)
+
+func TestNotifierRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	var attempts atomic.Int32
+
+	received := make(chan webhook.ConnectivityEvent, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		var event webhook.ConnectivityEvent
+
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		received <- event
+	}))
+
+	t.Cleanup(server.Close)
+
+	notifier := webhook.NewNotifier[webhook.ConnectivityEvent](server.URL, time.Second, 5*time.Second, zaptest.NewLogger(t))
+	notifier.Run(ctx)
+
+	notifier.Notify(webhook.ConnectivityEvent{MachineID: "machine-1", Connected: true})
+
+	select {
+	case event := <-received:
+		assert.Equal(t, webhook.ConnectivityEvent{MachineID: "machine-1", Connected: true}, event)
+	case <-ctx.Done():
+		require.FailNow(t, "webhook was not delivered")
+	}
+
+	assert.EqualValues(t, 2, attempts.Load())
+
+	cancel()
+	notifier.Wait()
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/webhook"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// KeyExpiryNotifierController is a controller which periodically checks the service account keys and notifies about the keys which are about to expire.
+//
+// Each key is notified about once, the notifications are logged and optionally sent to the webhook.
+type KeyExpiryNotifierController struct {
+	clock    clock.Clock
+	notified map[resource.ID]struct{}
+
+	params config.KeyExpiryNotificationParams
+}
+
+// KeyExpiryNotifierOption is a functional option for KeyExpiryNotifierController.
+type KeyExpiryNotifierOption func(*KeyExpiryNotifierController)
+
+// WithKeyExpiryNotifierClock sets the clock to use for the controller.
+func WithKeyExpiryNotifierClock(clock clock.Clock) KeyExpiryNotifierOption {
+	return func(k *KeyExpiryNotifierController) {
+		k.clock = clock
+	}
+}
+
+// NewKeyExpiryNotifierController initializes a new KeyExpiryNotifierController.
+func NewKeyExpiryNotifierController(params config.KeyExpiryNotificationParams, opts ...KeyExpiryNotifierOption) *KeyExpiryNotifierController {
+	result := &KeyExpiryNotifierController{
+		notified: map[resource.ID]struct{}{},
+		params:   params,
+	}
+
+	for _, opt := range opts {
+		opt(result)
+	}
+
+	if result.clock == nil {
+		result.clock = clock.New()
+	}
+
+	return result
+}
+
+// Name implements controller.Controller interface.
+func (*KeyExpiryNotifierController) Name() string {
+	return "KeyExpiryNotifierController"
+}
+
+// Inputs implements controller.Controller interface.
+func (k *KeyExpiryNotifierController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      auth.PublicKeyType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (k *KeyExpiryNotifierController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (k *KeyExpiryNotifierController) Run(ctx context.Context, runtime controller.Runtime, logger *zap.Logger) error {
+	var notifier *webhook.Notifier[webhook.KeyExpirationEvent]
+
+	if k.params.WebhookURL != "" {
+		notifier = webhook.NewNotifier[webhook.KeyExpirationEvent](k.params.WebhookURL, k.params.Timeout, k.params.RetryDuration, logger)
+
+		notifierCtx, notifierCancel := context.WithCancel(ctx)
+
+		notifier.Run(notifierCtx)
+
+		defer notifier.Wait()
+		defer notifierCancel()
+	}
+
+	ticker := k.clock.Ticker(k.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-runtime.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := k.run(ctx, runtime, logger, notifier); err != nil {
+			return fmt.Errorf("error checking key expiration: %w", err)
+		}
+	}
+}
+
+func (k *KeyExpiryNotifierController) run(ctx context.Context, runtime controller.Runtime, logger *zap.Logger, notifier *webhook.Notifier[webhook.KeyExpirationEvent]) error {
+	list, err := safe.ReaderListAll[*auth.PublicKey](ctx, runtime)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[resource.ID]struct{}, list.Len())
+
+	for it := list.Iterator(); it.Next(); {
+		md := it.Value().Metadata()
+		publicKeySpec := it.Value().TypedSpec().Value
+
+		email := publicKeySpec.GetIdentity().GetEmail()
+		if !strings.HasSuffix(email, pkgaccess.ServiceAccountNameSuffix) {
+			continue
+		}
+
+		// the retired keys are already replaced by the service account renewal
+		if publicKeySpec.GetRetireAt() != nil {
+			continue
+		}
+
+		expiration := publicKeySpec.GetExpiration().AsTime()
+		expiresIn := expiration.Sub(k.clock.Now())
+
+		// expired keys are removed by the key pruner
+		if expiresIn <= 0 || expiresIn > k.params.Threshold {
+			continue
+		}
+
+		seen[md.ID()] = struct{}{}
+
+		if _, ok := k.notified[md.ID()]; ok {
+			continue
+		}
+
+		serviceAccount := strings.TrimSuffix(email, pkgaccess.ServiceAccountNameSuffix)
+
+		logger.Warn("service account key is about to expire",
+			zap.String("service_account", serviceAccount),
+			zap.String("id", md.ID()),
+			zap.Time("expiration", expiration),
+		)
+
+		if notifier != nil {
+			notifier.Notify(webhook.KeyExpirationEvent{
+				ServiceAccount: serviceAccount,
+				KeyID:          md.ID(),
+				Expiration:     expiration,
+			})
+		}
+
+		k.notified[md.ID()] = struct{}{}
+	}
+
+	// forget the keys which were removed, so that the notified set doesn't grow
+	for id := range k.notified {
+		if _, ok := seen[id]; !ok {
+			delete(k.notified, id)
+		}
+	}
+
+	return nil
+}
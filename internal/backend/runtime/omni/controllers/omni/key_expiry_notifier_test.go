@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestKeyExpiryNotifierSuite(t *testing.T) {
+	suite.Run(t, new(KeyExpiryNotifierSuite))
+}
+
+type KeyExpiryNotifierSuite struct {
+	OmniSuite
+}
+
+type keyExpirationEvent struct {
+	ServiceAccount string `json:"service_account"`
+	KeyID          string `json:"key_id"`
+}
+
+func (suite *KeyExpiryNotifierSuite) TestNotify() {
+	fakeClock := clock.NewMock()
+	fakeClock.Set(time.Now())
+
+	received := make(chan keyExpirationEvent, 8)
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var event keyExpirationEvent
+
+		suite.Assert().NoError(json.NewDecoder(r.Body).Decode(&event))
+
+		received <- event
+	}))
+
+	suite.T().Cleanup(server.Close)
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(
+		omnictrl.NewKeyExpiryNotifierController(config.KeyExpiryNotificationParams{
+			Threshold:     time.Hour,
+			Interval:      time.Second,
+			WebhookURL:    server.URL,
+			Timeout:       time.Second,
+			RetryDuration: time.Second,
+		}, omnictrl.WithKeyExpiryNotifierClock(fakeClock)),
+	))
+
+	createKey := func(id, email string, expiresIn time.Duration) {
+		publicKey := authres.NewPublicKey(resources.DefaultNamespace, id)
+		publicKey.TypedSpec().Value.Confirmed = true
+		publicKey.TypedSpec().Value.Expiration = timestamppb.New(fakeClock.Now().Add(expiresIn))
+		publicKey.TypedSpec().Value.Identity = &specs.Identity{
+			Email: email,
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, publicKey))
+	}
+
+	expectEvent := func(expected keyExpirationEvent) {
+		select {
+		case event := <-received:
+			suite.Assert().Equal(expected, event)
+		case <-suite.ctx.Done():
+			suite.Require().FailNow("notification was not delivered")
+		}
+	}
+
+	createKey("key-expiring", "expiring"+pkgaccess.ServiceAccountNameSuffix, 30*time.Minute)
+
+	expectEvent(keyExpirationEvent{ServiceAccount: "expiring", KeyID: "key-expiring"})
+
+	createKey("key-user", "user@example.com", 30*time.Minute)
+	createKey("key-later", "later"+pkgaccess.ServiceAccountNameSuffix, 2*time.Hour)
+
+	fakeClock.Add(90 * time.Minute)
+
+	// the already notified key is not notified again, user keys are ignored
+	expectEvent(keyExpirationEvent{ServiceAccount: "later", KeyID: "key-later"})
+
+	fakeClock.Add(time.Second)
+
+	select {
+	case event := <-received:
+		suite.Assert().Failf("unexpected notification", "%v", event)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
@@ -20,6 +20,8 @@ import (
 )
 
 // KeyPrunerController is a controller which periodically prunes expired public keys.
+//
+// The public keys retired by the service account renewal are pruned once they are retired, even if they expired earlier.
 type KeyPrunerController struct {
 	clock clock.Clock
 
@@ -108,11 +110,15 @@ func (k *KeyPrunerController) run(ctx context.Context, runtime controller.Runtim
 		md := v.Metadata()
 		publicKeySpec := v.TypedSpec().Value
 
-		if k.clock.Now().Before(publicKeySpec.Expiration.AsTime()) {
+		if k.clock.Now().Before(auth.PublicKeyValidUntil(publicKeySpec)) {
 			continue
 		}
 
-		logger.Info("removing expired public key", zap.String("id", md.ID()), zap.Time("expiration", publicKeySpec.Expiration.AsTime()))
+		if publicKeySpec.RetireAt != nil {
+			logger.Info("removing retired public key", zap.String("id", md.ID()), zap.Time("retire_at", publicKeySpec.RetireAt.AsTime()))
+		} else {
+			logger.Info("removing expired public key", zap.String("id", md.ID()), zap.Time("expiration", publicKeySpec.Expiration.AsTime()))
+		}
 
 		err := runtime.Destroy(ctx, md)
 		if state.IsOwnerConflictError(err) {
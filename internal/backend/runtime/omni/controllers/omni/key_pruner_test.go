@@ -73,3 +73,23 @@ func (suite *KeyPrunerSuite) TestRemoveExpiredKey() {
 	// Check that second key is not removed
 	assertResource(&suite.OmniSuite, publicKey2.Metadata(), func(*authres.PublicKey, *assert.Assertions) {})
 }
+
+func (suite *KeyPrunerSuite) TestRemoveRetiredKey() {
+	fakeClock := suite.setup()
+
+	publicKey := authres.NewPublicKey(resources.DefaultNamespace, testID)
+	publicKey.TypedSpec().Value.Confirmed = true
+	publicKey.TypedSpec().Value.Expiration = timestamppb.New(fakeClock.Now().Add(2 * time.Second))
+	publicKey.TypedSpec().Value.RetireAt = timestamppb.New(fakeClock.Now().Add(defaultExpirationTime))
+
+	suite.Assert().NoError(suite.state.Create(suite.ctx, publicKey, state.WithCreateOwner(pointer.To(omnictrl.KeyPrunerController{}).Name())))
+
+	// the retired key is kept after it expires until the end of the grace period
+	fakeClock.Add(4 * time.Second)
+
+	assertResource(&suite.OmniSuite, publicKey.Metadata(), func(*authres.PublicKey, *assert.Assertions) {})
+
+	fakeClock.Add(5 * time.Second)
+
+	assertNoResource(&suite.OmniSuite, publicKey)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// KubernetesNodeStatusController picks the Kubernetes node state of each cluster machine from the cluster KubernetesStatus.
+//
+// KubernetesStatus is updated on every node and static pod change in the cluster, the node status of the machine
+// is modified only when the state of its own node changes.
+type KubernetesNodeStatusController = qtransform.QController[*omni.ClusterMachineIdentity, *omni.KubernetesNodeStatus]
+
+// NewKubernetesNodeStatusController initializes KubernetesNodeStatusController.
+func NewKubernetesNodeStatusController() *KubernetesNodeStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachineIdentity, *omni.KubernetesNodeStatus]{
+			Name: "KubernetesNodeStatusController",
+			MapMetadataFunc: func(identity *omni.ClusterMachineIdentity) *omni.KubernetesNodeStatus {
+				return omni.NewKubernetesNodeStatus(resources.DefaultNamespace, identity.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(nodeStatus *omni.KubernetesNodeStatus) *omni.ClusterMachineIdentity {
+				return omni.NewClusterMachineIdentity(resources.DefaultNamespace, nodeStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, identity *omni.ClusterMachineIdentity, nodeStatus *omni.KubernetesNodeStatus) error {
+				helpers.CopyLabels(identity, nodeStatus, omni.LabelCluster)
+
+				nodeStatus.TypedSpec().Value.Nodename = ""
+				nodeStatus.TypedSpec().Value.Ready = false
+
+				clusterName, ok := identity.Metadata().Labels().Get(omni.LabelCluster)
+				if !ok {
+					return nil
+				}
+
+				kubernetesStatus, err := safe.ReaderGetByID[*omni.KubernetesStatus](ctx, r, clusterName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return nil
+					}
+
+					return fmt.Errorf("failed to get cluster %q kubernetes status: %w", clusterName, err)
+				}
+
+				nodename := identity.TypedSpec().Value.Nodename
+
+				for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+					if node.Nodename == nodename {
+						nodeStatus.TypedSpec().Value.Nodename = nodename
+						nodeStatus.TypedSpec().Value.Ready = node.Ready
+
+						break
+					}
+				}
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			mappers.MapClusterResourceToLabeledResources[*omni.KubernetesStatus, *omni.ClusterMachineIdentity](),
+		),
+	)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type KubernetesNodeStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *KubernetesNodeStatusSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewKubernetesNodeStatusController()))
+
+	for machineID, nodename := range map[string]string{"machine-1": "node-1", "machine-2": "node-2"} {
+		identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, machineID)
+		identity.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+		identity.TypedSpec().Value.Nodename = nodename
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+	}
+
+	// the node is not known until the Kubernetes status is reported
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{"machine-1", "machine-2"},
+		func(nodeStatus *omni.KubernetesNodeStatus, assert *assert.Assertions) {
+			cluster, _ := nodeStatus.Metadata().Labels().Get(omni.LabelCluster)
+			assert.Equal("cluster", cluster)
+			assert.Empty(nodeStatus.TypedSpec().Value.Nodename)
+		},
+	)
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, "cluster")
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "node-1", Ready: true},
+		{Nodename: "node-2", Ready: false},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesStatus))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "machine-1", func(nodeStatus *omni.KubernetesNodeStatus, assert *assert.Assertions) {
+		assert.Equal("node-1", nodeStatus.TypedSpec().Value.Nodename)
+		assert.True(nodeStatus.TypedSpec().Value.Ready)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "machine-2", func(nodeStatus *omni.KubernetesNodeStatus, assert *assert.Assertions) {
+		assert.Equal("node-2", nodeStatus.TypedSpec().Value.Nodename)
+		assert.False(nodeStatus.TypedSpec().Value.Ready)
+	})
+
+	machine1Status, err := safe.StateGetByID[*omni.KubernetesNodeStatus](suite.ctx, suite.state, "machine-1")
+	suite.Require().NoError(err)
+
+	// the change of the other node doesn't touch the node status of the machine
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, kubernetesStatus.Metadata(), func(res *omni.KubernetesStatus) error {
+		res.TypedSpec().Value.Nodes[1].Ready = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "machine-2", func(nodeStatus *omni.KubernetesNodeStatus, assert *assert.Assertions) {
+		assert.True(nodeStatus.TypedSpec().Value.Ready)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, "machine-1", func(nodeStatus *omni.KubernetesNodeStatus, assert *assert.Assertions) {
+		assert.Equal(machine1Status.Metadata().Version(), nodeStatus.Metadata().Version())
+	})
+
+	// the node status is removed with the machine identity
+	rtestutils.Destroy[*omni.ClusterMachineIdentity](suite.ctx, suite.T(), suite.state, []resource.ID{"machine-1"})
+
+	rtestutils.AssertNoResource[*omni.KubernetesNodeStatus](suite.ctx, suite.T(), suite.state, "machine-1")
+}
+
+func TestKubernetesNodeStatusSuite(t *testing.T) {
+	suite.Run(t, new(KubernetesNodeStatusSuite))
+}
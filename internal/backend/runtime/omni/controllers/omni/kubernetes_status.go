@@ -168,6 +168,10 @@ func (ctrl *KubernetesStatusController) Run(ctx context.Context, r controller.Ru
 						r.TypedSpec().Value.StaticPods = ev.status.StaticPods
 					}
 
+					if ev.status.Dns != nil {
+						r.TypedSpec().Value.Dns = ev.status.Dns
+					}
+
 					return nil
 				}); err != nil {
 				return fmt.Errorf("error updating kubernetes status: %w", err)
@@ -443,6 +447,16 @@ var controlplanePodSelector = func() labels.Selector {
 	return sel
 }()
 
+// dnsPodSelector matches CoreDNS pods deployed by Talos.
+var dnsPodSelector = func() labels.Selector {
+	sel, err := labels.Parse("k8s-app=kube-dns")
+	if err != nil {
+		panic(err)
+	}
+
+	return sel
+}()
+
 func (ctrl *KubernetesStatusController) startWatcher(ctx context.Context, logger *zap.Logger, cluster string, notifyCh chan<- kubernetesWatcherNotify) error {
 	type kubernetesClient interface {
 		GetClient(ctx context.Context, cluster string) (*kubernetes.Client, error)
@@ -489,10 +503,26 @@ func (ctrl *KubernetesStatusController) startWatcher(ctx context.Context, logger
 		return err
 	}
 
+	w.dnsPodFactory = informers.NewSharedInformerFactoryWithOptions(w.client.Clientset(), 0,
+		informers.WithNamespace("kube-system"),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = dnsPodSelector.String()
+		}),
+	)
+
+	if _, err = w.dnsPodFactory.Core().V1().Pods().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ any) { w.dnsPodsSync(ctx, notifyCh) },
+		UpdateFunc: func(_ any, _ any) { w.dnsPodsSync(ctx, notifyCh) },
+		DeleteFunc: func(_ any) { w.dnsPodsSync(ctx, notifyCh) },
+	}); err != nil {
+		return err
+	}
+
 	ctx, w.ctxCancel = context.WithCancel(ctx)
 
 	w.nodeFactory.Start(ctx.Done())
 	w.podFactory.Start(ctx.Done())
+	w.dnsPodFactory.Start(ctx.Done())
 
 	go func() {
 		w.nodeFactory.WaitForCacheSync(ctx.Done())
@@ -508,6 +538,13 @@ func (ctrl *KubernetesStatusController) startWatcher(ctx context.Context, logger
 		w.podsSync(ctx, notifyCh)
 	}()
 
+	go func() {
+		w.dnsPodFactory.WaitForCacheSync(ctx.Done())
+		w.dnsPodsSynced.Store(true)
+
+		w.dnsPodsSync(ctx, notifyCh)
+	}()
+
 	if config.Config.WorkloadProxying.Enabled {
 		w.serviceFactory = informers.NewSharedInformerFactory(w.client.Clientset(), 0)
 
@@ -541,11 +578,13 @@ type kubernetesWatcher struct {
 	client         *kubernetes.Client
 	nodeFactory    informers.SharedInformerFactory
 	podFactory     informers.SharedInformerFactory
+	dnsPodFactory  informers.SharedInformerFactory
 	serviceFactory informers.SharedInformerFactory
 	ctxCancel      context.CancelFunc
 	cluster        string
 	nodesSynced    atomic.Bool
 	podsSynced     atomic.Bool
+	dnsPodsSynced  atomic.Bool
 	servicesSynced atomic.Bool
 }
 
@@ -553,6 +592,7 @@ func (w *kubernetesWatcher) stop() {
 	w.ctxCancel()
 	w.nodeFactory.Shutdown()
 	w.podFactory.Shutdown()
+	w.dnsPodFactory.Shutdown()
 }
 
 func (w *kubernetesWatcher) nodesSync(ctx context.Context, notifyCh chan<- kubernetesWatcherNotify) {
@@ -670,6 +710,55 @@ func (w *kubernetesWatcher) podsSync(ctx context.Context, notifyCh chan<- kubern
 		})
 }
 
+func (w *kubernetesWatcher) dnsPodsSync(ctx context.Context, notifyCh chan<- kubernetesWatcherNotify) {
+	if !w.dnsPodsSynced.Load() {
+		return
+	}
+
+	w.logger.Debug("dns pods sync event", zap.String("cluster", w.cluster))
+
+	pods, err := w.dnsPodFactory.Core().V1().Pods().Lister().List(dnsPodSelector)
+	if err != nil {
+		w.logger.Error("dns pods list failed", zap.String("cluster", w.cluster), zap.Error(err))
+	}
+
+	channel.SendWithContext(ctx, notifyCh,
+		kubernetesWatcherNotify{
+			cluster: w.cluster,
+			status: &specs.KubernetesStatusSpec{
+				Dns: dnsStatus(pods),
+			},
+		})
+}
+
+// dnsStatus computes the cluster DNS health from the CoreDNS pods.
+//
+// If there are no CoreDNS pods, the cluster DNS is provided by something else, so the health is unknown.
+func dnsStatus(pods []*corev1.Pod) *specs.KubernetesStatusSpec_DNSStatus {
+	status := &specs.KubernetesStatusSpec_DNSStatus{
+		TotalPods: uint32(len(pods)),
+	}
+
+	for _, pod := range pods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				status.ReadyPods++
+			}
+		}
+	}
+
+	switch {
+	case status.TotalPods == 0:
+		status.Health = specs.KubernetesStatusSpec_DNSStatus_UNKNOWN
+	case status.ReadyPods == 0:
+		status.Health = specs.KubernetesStatusSpec_DNSStatus_UNHEALTHY
+	default:
+		status.Health = specs.KubernetesStatusSpec_DNSStatus_HEALTHY
+	}
+
+	return status
+}
+
 func (w *kubernetesWatcher) servicesSync(ctx context.Context, notifyCh chan<- kubernetesWatcherNotify) {
 	if !w.servicesSynced.Load() {
 		return
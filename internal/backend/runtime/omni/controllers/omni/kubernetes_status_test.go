@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 )
 
@@ -111,3 +112,61 @@ func TestIsExposedServiceEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSStatus(t *testing.T) {
+	t.Parallel()
+
+	pod := func(ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+
+		return &corev1.Pod{
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: status,
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct { //nolint:govet
+		name     string
+		pods     []*corev1.Pod
+		expected *specs.KubernetesStatusSpec_DNSStatus
+	}{
+		{
+			name: "no coredns",
+			expected: &specs.KubernetesStatusSpec_DNSStatus{
+				Health: specs.KubernetesStatusSpec_DNSStatus_UNKNOWN,
+			},
+		},
+		{
+			name: "none ready",
+			pods: []*corev1.Pod{pod(false), pod(false)},
+			expected: &specs.KubernetesStatusSpec_DNSStatus{
+				Health:    specs.KubernetesStatusSpec_DNSStatus_UNHEALTHY,
+				TotalPods: 2,
+			},
+		},
+		{
+			name: "partially ready",
+			pods: []*corev1.Pod{pod(true), pod(false)},
+			expected: &specs.KubernetesStatusSpec_DNSStatus{
+				Health:    specs.KubernetesStatusSpec_DNSStatus_HEALTHY,
+				ReadyPods: 1,
+				TotalPods: 2,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, tt.expected.EqualVT(omni.DNSStatus(tt.pods)), "expected %v, got %v", tt.expected, omni.DNSStatus(tt.pods))
+		})
+	}
+}
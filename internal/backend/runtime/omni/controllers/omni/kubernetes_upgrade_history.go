@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// kubernetesUpgradeHistoryDepth is the number of the upgrades kept per cluster.
+const kubernetesUpgradeHistoryDepth = 50
+
+// KubernetesUpgradeHistoryController records the Kubernetes upgrades of the cluster.
+type KubernetesUpgradeHistoryController = qtransform.QController[*omni.KubernetesUpgradeStatus, *omni.KubernetesUpgradeHistory]
+
+// NewKubernetesUpgradeHistoryController initializes KubernetesUpgradeHistoryController.
+//
+// The upgrade is recorded when the upgrade status switches to the new version, and it is finished when the upgrade status
+// reaches the final phase.
+func NewKubernetesUpgradeHistoryController() *KubernetesUpgradeHistoryController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.KubernetesUpgradeStatus, *omni.KubernetesUpgradeHistory]{
+			Name: "KubernetesUpgradeHistoryController",
+			MapMetadataFunc: func(upgradeStatus *omni.KubernetesUpgradeStatus) *omni.KubernetesUpgradeHistory {
+				return omni.NewKubernetesUpgradeHistory(resources.DefaultNamespace, upgradeStatus.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(history *omni.KubernetesUpgradeHistory) *omni.KubernetesUpgradeStatus {
+				return omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, history.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, _ *zap.Logger, upgradeStatus *omni.KubernetesUpgradeStatus, history *omni.KubernetesUpgradeHistory) error {
+				history.Metadata().Labels().Set(omni.LabelCluster, upgradeStatus.Metadata().ID())
+
+				upgrades := recordKubernetesUpgrade(history.TypedSpec().Value.Upgrades, upgradeStatus.TypedSpec().Value)
+
+				if len(upgrades) > kubernetesUpgradeHistoryDepth {
+					upgrades = upgrades[len(upgrades)-kubernetesUpgradeHistoryDepth:]
+				}
+
+				history.TypedSpec().Value.Upgrades = upgrades
+
+				return nil
+			},
+		},
+	)
+}
+
+func recordKubernetesUpgrade(upgrades []*specs.KubernetesUpgradeHistorySpec_Upgrade, status *specs.KubernetesUpgradeStatusSpec) []*specs.KubernetesUpgradeHistorySpec_Upgrade {
+	var ongoing *specs.KubernetesUpgradeHistorySpec_Upgrade
+
+	if len(upgrades) > 0 && upgrades[len(upgrades)-1].Outcome == specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS {
+		ongoing = upgrades[len(upgrades)-1]
+	}
+
+	finish := func(outcome specs.KubernetesUpgradeHistorySpec_Upgrade_Outcome, errorMessage string) {
+		ongoing.Outcome = outcome
+		ongoing.Error = errorMessage
+		ongoing.FinishedAt = timestamppb.Now()
+	}
+
+	switch status.Phase {
+	case specs.KubernetesUpgradeStatusSpec_Upgrading:
+		// the initial installation of the cluster is not an upgrade
+		if status.CurrentUpgradeVersion == "" || status.LastUpgradeVersion == "" {
+			break
+		}
+
+		if ongoing != nil {
+			if ongoing.ToVersion == status.CurrentUpgradeVersion {
+				ongoing.Error = status.Error
+
+				break
+			}
+
+			finish(specs.KubernetesUpgradeHistorySpec_Upgrade_CANCELED, fmt.Sprintf("superseded by the upgrade to %s", status.CurrentUpgradeVersion))
+		}
+
+		upgrades = append(upgrades, &specs.KubernetesUpgradeHistorySpec_Upgrade{
+			FromVersion: status.LastUpgradeVersion,
+			ToVersion:   status.CurrentUpgradeVersion,
+			StartedAt:   timestamppb.Now(),
+			Outcome:     specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS,
+			Error:       status.Error,
+		})
+	case specs.KubernetesUpgradeStatusSpec_Failed:
+		if ongoing != nil {
+			finish(specs.KubernetesUpgradeHistorySpec_Upgrade_FAILED, status.Error)
+		}
+	case specs.KubernetesUpgradeStatusSpec_Reverting:
+		if ongoing != nil {
+			finish(specs.KubernetesUpgradeHistorySpec_Upgrade_REVERTED, ongoing.Error)
+		}
+	case specs.KubernetesUpgradeStatusSpec_Done:
+		if ongoing == nil {
+			break
+		}
+
+		if status.LastUpgradeVersion == ongoing.ToVersion {
+			finish(specs.KubernetesUpgradeHistorySpec_Upgrade_DONE, "")
+		} else {
+			finish(specs.KubernetesUpgradeHistorySpec_Upgrade_REVERTED, ongoing.Error)
+		}
+	case specs.KubernetesUpgradeStatusSpec_Unknown:
+	}
+
+	return upgrades
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+func TestKubernetesUpgradeHistorySuite(t *testing.T) {
+	suite.Run(t, new(KubernetesUpgradeHistorySuite))
+}
+
+type KubernetesUpgradeHistorySuite struct {
+	OmniSuite
+}
+
+func (suite *KubernetesUpgradeHistorySuite) TestUpgrades() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewKubernetesUpgradeHistoryController()))
+
+	upgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, "upgrades")
+	upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+	upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = "1.29.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, upgradeStatus))
+
+	type upgrade struct {
+		from, to string
+		outcome  specs.KubernetesUpgradeHistorySpec_Upgrade_Outcome
+	}
+
+	assertUpgrades := func(expected ...upgrade) {
+		rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{upgradeStatus.Metadata().ID()}, func(history *omni.KubernetesUpgradeHistory, assert *assert.Assertions) {
+			cluster, _ := history.Metadata().Labels().Get(omni.LabelCluster)
+			assert.Equal(upgradeStatus.Metadata().ID(), cluster)
+
+			var actual []upgrade
+
+			for _, u := range history.TypedSpec().Value.Upgrades {
+				actual = append(actual, upgrade{from: u.FromVersion, to: u.ToVersion, outcome: u.Outcome})
+
+				assert.NotNil(u.StartedAt)
+				assert.Equal(u.Outcome != specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS, u.FinishedAt != nil)
+			}
+
+			assert.Equal(expected, actual)
+		})
+	}
+
+	update := func(phase specs.KubernetesUpgradeStatusSpec_Phase, last, current string) {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, upgradeStatus.Metadata(), func(res *omni.KubernetesUpgradeStatus) error {
+			res.TypedSpec().Value.Phase = phase
+			res.TypedSpec().Value.LastUpgradeVersion = last
+			res.TypedSpec().Value.CurrentUpgradeVersion = current
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the initial installation is not recorded
+	assertUpgrades()
+
+	update(specs.KubernetesUpgradeStatusSpec_Done, "1.29.0", "")
+	assertUpgrades()
+
+	update(specs.KubernetesUpgradeStatusSpec_Upgrading, "1.29.0", "1.29.1")
+	assertUpgrades(upgrade{"1.29.0", "1.29.1", specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS})
+
+	update(specs.KubernetesUpgradeStatusSpec_Done, "1.29.1", "")
+	assertUpgrades(upgrade{"1.29.0", "1.29.1", specs.KubernetesUpgradeHistorySpec_Upgrade_DONE})
+
+	update(specs.KubernetesUpgradeStatusSpec_Upgrading, "1.29.1", "1.30.0")
+	assertUpgrades(
+		upgrade{"1.29.0", "1.29.1", specs.KubernetesUpgradeHistorySpec_Upgrade_DONE},
+		upgrade{"1.29.1", "1.30.0", specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS},
+	)
+
+	// the upgrade is superseded by the upgrade to another version
+	update(specs.KubernetesUpgradeStatusSpec_Upgrading, "1.29.1", "1.30.1")
+	assertUpgrades(
+		upgrade{"1.29.0", "1.29.1", specs.KubernetesUpgradeHistorySpec_Upgrade_DONE},
+		upgrade{"1.29.1", "1.30.0", specs.KubernetesUpgradeHistorySpec_Upgrade_CANCELED},
+		upgrade{"1.29.1", "1.30.1", specs.KubernetesUpgradeHistorySpec_Upgrade_IN_PROGRESS},
+	)
+
+	update(specs.KubernetesUpgradeStatusSpec_Reverting, "1.29.1", "")
+	update(specs.KubernetesUpgradeStatusSpec_Done, "1.29.1", "")
+	assertUpgrades(
+		upgrade{"1.29.0", "1.29.1", specs.KubernetesUpgradeHistorySpec_Upgrade_DONE},
+		upgrade{"1.29.1", "1.30.0", specs.KubernetesUpgradeHistorySpec_Upgrade_CANCELED},
+		upgrade{"1.29.1", "1.30.1", specs.KubernetesUpgradeHistorySpec_Upgrade_REVERTED},
+	)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+
+	"github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+)
+
+const (
+	// kubernetesUpgradePreChecksTimeout limits a single run of the upgrade pre-checks.
+	kubernetesUpgradePreChecksTimeout = 5 * time.Minute
+
+	// kubernetesUpgradePreChecksRetryInterval is how often the failed pre-checks are retried.
+	kubernetesUpgradePreChecksRetryInterval = 5 * time.Minute
+)
+
+// kubernetesUpgradePreChecksPassed runs the upgrade pre-checks once per upgrade version if the cluster requires them.
+//
+// The failed pre-checks are recorded as the upgrade failure, the upgrade doesn't start until they pass.
+func kubernetesUpgradePreChecksPassed(ctx context.Context, r controller.Reader, logger *zap.Logger, cluster *omni.Cluster, upgradeStatus *omni.KubernetesUpgradeStatus) (bool, error) {
+	spec := upgradeStatus.TypedSpec().Value
+	toVersion := cluster.TypedSpec().Value.KubernetesVersion
+
+	// the initial installation of the cluster is not an upgrade
+	if !cluster.TypedSpec().Value.GetFeatures().GetRequireKubernetesUpgradePreChecks() || spec.LastUpgradeVersion == "" || spec.PreChecksVersion == toVersion {
+		return true, nil
+	}
+
+	logger.Info("running kubernetes upgrade pre-checks", zap.String("cluster", cluster.Metadata().ID()), zap.String("from", spec.LastUpgradeVersion), zap.String("to", toVersion))
+
+	reason, err := runKubernetesUpgradePreChecks(ctx, r, cluster.Metadata().ID(), spec.LastUpgradeVersion, toVersion)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, err
+		}
+
+		// the checks which can't run are not passed
+		reason = err.Error()
+	}
+
+	if reason != "" {
+		logger.Warn("kubernetes upgrade pre-checks failed", zap.String("cluster", cluster.Metadata().ID()), zap.String("reason", reason))
+
+		spec.Phase = specs.KubernetesUpgradeStatusSpec_Failed
+		spec.Step = ""
+		spec.Status = ""
+		spec.Error = "upgrade pre-checks failed: " + reason
+
+		return false, nil
+	}
+
+	spec.PreChecksVersion = toVersion
+
+	return true, nil
+}
+
+// runKubernetesUpgradePreChecks runs the same checks as the KubernetesUpgradePreChecks API, it returns the reason if the checks fail.
+func runKubernetesUpgradePreChecks(ctx context.Context, r controller.Reader, clusterName, fromVersion, toVersion string) (string, error) {
+	path, err := upgrade.NewPath(fromVersion, toVersion)
+	if err != nil {
+		return fmt.Sprintf("invalid upgrade path: %s", err), nil
+	}
+
+	if !path.IsSupported() {
+		return fmt.Sprintf("unsupported upgrade path: %s", path), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kubernetesUpgradePreChecksTimeout)
+	defer cancel()
+
+	type kubernetesConfigurator interface {
+		GetKubeconfig(ctx context.Context, context *common.Context) (*rest.Config, error)
+	}
+
+	kubernetesRuntime, err := runtime.LookupInterface[kubernetesConfigurator](kubernetes.Name)
+	if err != nil {
+		return "", err
+	}
+
+	restConfig, err := kubernetesRuntime.GetKubeconfig(ctx, &common.Context{Name: clusterName})
+	if err != nil {
+		return "", fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+
+	type talosClientProvider interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientProvider](talos.Name)
+	if err != nil {
+		return "", err
+	}
+
+	talosClient, err := talosRuntime.GetClient(ctx, clusterName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get talos client: %w", err)
+	}
+
+	identities, err := safe.ReaderListAll[*omni.ClusterMachineIdentity](ctx, r, state.WithLabelQuery(
+		resource.LabelEqual(omni.LabelCluster, clusterName),
+		resource.LabelExists(omni.LabelControlPlaneRole),
+	))
+	if err != nil {
+		return "", err
+	}
+
+	var controlplaneNodes []string
+
+	for iter := identities.Iterator(); iter.Next(); {
+		if len(iter.Value().TypedSpec().Value.NodeIps) > 0 {
+			controlplaneNodes = append(controlplaneNodes, iter.Value().TypedSpec().Value.NodeIps[0])
+		}
+	}
+
+	var logBuffer strings.Builder
+
+	preCheck, err := upgrade.NewChecks(path, talosClient.COSI, restConfig, controlplaneNodes, nil, func(format string, args ...any) {
+		fmt.Fprintf(&logBuffer, format, args...)
+		fmt.Fprintln(&logBuffer)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err = preCheck.Run(ctx); err != nil {
+		if ctx.Err() != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&logBuffer, "pre-checks failed: %v\n", err)
+
+		return logBuffer.String(), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+func TestKubernetesUpgradePreChecksSuite(t *testing.T) {
+	suite.Run(t, new(KubernetesUpgradePreChecksSuite))
+}
+
+type KubernetesUpgradePreChecksSuite struct {
+	OmniSuite
+}
+
+// setupCluster creates the running single worker cluster with all Kubernetes components at the given version.
+func (suite *KubernetesUpgradePreChecksSuite) setupCluster(clusterName, kubernetesVersion string, requirePreChecks bool) *omni.Cluster {
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterName)
+	cluster.TypedSpec().Value.KubernetesVersion = kubernetesVersion
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{
+		RequireKubernetesUpgradePreChecks: requirePreChecks,
+	}
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+	clusterStatus.TypedSpec().Value.Ready = true
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, clusterName+"-machine")
+	identity.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	identity.TypedSpec().Value.Nodename = clusterName + "-node"
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, clusterName)
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{
+			Nodename:       clusterName + "-node",
+			KubeletVersion: kubernetesVersion,
+			Ready:          true,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	// the initial installation is not an upgrade, so the pre-checks are not run
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(upgradeStatus *omni.KubernetesUpgradeStatus, assert *assert.Assertions) {
+		assert.Equal(specs.KubernetesUpgradeStatusSpec_Done, upgradeStatus.TypedSpec().Value.Phase)
+		assert.Equal(kubernetesVersion, upgradeStatus.TypedSpec().Value.LastUpgradeVersion)
+	})
+
+	return cluster
+}
+
+func (suite *KubernetesUpgradePreChecksSuite) updateKubernetesVersion(cluster *omni.Cluster, kubernetesVersion string, requirePreChecks bool) {
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.KubernetesVersion = kubernetesVersion
+		res.TypedSpec().Value.Features.RequireKubernetesUpgradePreChecks = requirePreChecks
+
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KubernetesUpgradePreChecksSuite) TestPreChecksFailed() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewKubernetesUpgradeStatusController()))
+
+	cluster := suite.setupCluster("pre-checks-failed", "1.27.0", true)
+
+	// skipping the minor version is not a supported upgrade path
+	suite.updateKubernetesVersion(cluster, "1.29.0", true)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, cluster.Metadata().ID(), func(upgradeStatus *omni.KubernetesUpgradeStatus, assert *assert.Assertions) {
+		assert.Equal(specs.KubernetesUpgradeStatusSpec_Failed, upgradeStatus.TypedSpec().Value.Phase)
+		assert.Equal("upgrade pre-checks failed: unsupported upgrade path: 1.27->1.29", upgradeStatus.TypedSpec().Value.Error)
+		assert.Equal("1.27.0", upgradeStatus.TypedSpec().Value.LastUpgradeVersion)
+		assert.Empty(upgradeStatus.TypedSpec().Value.PreChecksVersion)
+	})
+
+	// the upgrade doesn't start, so the images are not pre-pulled
+	rtestutils.AssertNoResource[*omni.ImagePullRequest](suite.ctx, suite.T(), suite.state, cluster.Metadata().ID())
+}
+
+func (suite *KubernetesUpgradePreChecksSuite) TestPreChecksNotRequired() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewKubernetesUpgradeStatusController()))
+
+	cluster := suite.setupCluster("pre-checks-not-required", "1.27.0", false)
+
+	// the upgrade proceeds without the pre-checks if the cluster doesn't require them
+	suite.updateKubernetesVersion(cluster, "1.29.0", false)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, cluster.Metadata().ID(), func(upgradeStatus *omni.KubernetesUpgradeStatus, assert *assert.Assertions) {
+		assert.Equal(specs.KubernetesUpgradeStatusSpec_Upgrading, upgradeStatus.TypedSpec().Value.Phase)
+		assert.Equal("1.29.0", upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion)
+		assert.Empty(upgradeStatus.TypedSpec().Value.Error)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, cluster.Metadata().ID(), func(request *omni.ImagePullRequest, assert *assert.Assertions) {
+		assert.Len(request.TypedSpec().Value.NodeImageList, 1)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
@@ -48,7 +49,7 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 			UnmapMetadataFunc: func(upgradeStatus *omni.KubernetesUpgradeStatus) *omni.Cluster {
 				return omni.NewCluster(resources.DefaultNamespace, upgradeStatus.Metadata().ID())
 			},
-			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, cluster *omni.Cluster, upgradeStatus *omni.KubernetesUpgradeStatus) error {
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, cluster *omni.Cluster, upgradeStatus *omni.KubernetesUpgradeStatus) error {
 				kubernetesStatus, err := safe.ReaderGet[*omni.KubernetesStatus](ctx, r, omni.NewKubernetesStatus(resources.DefaultNamespace, cluster.Metadata().ID()).Metadata())
 				if err != nil {
 					if state.IsNotFoundError(err) {
@@ -136,6 +137,19 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 						upgradeStatus.TypedSpec().Value.Error = ""
 					}
 				case len(upgradePath.Steps) > 0 && upgradePath.AllComponentsReady:
+					if versionMismatch {
+						var passed bool
+
+						passed, err = kubernetesUpgradePreChecksPassed(ctx, r, logger, cluster, upgradeStatus)
+						if err != nil {
+							return err
+						}
+
+						if !passed {
+							return controller.NewRequeueInterval(kubernetesUpgradePreChecksRetryInterval)
+						}
+					}
+
 					prePullStatus, prePullDone, prePullErr := updateImagePullRequest(ctx, r, upgradePath)
 					if prePullErr != nil {
 						return prePullErr
@@ -176,6 +190,17 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 						return nil
 					}
 
+					var opensIn time.Duration
+
+					skip, opensIn, err = skipOutsideUpgradeWindow(ctx, r, cluster, patch, upgradeStatus)
+					if err != nil {
+						return err
+					}
+
+					if skip {
+						return controller.NewRequeueInterval(opensIn)
+					}
+
 					upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
 					upgradeStatus.TypedSpec().Value.Step = patch.Description
 					upgradeStatus.TypedSpec().Value.Status = "waiting for a restart"
@@ -250,6 +275,9 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.ImagePullStatus, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.KubernetesUpgradeWindow, *omni.Cluster](),
+		),
 		qtransform.WithExtraMappedInput(
 			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, _ *omni.KubernetesVersion) ([]resource.Pointer, error) {
 				// reconcile all clusters on KubernetesVersion changes
@@ -390,3 +418,32 @@ func skipLocked(ctx context.Context, r controller.Reader, patch kubernetes.Upgra
 
 	return false, nil
 }
+
+func skipOutsideUpgradeWindow(ctx context.Context, r controller.Reader, cluster *omni.Cluster, patch kubernetes.UpgradeStep,
+	upgradeStatus *omni.KubernetesUpgradeStatus,
+) (bool, time.Duration, error) {
+	window, err := safe.ReaderGet[*omni.KubernetesUpgradeWindow](ctx, r, omni.NewKubernetesUpgradeWindow(resources.DefaultNamespace, cluster.Metadata().ID()).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, 0, nil
+		}
+
+		return false, 0, err
+	}
+
+	open, opensIn, err := omni.KubernetesUpgradeWindowOpen(window.TypedSpec().Value, time.Now())
+	if err != nil {
+		return false, 0, err
+	}
+
+	if open {
+		return false, 0, nil
+	}
+
+	upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+	upgradeStatus.TypedSpec().Value.Step = patch.Description
+	upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("waiting for the upgrade window, opens in %s", opensIn.Round(time.Minute))
+	upgradeStatus.TypedSpec().Value.Error = ""
+
+	return true, opensIn, nil
+}
@@ -52,5 +52,7 @@ func NewMachineController() *MachineController {
 			},
 		},
 		qtransform.WithConcurrency(4),
+		// the imported machines are managed by the ImportedMachineController
+		qtransform.WithOutputKind(controller.OutputShared),
 	)
 }
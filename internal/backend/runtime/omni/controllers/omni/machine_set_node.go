@@ -223,9 +223,14 @@ func (ctrl *MachineSetNodeController) createNodes(
 	created := 0
 
 	for _, selector := range selectors {
+		// reserved machines are never picked automatically
 		selector.Terms = append(selector.Terms, resource.LabelTerm{
 			Key: omni.MachineStatusLabelAvailable,
 			Op:  resource.LabelOpExists,
+		}, resource.LabelTerm{
+			Key:    omni.LabelReserved,
+			Op:     resource.LabelOpExists,
+			Invert: true,
 		})
 
 		availableMachineClassMachines := allMachineStatuses.FilterLabelQuery(resource.RawLabelQuery(selector))
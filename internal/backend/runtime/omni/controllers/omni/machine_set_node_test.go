@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/kvutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -176,6 +177,64 @@ func (suite *MachineSetNodeSuite) TestReconcile() {
 	assertNoMachineSetNode(machines[4])
 }
 
+func (suite *MachineSetNodeSuite) TestReconcileReserved() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineSetNodeController{}))
+
+	machines := suite.createMachines(
+		map[string]string{
+			omni.MachineStatusLabelCPU:       "AMD",
+			omni.MachineStatusLabelAvailable: "",
+			omni.LabelReserved:               "",
+		},
+		map[string]string{
+			omni.MachineStatusLabelCPU:       "AMD",
+			omni.MachineStatusLabelAvailable: "",
+		},
+	)
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "reserved")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, "cluster1")
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+
+	machineClass := newMachineClass(fmt.Sprintf("%s==AMD", omni.MachineStatusLabelCPU))
+
+	machineSet.TypedSpec().Value.MachineClass = &specs.MachineSetSpec_MachineClass{
+		Name:         machineClass.Metadata().ID(),
+		MachineCount: 2,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineClass))
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	// the reserved machine is skipped even though the machine set is not scaled up fully
+	assertResource[*omni.MachineSetNode](
+		&suite.OmniSuite,
+		omni.NewMachineSetNode(resources.DefaultNamespace, machines[1].Metadata().ID(), machineSet).Metadata(),
+		func(*omni.MachineSetNode, *assert.Assertions) {},
+	)
+
+	assertNoResource[*omni.MachineSetNode](
+		&suite.OmniSuite,
+		omni.NewMachineSetNode(resources.DefaultNamespace, machines[0].Metadata().ID(), machineSet),
+	)
+
+	// releasing the reservation makes the machine available for the allocation
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machines[0].Metadata(), func(res *omni.MachineStatus) error {
+		res.Metadata().Labels().Delete(omni.LabelReserved)
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertResource[*omni.MachineSetNode](
+		&suite.OmniSuite,
+		omni.NewMachineSetNode(resources.DefaultNamespace, machines[0].Metadata().ID(), machineSet).Metadata(),
+		func(*omni.MachineSetNode, *assert.Assertions) {},
+	)
+}
+
 func TestSortFunction(t *testing.T) {
 	machineStatuses := map[resource.ID]*omni.MachineStatus{}
 	machineSetNodes := make([]*omni.MachineSetNode, 0, 10)
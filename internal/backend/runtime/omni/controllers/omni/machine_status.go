@@ -8,14 +8,21 @@ package omni
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/kvutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	cosistate "github.com/cosi-project/runtime/pkg/state"
+	"github.com/dustin/go-humanize"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -23,11 +30,23 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/task"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/task/machine"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/webhook"
+	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
+// networkLinkDownDebounce is the time the link should stay down before the machine is labeled with it,
+// so that the short flaps (e.g. the switch port renegotiation) are not reported.
+//
+// The label is updated with the machine status, the machine status is refreshed at least on every clock poll.
+const networkLinkDownDebounce = 2 * time.Minute
+
 // MachineStatusController manages omni.MachineStatuses based on information from Talos API.
 type MachineStatusController struct {
-	runner *task.Runner[machine.InfoChan, machine.CollectTaskSpec]
+	runner   *task.Runner[machine.InfoChan, machine.CollectTaskSpec]
+	notifier *webhook.Notifier[webhook.ConnectivityEvent]
+
+	// longDisconnectTimer requeues the reconcile once the next disconnected machine reaches the long disconnect threshold.
+	longDisconnectTimer *time.Timer
 }
 
 // Name implements controller.Controller interface.
@@ -63,6 +82,16 @@ func (ctrl *MachineStatusController) Inputs() []controller.Input {
 			Type:      omni.MachineLabelsType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineReservationType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.KubernetesNodeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -81,6 +110,19 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 	ctrl.runner = task.NewEqualRunner[machine.CollectTaskSpec]()
 	defer ctrl.runner.Stop()
 
+	defer ctrl.scheduleLongDisconnectCheck(r, 0)
+
+	if webhookConfig := config.Config.ConnectivityWebhook; webhookConfig.URL != "" {
+		ctrl.notifier = webhook.NewNotifier[webhook.ConnectivityEvent](webhookConfig.URL, webhookConfig.Timeout, webhookConfig.RetryDuration, logger)
+
+		notifierCtx, notifierCancel := context.WithCancel(ctx)
+
+		ctrl.notifier.Run(notifierCtx)
+
+		defer ctrl.notifier.Wait()
+		defer notifierCancel()
+	}
+
 	notifyCh := make(chan machine.Info)
 
 	for {
@@ -116,6 +158,7 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 	machines := map[resource.ID]*omni.Machine{}
 	connectedMachines := 0
 	machineLabels := map[resource.ID]*omni.MachineLabels{}
+	reservedMachines := map[resource.ID]struct{}{}
 	reportingEvents := map[string]struct{}{}
 
 	for iter := list.Iterator(); iter.Next(); {
@@ -134,6 +177,15 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 
 		machineLabels[item.Metadata().ID()] = labels
 
+		_, err = safe.ReaderGetByID[*omni.MachineReservation](ctx, r, item.Metadata().ID())
+		if err != nil {
+			if !cosistate.IsNotFoundError(err) {
+				return err
+			}
+		} else {
+			reservedMachines[item.Metadata().ID()] = struct{}{}
+		}
+
 		if item.Metadata().Phase() == resource.PhaseTearingDown {
 			continue
 		}
@@ -160,9 +212,16 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 
 		var talosConfig *omni.TalosConfig
 
-		if cluster != "" {
+		talosConfigID := cluster
+
+		// externally managed machines are accessed using the talosconfig they were imported with
+		if importName, ok := item.Metadata().Labels().Get(omni.LabelExternallyManaged); ok {
+			talosConfigID = omni.ImportedTalosConfigID(importName)
+		}
+
+		if talosConfigID != "" {
 			talosConfig, err = safe.ReaderGet[*omni.TalosConfig](ctx, r, resource.NewMetadata(
-				resources.DefaultNamespace, omni.TalosConfigType, cluster, resource.VersionUndefined,
+				resources.DefaultNamespace, omni.TalosConfigType, talosConfigID, resource.VersionUndefined,
 			))
 
 			if err != nil && !cosistate.IsNotFoundError(err) {
@@ -196,6 +255,8 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 				MaintenanceMode: talosConfig == nil || maintenanceStage,
 				MachineID:       item.Metadata().ID(),
 				MachineLabels:   labels,
+
+				IncludeSideroLinkAddress: config.Config.MachineStatusSideroLinkAddress,
 			}
 
 			connectedMachines++
@@ -210,14 +271,28 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 		return err
 	}
 
+	now := time.Now()
+	longDisconnectThreshold := config.Config.MachineStatusLongDisconnectThreshold
+
+	var nextLongDisconnectCheck time.Duration
+
 	for id := range machines {
+		var connectivityChanged bool
+
 		if err = safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, id), func(m *omni.MachineStatus) error {
 			spec := m.TypedSpec().Value
 
 			connected := machines[id].TypedSpec().Value.Connected
 
+			_, wasConnected := m.Metadata().Labels().Get(omni.MachineStatusLabelConnected)
+			connectivityChanged = wasConnected != connected
+
 			spec.Connected = connected
 
+			if connectivityChanged || spec.ConnectivityChangedAt == nil {
+				spec.ConnectivityChangedAt = timestamppb.Now()
+			}
+
 			if connected {
 				m.Metadata().Labels().Set(omni.MachineStatusLabelConnected, "")
 				m.Metadata().Labels().Delete(omni.MachineStatusLabelDisconnected)
@@ -226,6 +301,16 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 				m.Metadata().Labels().Set(omni.MachineStatusLabelDisconnected, "")
 			}
 
+			omni.MachineStatusReconcileLongDisconnected(m, now, longDisconnectThreshold)
+
+			if !connected && longDisconnectThreshold > 0 {
+				untilThreshold := spec.ConnectivityChangedAt.AsTime().Add(longDisconnectThreshold).Sub(now)
+
+				if untilThreshold > 0 && (nextLongDisconnectCheck == 0 || untilThreshold < nextLongDisconnectCheck) {
+					nextLongDisconnectCheck = untilThreshold
+				}
+			}
+
 			if _, ok := reportingEvents[id]; ok {
 				m.Metadata().Labels().Set(omni.MachineStatusLabelReportingEvents, "")
 			} else {
@@ -248,16 +333,66 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 			helpers.CopyUserLabels(m, ctrl.mergeLabels(m, machineLabels[m.Metadata().ID()]))
 
 			omni.MachineStatusReconcileLabels(m)
+			omni.MachineStatusReconcilePlatformLabels(m, config.Config.MachineStatusPlatformLabels)
+			omni.MachineStatusReconcileLinkDownLabel(m, time.Now(), networkLinkDownDebounce)
+
+			if external, ok := machines[id].Metadata().Labels().Get(omni.LabelExternallyManaged); ok {
+				m.Metadata().Labels().Set(omni.LabelExternallyManaged, external)
+
+				// externally managed machines are never available for the allocation
+				m.Metadata().Labels().Delete(omni.MachineStatusLabelAvailable)
+
+				return nil
+			}
+
+			m.Metadata().Labels().Delete(omni.LabelExternallyManaged)
+
+			if _, reserved := reservedMachines[id]; reserved {
+				m.Metadata().Labels().Set(omni.LabelReserved, "")
+			} else {
+				m.Metadata().Labels().Delete(omni.LabelReserved)
+			}
+
+			if err = ctrl.setClusterRelation(clusterMachine, m); err != nil {
+				return err
+			}
+
+			return ctrl.setKubernetesNodeStatus(ctx, r, m)
+		}); err != nil {
+			if cosistate.IsPhaseConflictError(err) {
+				continue
+			}
 
-			return ctrl.setClusterRelation(clusterMachine, m)
-		}); err != nil && !cosistate.IsPhaseConflictError(err) {
 			return err
 		}
+
+		if connectivityChanged && ctrl.notifier != nil {
+			ctrl.notifier.Notify(webhook.ConnectivityEvent{
+				MachineID: id,
+				Connected: machines[id].TypedSpec().Value.Connected,
+			})
+		}
 	}
 
+	ctrl.scheduleLongDisconnectCheck(r, nextLongDisconnectCheck)
+
 	return nil
 }
 
+// scheduleLongDisconnectCheck requeues the reconcile after the given delay, as nothing else changes when the machine stays disconnected.
+//
+// The zero delay cancels the scheduled reconcile.
+func (ctrl *MachineStatusController) scheduleLongDisconnectCheck(r controller.Runtime, delay time.Duration) {
+	if ctrl.longDisconnectTimer != nil {
+		ctrl.longDisconnectTimer.Stop()
+		ctrl.longDisconnectTimer = nil
+	}
+
+	if delay > 0 {
+		ctrl.longDisconnectTimer = time.AfterFunc(delay, r.QueueReconcile)
+	}
+}
+
 func (ctrl *MachineStatusController) mergeLabels(m *omni.MachineStatus, machineLabels *omni.MachineLabels) map[string]string {
 	labels := map[string]string{}
 
@@ -316,6 +451,156 @@ func (ctrl *MachineStatusController) setClusterRelation(clusterMachine *omni.Clu
 	return nil
 }
 
+// setKubernetesNodeStatus reflects the Ready condition of the machine Kubernetes node as it is seen by the KubernetesNodeStatusController.
+func (ctrl *MachineStatusController) setKubernetesNodeStatus(ctx context.Context, r controller.Reader, machineStatus *omni.MachineStatus) error {
+	nodeStatus, err := ctrl.kubernetesNodeStatus(ctx, r, machineStatus)
+	if err != nil {
+		return err
+	}
+
+	machineStatus.TypedSpec().Value.KubernetesNodeStatus = nodeStatus
+
+	if nodeStatus == nil {
+		machineStatus.Metadata().Labels().Delete(omni.MachineStatusLabelKubernetesNodeReady)
+
+		return nil
+	}
+
+	machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelKubernetesNodeReady, strconv.FormatBool(nodeStatus.Ready))
+
+	return nil
+}
+
+func (ctrl *MachineStatusController) kubernetesNodeStatus(ctx context.Context, r controller.Reader,
+	machineStatus *omni.MachineStatus,
+) (*specs.MachineStatusSpec_KubernetesNodeStatus, error) {
+	if machineStatus.TypedSpec().Value.Cluster == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	nodeStatus, err := safe.ReaderGetByID[*omni.KubernetesNodeStatus](ctx, r, machineStatus.Metadata().ID())
+	if err != nil {
+		if cosistate.IsNotFoundError(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	if nodeStatus.TypedSpec().Value.Nodename == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &specs.MachineStatusSpec_KubernetesNodeStatus{
+		Nodename: nodeStatus.TypedSpec().Value.Nodename,
+		Ready:    nodeStatus.TypedSpec().Value.Ready,
+	}, nil
+}
+
+// trackNetworkLinksDown carries over the time the links went down from the previous poll.
+func trackNetworkLinksDown(previous, current []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus, now time.Time,
+) []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus {
+	downSince := map[string]*timestamppb.Timestamp{}
+
+	for _, link := range previous {
+		if link.DownSince != nil {
+			downSince[link.LinuxName] = link.DownSince
+		}
+	}
+
+	for _, link := range current {
+		if !link.AdminUp || link.LinkUp {
+			link.DownSince = nil
+
+			continue
+		}
+
+		if since, ok := downSince[link.LinuxName]; ok {
+			link.DownSince = since
+		} else {
+			link.DownSince = timestamppb.New(now)
+		}
+	}
+
+	return current
+}
+
+// hardwareChanges lists the hardware components added and removed since the previous poll.
+//
+// The nil components weren't polled, so they are not compared.
+func hardwareChanges(previous *specs.MachineStatusSpec_HardwareStatus, processors []*specs.MachineStatusSpec_HardwareStatus_Processor,
+	memoryModules []*specs.MachineStatusSpec_HardwareStatus_MemoryModule, blockdevices []*specs.MachineStatusSpec_HardwareStatus_BlockDevice,
+) []string {
+	var changes []string
+
+	if processors != nil {
+		changes = append(changes, componentChanges("processor", previous.GetProcessors(), processors, func(p *specs.MachineStatusSpec_HardwareStatus_Processor) string {
+			return fmt.Sprintf("%s, %d cores, %d threads", p.GetDescription(), p.GetCoreCount(), p.GetThreadCount())
+		})...)
+	}
+
+	if memoryModules != nil {
+		changes = append(changes, componentChanges("memory module", previous.GetMemoryModules(), memoryModules, func(m *specs.MachineStatusSpec_HardwareStatus_MemoryModule) string {
+			return strings.TrimSpace(fmt.Sprintf("%s %d MB", m.GetDescription(), m.GetSizeMb()))
+		})...)
+	}
+
+	if blockdevices != nil {
+		// the device name and the system disk flag might change without the hardware change, so they are not compared
+		changes = append(changes, componentChanges("disk", previous.GetBlockdevices(), blockdevices, func(d *specs.MachineStatusSpec_HardwareStatus_BlockDevice) string {
+			description := strings.TrimSpace(d.GetModel() + " " + humanize.Bytes(d.GetSize()))
+
+			if d.GetSerial() != "" {
+				description += ", serial " + d.GetSerial()
+			}
+
+			if d.GetWwid() != "" {
+				description += ", wwid " + d.GetWwid()
+			}
+
+			return description
+		})...)
+	}
+
+	return changes
+}
+
+// componentChanges compares the components by their descriptions.
+//
+// The first poll collects the initial inventory, so nothing is reported if there are no previous components.
+func componentChanges[T any](kind string, previous, current []T, describe func(T) string) []string {
+	if len(previous) == 0 {
+		return nil
+	}
+
+	// the same component might be installed several times, e.g. the identical memory modules
+	counts := map[string]int{}
+
+	for _, component := range previous {
+		counts[describe(component)]--
+	}
+
+	for _, component := range current {
+		counts[describe(component)]++
+	}
+
+	var changes []string
+
+	for description, count := range counts {
+		for ; count > 0; count-- {
+			changes = append(changes, fmt.Sprintf("%s added: %s", kind, description))
+		}
+
+		for ; count < 0; count++ {
+			changes = append(changes, fmt.Sprintf("%s removed: %s", kind, description))
+		}
+	}
+
+	slices.Sort(changes)
+
+	return changes
+}
+
 //nolint:gocognit,gocyclo,cyclop
 func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r controller.Runtime, event machine.Info) error {
 	if err := safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, event.MachineID), func(m *omni.MachineStatus) error {
@@ -345,6 +630,7 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 
 		if event.Addresses != nil {
 			spec.Network.Addresses = event.Addresses
+			spec.Network.SiderolinkAddress = event.SideroLinkAddress
 		}
 
 		if event.DefaultGateways != nil {
@@ -352,13 +638,20 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 		}
 
 		if event.NetworkLinks != nil {
-			spec.Network.NetworkLinks = event.NetworkLinks
+			spec.Network.NetworkLinks = trackNetworkLinksDown(spec.Network.NetworkLinks, event.NetworkLinks, time.Now())
 		}
 
 		if spec.Hardware == nil {
 			spec.Hardware = &specs.MachineStatusSpec_HardwareStatus{}
 		}
 
+		if changes := hardwareChanges(spec.Hardware, event.Processors, event.MemoryModules, event.Blockdevices); len(changes) > 0 {
+			spec.HardwareChange = &specs.MachineStatusSpec_HardwareChange{
+				ChangedAt: timestamppb.Now(),
+				Changes:   changes,
+			}
+		}
+
 		if event.Arch != nil {
 			spec.Hardware.Arch = *event.Arch
 		}
@@ -375,6 +668,10 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 			spec.Hardware.Blockdevices = event.Blockdevices
 		}
 
+		if event.Firmware != nil {
+			spec.Hardware.Firmware = event.Firmware
+		}
+
 		if event.PlatformMetadata != nil {
 			spec.PlatformMetadata = event.PlatformMetadata
 		}
@@ -399,9 +696,42 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 			spec.Schematic = event.Schematic
 		}
 
+		if event.KernelCmdline != nil {
+			spec.KernelCmdline = *event.KernelCmdline
+		}
+
+		if event.BootStatus != nil {
+			spec.BootStatus = event.BootStatus
+		}
+
+		if event.ClockStatus != nil {
+			spec.ClockStatus = event.ClockStatus
+		}
+
+		if event.EncryptionStatus != nil {
+			spec.EncryptionStatus = event.EncryptionStatus
+		}
+
+		if event.InstallImage != nil {
+			spec.InstallImage = event.InstallImage
+		}
+
+		if event.RebootStatus != nil {
+			spec.RebootStatus = event.RebootStatus
+		}
+
+		if len(event.PollStatuses) > 0 && spec.PollStatuses == nil {
+			spec.PollStatuses = make(map[string]specs.MachineStatusSpec_PollStatus, len(event.PollStatuses))
+		}
+
+		// only some of the pollers run on each poll, so keep the statuses of the other ones
+		maps.Copy(spec.PollStatuses, event.PollStatuses)
+
 		spec.Maintenance = event.MaintenanceMode
 
 		omni.MachineStatusReconcileLabels(m)
+		omni.MachineStatusReconcilePlatformLabels(m, config.Config.MachineStatusPlatformLabels)
+		omni.MachineStatusReconcileLinkDownLabel(m, time.Now(), networkLinkDownDebounce)
 
 		return nil
 	}); err != nil && !cosistate.IsPhaseConflictError(err) {
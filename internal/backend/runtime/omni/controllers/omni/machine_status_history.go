@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// MaxMachineStatusHistorySize is the upper bound of the encoded machine status history size.
+//
+// The oldest snapshots are dropped when the history doesn't fit, so that the resource stays well below the etcd value size limit.
+const MaxMachineStatusHistorySize = 512 * 1024
+
+// MachineStatusHistoryController keeps the bounded history of the machine status snapshots.
+type MachineStatusHistoryController = qtransform.QController[*omni.MachineStatus, *omni.MachineStatusHistory]
+
+// NewMachineStatusHistoryController initializes MachineStatusHistoryController.
+//
+// A snapshot is taken when the machine status changes, but not more often than the configured interval:
+// the changes within the interval are captured by a single snapshot once the interval passes.
+func NewMachineStatusHistoryController(params config.MachineStatusHistoryParams) *MachineStatusHistoryController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineStatus, *omni.MachineStatusHistory]{
+			Name: "MachineStatusHistoryController",
+			MapMetadataFunc: func(machineStatus *omni.MachineStatus) *omni.MachineStatusHistory {
+				return omni.NewMachineStatusHistory(resources.DefaultNamespace, machineStatus.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(history *omni.MachineStatusHistory) *omni.MachineStatus {
+				return omni.NewMachineStatus(resources.DefaultNamespace, history.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, _ *zap.Logger, machineStatus *omni.MachineStatus, history *omni.MachineStatusHistory) error {
+				snapshots := history.TypedSpec().Value.Snapshots
+
+				now := time.Now()
+
+				if len(snapshots) > 0 {
+					latest := snapshots[len(snapshots)-1]
+
+					if proto.Equal(latest.Status, machineStatus.TypedSpec().Value) {
+						return nil
+					}
+
+					if nextSnapshotIn := latest.TakenAt.AsTime().Add(params.Interval).Sub(now); nextSnapshotIn > 0 {
+						return controller.NewRequeueInterval(nextSnapshotIn)
+					}
+				}
+
+				snapshots = append(snapshots, &specs.MachineStatusHistorySpec_Snapshot{
+					TakenAt: timestamppb.New(now),
+					Status:  machineStatus.TypedSpec().Value.CloneVT(),
+				})
+
+				if len(snapshots) > params.Depth {
+					snapshots = snapshots[len(snapshots)-params.Depth:]
+				}
+
+				history.TypedSpec().Value.Snapshots = snapshots
+
+				// the latest snapshot is always kept, even if it doesn't fit alone
+				for len(history.TypedSpec().Value.Snapshots) > 1 && history.TypedSpec().Value.SizeVT() > MaxMachineStatusHistorySize {
+					history.TypedSpec().Value.Snapshots = history.TypedSpec().Value.Snapshots[1:]
+				}
+
+				return nil
+			},
+		},
+	)
+}
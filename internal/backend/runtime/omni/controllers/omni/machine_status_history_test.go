@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestMachineStatusHistorySuite(t *testing.T) {
+	suite.Run(t, new(MachineStatusHistorySuite))
+}
+
+type MachineStatusHistorySuite struct {
+	OmniSuite
+}
+
+func (suite *MachineStatusHistorySuite) TestSnapshots() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusHistoryController(config.MachineStatusHistoryParams{
+		Depth:    2,
+		Interval: 500 * time.Millisecond,
+	})))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "history")
+	machineStatus.TypedSpec().Value.TalosVersion = "v1.6.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	assertSnapshots := func(expectedVersions ...string) {
+		rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{machineStatus.Metadata().ID()}, func(history *omni.MachineStatusHistory, assert *assert.Assertions) {
+			snapshots := history.TypedSpec().Value.Snapshots
+
+			versions := make([]string, 0, len(snapshots))
+
+			for _, snapshot := range snapshots {
+				versions = append(versions, snapshot.Status.TalosVersion)
+			}
+
+			assert.Equal(expectedVersions, versions)
+		})
+	}
+
+	assertSnapshots("v1.6.0")
+
+	updateVersion := func(version string) {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.TalosVersion = version
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the changes within the interval are captured by a single snapshot
+	updateVersion("v1.6.1")
+	updateVersion("v1.6.2")
+
+	assertSnapshots("v1.6.0", "v1.6.2")
+
+	updateVersion("v1.6.3")
+
+	// the oldest snapshot is dropped when the depth is reached
+	assertSnapshots("v1.6.2", "v1.6.3")
+
+	history, err := safe.StateGet[*omni.MachineStatusHistory](suite.ctx, suite.state, omni.NewMachineStatusHistory(resources.DefaultNamespace, "history").Metadata())
+	suite.Require().NoError(err)
+
+	snapshots := history.TypedSpec().Value.Snapshots
+	suite.Assert().GreaterOrEqual(snapshots[1].TakenAt.AsTime().Sub(snapshots[0].TakenAt.AsTime()), 500*time.Millisecond)
+}
+
+func (suite *MachineStatusHistorySuite) TestSizeLimit() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusHistoryController(config.MachineStatusHistoryParams{
+		Depth: config.MaxMachineStatusHistoryDepth,
+	})))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "history-size")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	// each snapshot takes a third of the limit, so only the two latest ones fit
+	for i := range 5 {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.TalosVersion = strconv.Itoa(i) + strings.Repeat("v", omnictrl.MaxMachineStatusHistorySize/3)
+
+			return nil
+		})
+		suite.Require().NoError(err)
+
+		rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{machineStatus.Metadata().ID()}, func(history *omni.MachineStatusHistory, assert *assert.Assertions) {
+			snapshots := history.TypedSpec().Value.Snapshots
+
+			if assert.NotEmpty(snapshots) {
+				assert.Equal(strconv.Itoa(i), snapshots[len(snapshots)-1].Status.TalosVersion[:1])
+			}
+		})
+	}
+
+	history, err := safe.StateGet[*omni.MachineStatusHistory](suite.ctx, suite.state, omni.NewMachineStatusHistory(resources.DefaultNamespace, "history-size").Metadata())
+	suite.Require().NoError(err)
+
+	suite.Assert().Len(history.TypedSpec().Value.Snapshots, 2)
+	suite.Assert().LessOrEqual(history.TypedSpec().Value.SizeVT(), omnictrl.MaxMachineStatusHistorySize)
+}
@@ -25,11 +25,13 @@ import (
 type MachineStatusMetricsController struct {
 	versionsMu  sync.Mutex
 	versionsMap map[string]int
+	machineInfo map[string][]string
 
 	metricsOnce                 sync.Once
 	metricNumMachines           prometheus.Gauge
 	metricNumConnectedMachines  prometheus.Gauge
 	metricNumMachinesPerVersion *prometheus.Desc
+	metricMachineInfo           *prometheus.Desc
 }
 
 // Name implements controller.Controller interface.
@@ -71,9 +73,48 @@ func (ctrl *MachineStatusMetricsController) initMetrics() {
 			[]string{"talos_version"},
 			nil,
 		)
+
+		ctrl.metricMachineInfo = prometheus.NewDesc(
+			"omni_machine_info",
+			"Machine metadata collected by Omni, the value is always 1.",
+			machineInfoLabels,
+			nil,
+		)
 	})
 }
 
+var machineInfoLabels = []string{
+	"machine_id",
+	"hostname",
+	"cluster",
+	"role",
+	"talos_version",
+	"arch",
+	"platform",
+	"region",
+	"zone",
+	"instance_type",
+	"schematic_id",
+}
+
+func machineInfoLabelValues(machineStatus *omni.MachineStatus) []string {
+	spec := machineStatus.TypedSpec().Value
+
+	return []string{
+		machineStatus.Metadata().ID(),
+		spec.GetNetwork().GetHostname(),
+		spec.GetCluster(),
+		spec.GetRole().String(),
+		spec.GetTalosVersion(),
+		spec.GetHardware().GetArch(),
+		spec.GetPlatformMetadata().GetPlatform(),
+		spec.GetPlatformMetadata().GetRegion(),
+		spec.GetPlatformMetadata().GetZone(),
+		spec.GetPlatformMetadata().GetInstanceType(),
+		spec.GetSchematic().GetId(),
+	}
+}
+
 // Run implements controller.Controller interface.
 func (ctrl *MachineStatusMetricsController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
 	ctrl.initMetrics()
@@ -97,10 +138,13 @@ func (ctrl *MachineStatusMetricsController) Run(ctx context.Context, r controlle
 
 		ctrl.versionsMu.Lock()
 		ctrl.versionsMap = map[string]int{}
+		ctrl.machineInfo = make(map[string][]string, list.Len())
 
 		for iter := list.Iterator(); iter.Next(); {
 			machines++
 
+			ctrl.machineInfo[iter.Value().Metadata().ID()] = machineInfoLabelValues(iter.Value())
+
 			if iter.Value().TypedSpec().Value.Connected {
 				connectedMachines++
 			}
@@ -138,6 +182,10 @@ func (ctrl *MachineStatusMetricsController) Collect(ch chan<- prometheus.Metric)
 		ch <- prometheus.MustNewConstMetric(ctrl.metricNumMachinesPerVersion, prometheus.GaugeValue, float64(count), version)
 	}
 
+	for _, labelValues := range ctrl.machineInfo {
+		ch <- prometheus.MustNewConstMetric(ctrl.metricMachineInfo, prometheus.GaugeValue, 1, labelValues...)
+	}
+
 	ctrl.versionsMu.Unlock()
 
 	ctrl.metricNumMachines.Collect(ch)
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineStatusMetricsSuite struct {
+	OmniSuite
+}
+
+func (suite *MachineStatusMetricsSuite) TestCollect() {
+	suite.startRuntime()
+
+	controlPlane := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	controlPlane.TypedSpec().Value = &specs.MachineStatusSpec{
+		Connected:    true,
+		TalosVersion: "1.6.0",
+		Cluster:      "cluster",
+		Role:         specs.MachineStatusSpec_CONTROL_PLANE,
+		Network:      &specs.MachineStatusSpec_NetworkStatus{Hostname: "cp-1"},
+		Hardware:     &specs.MachineStatusSpec_HardwareStatus{Arch: "amd64"},
+		PlatformMetadata: &specs.MachineStatusSpec_PlatformMetadata{
+			Platform:     "aws",
+			Region:       "us-east-1",
+			Zone:         "us-east-1a",
+			InstanceType: "t3.large",
+		},
+		Schematic: &specs.MachineStatusSpec_Schematic{Id: "schematic"},
+	}
+
+	// the machine which hasn't reported anything yet still gets the info metric with the empty labels
+	unknown := omni.NewMachineStatus(resources.DefaultNamespace, "machine-2")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, controlPlane))
+	suite.Require().NoError(suite.state.Create(suite.ctx, unknown))
+
+	ctrl := &omnictrl.MachineStatusMetricsController{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(ctrl))
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		assert.NoError(collect, testutil.CollectAndCompare(ctrl, strings.NewReader(`
+# HELP omni_connected_machines Number of machines in the instance that are connected.
+# TYPE omni_connected_machines gauge
+omni_connected_machines 1
+# HELP omni_machine_info Machine metadata collected by Omni, the value is always 1.
+# TYPE omni_machine_info gauge
+omni_machine_info{arch="",cluster="",hostname="",instance_type="",machine_id="machine-2",platform="",region="",role="NONE",schematic_id="",talos_version="",zone=""} 1
+omni_machine_info{arch="amd64",cluster="cluster",hostname="cp-1",instance_type="t3.large",machine_id="machine-1",platform="aws",region="us-east-1",role="CONTROL_PLANE",schematic_id="schematic",talos_version="1.6.0",zone="us-east-1a"} 1
+# HELP omni_machines Number of machines in the instance.
+# TYPE omni_machines gauge
+omni_machines 2
+# HELP omni_machines_version Number of machines in the instance by version.
+# TYPE omni_machines_version gauge
+omni_machines_version{talos_version="1.6.0"} 1
+`)))
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestMachineStatusMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MachineStatusMetricsSuite))
+}
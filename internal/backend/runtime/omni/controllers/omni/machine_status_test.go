@@ -7,6 +7,7 @@ package omni_test
 
 import (
 	"context"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -16,7 +17,12 @@ import (
 	"github.com/siderolabs/image-factory/pkg/constants"
 	"github.com/siderolabs/image-factory/pkg/schematic"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/extensions"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -26,6 +32,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
 type MachineStatusSuite struct {
@@ -50,6 +57,8 @@ func (suite *MachineStatusSuite) TestMachineConnected() {
 	// when
 	suite.Assert().NoError(suite.state.Create(suite.ctx, machine))
 
+	var connectedAt time.Time
+
 	// then
 	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
 		statusVal := status.TypedSpec().Value
@@ -58,6 +67,10 @@ func (suite *MachineStatusSuite) TestMachineConnected() {
 
 		_, ok := status.Metadata().Labels().Get(omni.MachineStatusLabelConnected)
 		assert.Truef(ok, "connected label not set")
+
+		assert.NotNil(statusVal.ConnectivityChangedAt)
+
+		connectedAt = statusVal.ConnectivityChangedAt.AsTime()
 	})
 
 	// check that the connected label is removed again, if the machine becomes disconnected.
@@ -76,6 +89,8 @@ func (suite *MachineStatusSuite) TestMachineConnected() {
 
 		_, ok := status.Metadata().Labels().Get(omni.MachineStatusLabelConnected)
 		assert.Falsef(ok, "connected label should not be set anymore")
+
+		assert.True(statusVal.ConnectivityChangedAt.AsTime().After(connectedAt), "connectivity change time should be updated")
 	})
 }
 
@@ -108,6 +123,105 @@ func (suite *MachineStatusSuite) TestMachineReportingEvents() {
 	})
 }
 
+func (suite *MachineStatusSuite) TestMachineReserved() {
+	suite.setup()
+
+	machine := omni.NewMachine(resources.DefaultNamespace, testID)
+
+	suite.Assert().NoError(suite.state.Create(suite.ctx, machine))
+
+	reservation := omni.NewMachineReservation(resources.DefaultNamespace, testID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, reservation))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		_, ok := status.Metadata().Labels().Get(omni.LabelReserved)
+		assert.Truef(ok, "reserved label not set")
+	})
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, reservation.Metadata()))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		_, ok := status.Metadata().Labels().Get(omni.LabelReserved)
+		assert.Falsef(ok, "reserved label should not be set anymore")
+	})
+}
+
+func (suite *MachineStatusSuite) TestMachineKubernetesNodeStatus() {
+	suite.setup()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewKubernetesNodeStatusController()))
+
+	machine := omni.NewMachine(resources.DefaultNamespace, testID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machine))
+
+	// the machine is not in a cluster
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Nil(status.TypedSpec().Value.KubernetesNodeStatus)
+
+		_, ok := status.Metadata().Labels().Get(omni.MachineStatusLabelKubernetesNodeReady)
+		assert.False(ok)
+	})
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, testID)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, testID)
+	identity.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	identity.TypedSpec().Value.Nodename = "worker-1"
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, "cluster")
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{
+			Nodename: "worker-1",
+			Ready:    false,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesStatus))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		nodeStatus := status.TypedSpec().Value.KubernetesNodeStatus
+		if !assert.NotNil(nodeStatus) {
+			return
+		}
+
+		assert.Equal("worker-1", nodeStatus.Nodename)
+		assert.False(nodeStatus.Ready)
+
+		ready, _ := status.Metadata().Labels().Get(omni.MachineStatusLabelKubernetesNodeReady)
+		assert.Equal("false", ready)
+	})
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, kubernetesStatus.Metadata(), func(res *omni.KubernetesStatus) error {
+		res.TypedSpec().Value.Nodes[0].Ready = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.True(status.TypedSpec().Value.GetKubernetesNodeStatus().GetReady())
+
+		ready, _ := status.Metadata().Labels().Get(omni.MachineStatusLabelKubernetesNodeReady)
+		assert.Equal("true", ready)
+	})
+
+	// the node status is cleared when the machine leaves the cluster
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, clusterMachine.Metadata()))
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Nil(status.TypedSpec().Value.KubernetesNodeStatus)
+
+		_, ok := status.Metadata().Labels().Get(omni.MachineStatusLabelKubernetesNodeReady)
+		assert.False(ok)
+	})
+}
+
 func (suite *MachineStatusSuite) TestMachineUserLabels() {
 	suite.setup()
 
@@ -273,7 +387,14 @@ func (suite *MachineStatusSuite) TestMachineSchematic() {
 				},
 			},
 			expected: &specs.MachineStatusSpec_Schematic{
-				Invalid: true,
+				Invalid:    true,
+				Extensions: []string{"unknown"},
+				InstalledExtensions: []*specs.MachineStatusSpec_Schematic_InstalledExtension{
+					{
+						Name:    "unknown",
+						Version: "1",
+					},
+				},
 			},
 		},
 		{
@@ -305,6 +426,267 @@ func (suite *MachineStatusSuite) TestMachineSchematic() {
 	}
 }
 
+// createClusterMachine creates the connected machine allocated to a cluster, so that it is polled out of the maintenance mode.
+func (suite *MachineStatusSuite) createClusterMachine() *omni.Machine {
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, testID)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewTalosConfig(resources.DefaultNamespace, "cluster")))
+
+	machine := omni.NewMachine(resources.DefaultNamespace, testID)
+	machine.TypedSpec().Value.Connected = true
+	machine.TypedSpec().Value.ManagementAddress = suite.socketConnectionString
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machine))
+
+	return machine
+}
+
+func (suite *MachineStatusSuite) TestMachineKernelCmdline() {
+	suite.setup()
+
+	suite.machineService.setFileContents("/proc/cmdline", "BOOT_IMAGE=/A/vmlinuz console=ttyS0 talos.platform=metal\n")
+
+	suite.createClusterMachine()
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Equal("BOOT_IMAGE=/A/vmlinuz console=ttyS0 talos.platform=metal", status.TypedSpec().Value.KernelCmdline)
+	})
+}
+
+// reconnectMachine disconnects and connects the machine back, so that all its info is polled again.
+func (suite *MachineStatusSuite) reconnectMachine(machine *omni.Machine) {
+	for _, connected := range []bool{false, true} {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machine.Metadata(), func(res *omni.Machine) error {
+			res.TypedSpec().Value.Connected = connected
+
+			return nil
+		})
+		suite.Require().NoError(err)
+
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+			assert.Equal(connected, status.TypedSpec().Value.Connected)
+		})
+	}
+}
+
+func (suite *MachineStatusSuite) TestMachineBootStatus() {
+	suite.setup()
+
+	suite.machineService.setFileContents("/proc/cmdline", "BOOT_IMAGE=/A/vmlinuz talos.platform=metal")
+
+	machine := suite.createClusterMachine()
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Equal(&specs.MachineStatusSpec_BootStatus{
+			Method:    specs.MachineStatusSpec_BootStatus_DISK,
+			Installed: true,
+		}, status.TypedSpec().Value.BootStatus)
+	})
+
+	// the machine is booted from the ISO again
+	suite.machineService.setFileContents("/proc/cmdline", "BOOT_IMAGE=/boot/vmlinuz talos.platform=metal")
+
+	suite.reconnectMachine(machine)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Equal(&specs.MachineStatusSpec_BootStatus{
+			Method: specs.MachineStatusSpec_BootStatus_ISO,
+		}, status.TypedSpec().Value.BootStatus)
+	})
+}
+
+func (suite *MachineStatusSuite) TestMachineSiderolinkAddress() {
+	suite.setup()
+
+	siderolinkAddress := netip.PrefixFrom(network.ULAPrefix("cluster", network.ULASideroLink).Addr().Next(), 64)
+
+	nodeAddress := network.NewNodeAddress(network.NamespaceName, network.FilteredNodeAddressID(network.NodeAddressCurrentID, k8s.NodeAddressFilterNoK8s))
+	nodeAddress.TypedSpec().Addresses = []netip.Prefix{
+		netip.MustParsePrefix("10.5.0.2/24"),
+		siderolinkAddress,
+	}
+
+	suite.Require().NoError(suite.machineService.state.Create(suite.ctx, nodeAddress))
+
+	machine := suite.createClusterMachine()
+
+	// the SideroLink address is not reported by default
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Equal([]string{"10.5.0.2/24"}, status.TypedSpec().Value.GetNetwork().GetAddresses())
+		assert.Empty(status.TypedSpec().Value.GetNetwork().GetSiderolinkAddress())
+	})
+
+	config.Config.MachineStatusSideroLinkAddress = true
+
+	defer func() {
+		config.Config.MachineStatusSideroLinkAddress = false
+	}()
+
+	suite.reconnectMachine(machine)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.Equal([]string{"10.5.0.2/24"}, status.TypedSpec().Value.GetNetwork().GetAddresses())
+		assert.Equal(siderolinkAddress.String(), status.TypedSpec().Value.GetNetwork().GetSiderolinkAddress())
+	})
+}
+
+func (suite *MachineStatusSuite) TestMachineFirmware() {
+	suite.setup()
+
+	systemInformation := hardware.NewSystemInformation(hardware.SystemInformationID)
+	systemInformation.TypedSpec().Manufacturer = "QEMU"
+	systemInformation.TypedSpec().ProductName = "Standard PC (Q35 + ICH9, 2009)"
+	systemInformation.TypedSpec().SerialNumber = "SN0001"
+
+	suite.Require().NoError(suite.machineService.state.Create(suite.ctx, systemInformation))
+
+	suite.machineService.setFileContents("/sys/class/dmi/id/bios_vendor", "SeaBIOS\n")
+	suite.machineService.setFileContents("/sys/class/dmi/id/bios_version", "1.16.3\n")
+
+	suite.createClusterMachine()
+
+	// the BIOS date is not available, so it is left empty
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.EqualValues(&specs.MachineStatusSpec_HardwareStatus_Firmware{
+			Vendor:       "QEMU",
+			Product:      "Standard PC (Q35 + ICH9, 2009)",
+			SerialNumber: "SN0001",
+			BiosVendor:   "SeaBIOS",
+			BiosVersion:  "1.16.3",
+		}, status.TypedSpec().Value.GetHardware().GetFirmware())
+	})
+}
+
+func (suite *MachineStatusSuite) TestMachineInstallImage() {
+	suite.setup()
+
+	cfg, err := configloader.NewFromBytes([]byte(`version: v1alpha1
+machine:
+  install:
+    image: ghcr.io/siderolabs/installer:v1.6.0
+`))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.machineService.state.Create(suite.ctx, configres.NewMachineConfig(cfg)))
+
+	suite.machineService.setFileContents("/proc/cmdline", "BOOT_IMAGE=/A/vmlinuz talos.platform=metal")
+
+	machine := suite.createClusterMachine()
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.EqualValues(&specs.MachineStatusSpec_InstallImage{
+			Image:     "ghcr.io/siderolabs/installer:v1.6.0",
+			Installed: true,
+		}, status.TypedSpec().Value.InstallImage)
+	})
+
+	// the machine running from the ISO wasn't installed from the image in the config
+	suite.machineService.setFileContents("/proc/cmdline", "BOOT_IMAGE=/boot/vmlinuz talos.platform=metal")
+
+	suite.reconnectMachine(machine)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		assert.EqualValues(&specs.MachineStatusSpec_InstallImage{}, status.TypedSpec().Value.InstallImage)
+	})
+}
+
+func (suite *MachineStatusSuite) TestMachinePollStatuses() {
+	suite.setup()
+
+	suite.createClusterMachine()
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		pollStatuses := status.TypedSpec().Value.PollStatuses
+
+		assert.Equal(specs.MachineStatusSpec_DATA, pollStatuses["version"])
+		assert.Equal(specs.MachineStatusSpec_EMPTY, pollStatuses["disks"])
+		assert.Equal(specs.MachineStatusSpec_EMPTY, pollStatuses[network.HostnameStatusType])
+	})
+
+	hostname := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostname.TypedSpec().Hostname = "worker-1"
+
+	suite.Require().NoError(suite.machineService.state.Create(suite.ctx, hostname))
+
+	// only the hostname is polled again, the statuses of the other pollers are kept
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		pollStatuses := status.TypedSpec().Value.PollStatuses
+
+		assert.Equal(specs.MachineStatusSpec_DATA, pollStatuses[network.HostnameStatusType])
+		assert.Equal(specs.MachineStatusSpec_DATA, pollStatuses["version"])
+		assert.Equal(specs.MachineStatusSpec_EMPTY, pollStatuses["disks"])
+	})
+}
+
+func TestTrackNetworkLinksDown(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+
+	links := omnictrl.TrackNetworkLinksDown(nil, []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+		{LinuxName: "eth0", AdminUp: true, LinkUp: true},
+		{LinuxName: "eth1", AdminUp: true},
+		{LinuxName: "eth2"},
+	}, start)
+
+	assert.Nil(t, links[0].DownSince)
+	assert.Equal(t, start.Unix(), links[1].DownSince.AsTime().Unix())
+	assert.Nil(t, links[2].DownSince, "administratively down links are not expected to be up")
+
+	// the link stays down, so the time it went down is kept
+	links = omnictrl.TrackNetworkLinksDown(links, []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+		{LinuxName: "eth0", AdminUp: true},
+		{LinuxName: "eth1", AdminUp: true},
+		{LinuxName: "eth2"},
+	}, start.Add(time.Hour))
+
+	assert.Equal(t, start.Add(time.Hour).Unix(), links[0].DownSince.AsTime().Unix())
+	assert.Equal(t, start.Unix(), links[1].DownSince.AsTime().Unix())
+
+	// the link is back up
+	links = omnictrl.TrackNetworkLinksDown(links, []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+		{LinuxName: "eth0", AdminUp: true},
+		{LinuxName: "eth1", AdminUp: true, LinkUp: true},
+	}, start.Add(2*time.Hour))
+
+	assert.Equal(t, start.Add(time.Hour).Unix(), links[0].DownSince.AsTime().Unix())
+	assert.Nil(t, links[1].DownSince)
+}
+
+func TestHardwareChanges(t *testing.T) {
+	t.Parallel()
+
+	disk := &specs.MachineStatusSpec_HardwareStatus_BlockDevice{Model: "QEMU HARDDISK", Size: 10 * 1024 * 1024 * 1024, Serial: "QM00001", LinuxName: "/dev/sda"}
+	memory := &specs.MachineStatusSpec_HardwareStatus_MemoryModule{Description: "DIMM", SizeMb: 4096}
+
+	previous := &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices:  []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{disk},
+		MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{memory, memory},
+	}
+
+	// the initial inventory is not a change
+	assert.Empty(t, omnictrl.HardwareChanges(&specs.MachineStatusSpec_HardwareStatus{}, nil, previous.MemoryModules, previous.Blockdevices))
+
+	// the device name and the system disk flag are volatile
+	assert.Empty(t, omnictrl.HardwareChanges(previous, nil, nil, []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		{Model: "QEMU HARDDISK", Size: 10 * 1024 * 1024 * 1024, Serial: "QM00001", LinuxName: "/dev/vda", SystemDisk: true},
+	}))
+
+	// the components which weren't polled are not compared
+	assert.Empty(t, omnictrl.HardwareChanges(previous, nil, nil, nil))
+
+	assert.Equal(t, []string{
+		"memory module removed: DIMM 4096 MB",
+		"disk added: QEMU HARDDISK 5.4 GB, serial QM00002",
+	}, omnictrl.HardwareChanges(previous, nil, []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{memory}, []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		disk,
+		{Model: "QEMU HARDDISK", Size: 5 * 1024 * 1024 * 1024, Serial: "QM00002"},
+	}))
+}
+
 func TestMachineStatusSuite(t *testing.T) {
 	suite.Run(t, new(MachineStatusSuite))
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	"github.com/siderolabs/go-retry/retry"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
@@ -68,6 +69,7 @@ type machineService struct {
 	resetRequests           []*machine.ResetRequest
 	etcdRecoverRequestCount atomic.Uint64
 	files                   map[string][]string
+	fileContents            map[string]string
 	serviceList             *machine.ServiceListResponse
 	etcdLeaveClusterHandler func(context.Context, *machine.EtcdLeaveClusterRequest) (*machine.EtcdLeaveClusterResponse, error)
 
@@ -232,6 +234,31 @@ func (ms *machineService) List(req *machine.ListRequest, serv machine.MachineSer
 	return nil
 }
 
+func (ms *machineService) setFileContents(path, contents string) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	if ms.fileContents == nil {
+		ms.fileContents = map[string]string{}
+	}
+
+	ms.fileContents[path] = contents
+}
+
+func (ms *machineService) Read(req *machine.ReadRequest, serv machine.MachineService_ReadServer) error {
+	ms.lock.Lock()
+	contents, ok := ms.fileContents[req.GetPath()]
+	ms.lock.Unlock()
+
+	if !ok {
+		return status.Errorf(codes.NotFound, "file %q is not mocked", req.GetPath())
+	}
+
+	return serv.Send(&common.Data{
+		Bytes: []byte(contents),
+	})
+}
+
 func (ms *machineService) Upgrade(_ context.Context, request *machine.UpgradeRequest) (*machine.UpgradeResponse, error) {
 	ms.lock.Lock()
 	defer ms.lock.Unlock()
@@ -81,5 +81,7 @@ func NewTalosConfigController(certificateValidity time.Duration) *TalosConfigCon
 				})
 			},
 		),
+		// the talosconfigs of the imported machines are managed by the ImportedTalosConfigController
+		qtransform.WithOutputKind(controller.OutputShared),
 	)
 }
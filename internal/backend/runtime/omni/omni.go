@@ -201,9 +201,13 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewClusterUUIDController(),
 		omnictrl.NewControlPlaneStatusController(),
 		omnictrl.NewEtcdBackupEncryptionController(),
+		omnictrl.NewImportedMachineController(),
+		omnictrl.NewImportedTalosConfigController(),
 		omnictrl.NewKubeconfigController(constants.CertificateValidityTime),
+		omnictrl.NewKubernetesNodeStatusController(),
 		omnictrl.NewKubernetesUpgradeManifestStatusController(),
 		omnictrl.NewKubernetesUpgradeStatusController(),
+		omnictrl.NewKubernetesUpgradeHistoryController(),
 		omnictrl.NewMachineController(),
 		omnictrl.NewMachineSetStatusController(),
 		omnictrl.NewMachineSetEtcdAuditController(talosClientFactory, time.Minute),
@@ -220,6 +224,18 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		)
 	}
 
+	if config.Config.KeyExpiryNotification.Threshold > 0 {
+		controllers = append(controllers,
+			omnictrl.NewKeyExpiryNotifierController(config.Config.KeyExpiryNotification),
+		)
+	}
+
+	if config.Config.MachineStatusHistory.Depth > 0 {
+		qcontrollers = append(qcontrollers,
+			omnictrl.NewMachineStatusHistoryController(config.Config.MachineStatusHistory),
+		)
+	}
+
 	for _, c := range controllers {
 		if err = controllerRuntime.RegisterController(c); err != nil {
 			return nil, err
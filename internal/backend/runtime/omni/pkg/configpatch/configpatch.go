@@ -10,33 +10,67 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/xslices"
 
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
+// Source is the level the config patch is defined at.
+type Source int
+
+// Config patch sources in the order they are applied.
+const (
+	SourceCluster Source = iota
+	SourceMachineSet
+	SourceClusterMachine
+	SourceMachine
+)
+
+// Patch is a config patch along with its source.
+type Patch struct {
+	*omni.ConfigPatch
+
+	Source Source
+}
+
+// Lister is implemented by both controller.Reader and state.State.
+type Lister interface {
+	List(context.Context, resource.Kind, ...state.ListOption) (resource.List, error)
+}
+
 // Helper provides a way to lookup config patches by machine/machine-set.
 type Helper struct {
 	allConfigPatches safe.List[*omni.ConfigPatch]
 }
 
 // NewHelper creates a new config patch helper.
-func NewHelper(ctx context.Context, r controller.Reader) (*Helper, error) {
-	allConfigPatches, err := safe.ReaderListAll[*omni.ConfigPatch](ctx, r)
+func NewHelper(ctx context.Context, r Lister) (*Helper, error) {
+	list, err := r.List(ctx, resource.NewMetadata(resources.DefaultNamespace, omni.ConfigPatchType, "", resource.VersionUndefined))
 	if err != nil {
 		return nil, err
 	}
 
 	return &Helper{
-		allConfigPatches: allConfigPatches,
+		allConfigPatches: safe.NewList[*omni.ConfigPatch](list),
 	}, nil
 }
 
 // Get collects all machine config patches.
 func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet) ([]*omni.ConfigPatch, error) {
+	patches, err := h.GetWithSources(machine, machineSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return xslices.Map(patches, func(patch Patch) *omni.ConfigPatch { return patch.ConfigPatch }), nil
+}
+
+// GetWithSources collects all machine config patches in the order they are applied, along with their sources.
+func (h *Helper) GetWithSources(machine *omni.ClusterMachine, machineSet *omni.MachineSet) ([]Patch, error) {
 	clusterName, ok := machine.Metadata().Labels().Get(omni.LabelCluster)
 	if !ok {
 		return nil, fmt.Errorf("cluster machine %q doesn't have cluster label set", machine.Metadata().ID())
@@ -46,9 +80,9 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 
 	machinePatchList := h.allConfigPatches.FilterLabelQuery(resource.LabelEqual(omni.LabelMachine, machine.Metadata().ID()))
 
-	clusterPatches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len())
-	machineSetPatches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len())
-	clusterMachinePatches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len())
+	clusterPatches := make([]Patch, 0, clusterPatchList.Len())
+	machineSetPatches := make([]Patch, 0, clusterPatchList.Len())
+	clusterMachinePatches := make([]Patch, 0, clusterPatchList.Len())
 
 	for iter := clusterPatchList.Iterator(); iter.Next(); {
 		patch := iter.Value()
@@ -59,17 +93,17 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 		switch {
 		// machine set patch
 		case machineSetOk && machineSetName == machineSet.Metadata().ID():
-			machineSetPatches = append(machineSetPatches, patch)
+			machineSetPatches = append(machineSetPatches, Patch{ConfigPatch: patch, Source: SourceMachineSet})
 		// cluster machine patch
 		case clusterMachineOk && clusterMachineName == machine.Metadata().ID():
-			clusterMachinePatches = append(clusterMachinePatches, patch)
+			clusterMachinePatches = append(clusterMachinePatches, Patch{ConfigPatch: patch, Source: SourceClusterMachine})
 		// cluster patch
 		case !machineSetOk && !clusterMachineOk:
-			clusterPatches = append(clusterPatches, patch)
+			clusterPatches = append(clusterPatches, Patch{ConfigPatch: patch, Source: SourceCluster})
 		}
 	}
 
-	patches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len()+machinePatchList.Len())
+	patches := make([]Patch, 0, clusterPatchList.Len()+machinePatchList.Len())
 
 	patches = append(patches, clusterPatches...)
 	patches = append(patches, machineSetPatches...)
@@ -78,10 +112,10 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 	for iter := machinePatchList.Iterator(); iter.Next(); {
 		patch := iter.Value()
 
-		patches = append(patches, patch)
+		patches = append(patches, Patch{ConfigPatch: patch, Source: SourceMachine})
 	}
 
-	return xslices.Filter(patches, func(configPatch *omni.ConfigPatch) bool {
+	return xslices.Filter(patches, func(configPatch Patch) bool {
 		return configPatch.Metadata().Phase() == resource.PhaseRunning
 	}), nil
 }
@@ -59,6 +59,7 @@ var (
 		omni.ExposedServiceType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
+		omni.KubernetesNodeStatusType,
 		omni.MachineSetType,
 		omni.MachineSetNodeType,
 		omni.EtcdBackupType,
@@ -236,6 +237,10 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 		return nil
 	}
 
+	if err := checkClusterScope(ctx, access, clusterID, requireAll); err != nil {
+		return err
+	}
+
 	if requireAll {
 		clusterID = "any"
 	}
@@ -255,6 +260,27 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 	return filterAccess(ctx, access)
 }
 
+// checkClusterScope denies the users limited to a single cluster the access to the resources of the other clusters.
+//
+// The cluster of the resource is not known for the list and watch requests which are not filtered by the cluster,
+// so these requests are denied for the cluster related types.
+func checkClusterScope(ctx context.Context, access state.Access, clusterID resource.ID, requireAll bool) error {
+	clusterScope, ok := ctx.Value(auth.ClusterScopeContextKey{}).(string)
+	if !ok {
+		return nil
+	}
+
+	if clusterID != "" && !requireAll {
+		return auth.CheckClusterScope(ctx, clusterID)
+	}
+
+	if isClusterRelatedType(access.ResourceType) && (access.Verb == state.List || access.Verb == state.Watch) {
+		return status.Errorf(codes.PermissionDenied, "access is limited to the cluster %q, the requests must be filtered by the cluster", clusterScope)
+	}
+
+	return nil
+}
+
 func checkForKindAccess(ctx context.Context, st state.State, verb state.Verb, kind resource.Kind, labelTerms []resource.LabelTerm) error {
 	clusterID := ""
 	requireAll := false
@@ -361,12 +387,18 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.EtcdManualBackupType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
+		omni.KubernetesManifestSyncStatusType,
+		omni.KubernetesNodeStatusType,
 		omni.KubernetesStatusType,
+		omni.KubernetesUpgradeHistoryType,
 		omni.KubernetesUpgradeManifestStatusType,
 		omni.KubernetesUpgradeStatusType,
+		omni.KubernetesUpgradeWindowType,
 		omni.LoadBalancerConfigType,
 		omni.LoadBalancerStatusType,
 		omni.MachineLabelsType,
+		omni.MachineLogRetentionType,
+		omni.MachineReservationType,
 		omni.MachineSetType,
 		omni.MachineSetDestroyStatusType,
 		omni.MachineSetNodeType,
@@ -377,6 +409,7 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		siderolink.ConnectionParamsType,
 		omni.MachineType,
 		omni.MachineClassType,
+		omni.MachineGroupType,
 		omni.MachineStatusType,
 		omni.MachineStatusSnapshotType,
 		omni.MachineStatusLinkType,
@@ -385,6 +418,9 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.SchematicConfigurationType,
 		virtual.KubernetesUsageType:
 		_, err = auth.CheckGRPC(ctx, auth.WithRole(verbToRole(access.Verb)))
+	case omni.MachineStatusHistoryType:
+		// the history keeps the machine statuses with the hardware identifiers, the readers get them redacted through GetMachineStatusAt
+		_, err = auth.CheckGRPC(ctx, auth.WithRole(role.Operator))
 	case
 		meta.NamespaceType,
 		meta.ResourceDefinitionType,
@@ -484,7 +520,9 @@ func filterAccessByType(access state.Access) error {
 		omni.FeaturesConfigType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
+		omni.KubernetesNodeStatusType,
 		omni.KubernetesStatusType,
+		omni.KubernetesUpgradeHistoryType,
 		omni.KubernetesUpgradeManifestStatusType,
 		omni.KubernetesUpgradeStatusType,
 		omni.LoadBalancerConfigType,
@@ -496,6 +534,7 @@ func filterAccessByType(access state.Access) error {
 		omni.MachineStatusType,
 		omni.MachineStatusLinkType,
 		omni.MachineStatusSnapshotType,
+		omni.MachineStatusHistoryType,
 		omni.KubernetesVersionType,
 		omni.TalosExtensionsType,
 		omni.TalosVersionType,
@@ -110,6 +110,11 @@ func clusterValidationOptions(st state.State) []validated.StateOption {
 
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.Cluster, _ ...state.CreateOption) error {
+			// the suffix is reserved for the talosconfigs of the imported machines
+			if strings.HasSuffix(res.Metadata().ID(), omni.ImportedTalosConfigIDSuffix) {
+				return fmt.Errorf("cluster name can't end with %q", omni.ImportedTalosConfigIDSuffix)
+			}
+
 			if err := validateEncryption(res); err != nil {
 				return err
 			}
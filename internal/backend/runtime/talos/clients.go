@@ -59,13 +59,14 @@ func IsClientNotReadyError(e error) bool {
 
 // NewClient creates a new Talos client.
 func NewClient(c *client.Client, clusterName string) *Client {
-	return &Client{Client: c, clusterName: clusterName}
+	return &Client{Client: c, clusterName: clusterName, createdAt: time.Now()}
 }
 
 // Client wraps Talos client.
 type Client struct {
 	*client.Client
 
+	createdAt   time.Time
 	clusterName string
 }
 
@@ -130,8 +131,9 @@ type ClientFactory struct {
 	cache *expirable.LRU[string, *Client]
 	sf    singleflight.Group
 
-	metricCacheSize, metricActiveClients prometheus.Gauge
-	metricCacheHits, metricCacheMisses   prometheus.Counter
+	metricCacheSize, metricActiveClients                   prometheus.Gauge
+	metricCacheHits, metricCacheMisses, metricCacheRelease *prometheus.CounterVec
+	metricClientAge                                        *prometheus.Desc
 }
 
 // NewClientFactory initializes a ClientFactory with a built-in cache.
@@ -150,14 +152,24 @@ func NewClientFactory(omniState state.State, logger *zap.Logger) *ClientFactory
 			Name: "omni_talos_clientfactory_active_clients",
 			Help: "Number of active Talos clients created by Talos client factory.",
 		}),
-		metricCacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+		metricCacheHits: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "omni_talos_clientfactory_cache_hits_total",
 			Help: "Number of Talos client factory cache hits.",
-		}),
-		metricCacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+		}, []string{"cluster"}),
+		metricCacheMisses: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "omni_talos_clientfactory_cache_misses_total",
 			Help: "Number of Talos client factory cache misses.",
-		}),
+		}, []string{"cluster"}),
+		metricCacheRelease: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_talos_clientfactory_cache_releases_total",
+			Help: "Number of Talos clients released from the cache of Talos client factory because of the cluster endpoint or config changes.",
+		}, []string{"cluster"}),
+		metricClientAge: prometheus.NewDesc(
+			"omni_talos_clientfactory_client_age_seconds",
+			"Time since the cached Talos client was created.",
+			[]string{"cluster"},
+			nil,
+		),
 	}
 }
 
@@ -211,7 +223,7 @@ func (factory *ClientFactory) Get(ctx context.Context, clusterName string) (*Cli
 	if cli, ok := factory.cache.Get(clusterName); ok {
 		factory.logger.Debug("cache hit, returning cached Talos client", zap.String("cluster", clusterName))
 
-		factory.metricCacheHits.Inc()
+		factory.metricCacheHits.WithLabelValues(clusterName).Inc()
 
 		return cli, nil
 	}
@@ -219,7 +231,7 @@ func (factory *ClientFactory) Get(ctx context.Context, clusterName string) (*Cli
 	ch := factory.sf.DoChan(clusterName, func() (any, error) {
 		factory.logger.Debug("cache miss, creating new Talos client", zap.String("cluster", clusterName))
 
-		factory.metricCacheMisses.Inc()
+		factory.metricCacheMisses.WithLabelValues(clusterName).Inc()
 
 		cli, err := factory.build(ctx, clusterName)
 		if err != nil {
@@ -260,7 +272,16 @@ func (factory *ClientFactory) Get(ctx context.Context, clusterName string) (*Cli
 func (factory *ClientFactory) release(clusterName string) {
 	factory.logger.Debug("deleting Talos client from cache", zap.String("cluster", clusterName), zap.Stack("stack"))
 
-	factory.cache.Remove(clusterName)
+	if factory.cache.Remove(clusterName) {
+		factory.metricCacheRelease.WithLabelValues(clusterName).Inc()
+	}
+}
+
+// forget removes the cluster metrics, so that the metrics of the deleted clusters are not reported forever.
+func (factory *ClientFactory) forget(clusterName string) {
+	factory.metricCacheHits.DeleteLabelValues(clusterName)
+	factory.metricCacheMisses.DeleteLabelValues(clusterName)
+	factory.metricCacheRelease.DeleteLabelValues(clusterName)
 }
 
 func (factory *ClientFactory) build(ctx context.Context, clusterName string) (*Client, error) {
@@ -331,6 +352,10 @@ func (factory *ClientFactory) StartCacheManager(ctx context.Context) error {
 			}
 
 			factory.release(ev.Resource.Metadata().ID())
+
+			if ev.Type == state.Destroyed && ev.Resource.Metadata().Type() == omni.ClusterEndpointType {
+				factory.forget(ev.Resource.Metadata().ID())
+			}
 		}
 	}
 }
@@ -349,6 +374,11 @@ func (factory *ClientFactory) Collect(ch chan<- prometheus.Metric) {
 
 	factory.metricCacheHits.Collect(ch)
 	factory.metricCacheMisses.Collect(ch)
+	factory.metricCacheRelease.Collect(ch)
+
+	for _, cli := range factory.cache.Values() {
+		ch <- prometheus.MustNewConstMetric(factory.metricClientAge, prometheus.GaugeValue, time.Since(cli.createdAt).Seconds(), cli.clusterName)
+	}
 }
 
 var _ prometheus.Collector = &ClientFactory{}
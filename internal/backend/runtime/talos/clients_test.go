@@ -7,16 +7,21 @@ package talos_test
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 
 	"github.com/siderolabs/omni/client/pkg/constants"
@@ -107,6 +112,59 @@ func (suite *ClientsSuite) TestGetClient() {
 	suite.Require().NoError(err)
 
 	suite.Assert().Same(c1, c2)
+
+	suite.Assert().NoError(testutil.CollectAndCompare(clientFactory, strings.NewReader(`
+# HELP omni_talos_clientfactory_cache_hits_total Number of Talos client factory cache hits.
+# TYPE omni_talos_clientfactory_cache_hits_total counter
+omni_talos_clientfactory_cache_hits_total{cluster="omni"} 1
+# HELP omni_talos_clientfactory_cache_misses_total Number of Talos client factory cache misses.
+# TYPE omni_talos_clientfactory_cache_misses_total counter
+omni_talos_clientfactory_cache_misses_total{cluster="omni"} 2
+`), "omni_talos_clientfactory_cache_hits_total", "omni_talos_clientfactory_cache_misses_total"))
+
+	suite.Assert().Equal(1, testutil.CollectAndCount(clientFactory, "omni_talos_clientfactory_client_age_seconds"))
+}
+
+func (suite *ClientsSuite) TestCacheReleaseMetrics() {
+	clusterName := "metrics"
+
+	// the released client is finalized by the garbage collector, possibly after the test is done
+	clientFactory := talos.NewClientFactory(suite.state, zap.NewNop())
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(clientFactory.StartCacheManager(suite.ctx))
+	}()
+
+	clusterEndpoint := omni.NewClusterEndpoint(resources.DefaultNamespace, clusterName)
+	clusterEndpoint.TypedSpec().Value.ManagementAddresses = []string{"unix:///tmp/talos.sock"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterEndpoint))
+
+	_, err := clientFactory.Get(suite.ctx, clusterName)
+	suite.Require().NoError(err)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, clusterEndpoint.Metadata(), func(res *omni.ClusterEndpoint) error {
+		res.TypedSpec().Value.ManagementAddresses = []string{"unix:///tmp/talos2.sock"}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		assert.Equal(collect, 0, testutil.CollectAndCount(clientFactory, "omni_talos_clientfactory_client_age_seconds"))
+	}, 10*time.Second, 100*time.Millisecond)
+
+	suite.Assert().Equal(1, testutil.CollectAndCount(clientFactory, "omni_talos_clientfactory_cache_releases_total"))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, clusterEndpoint.Metadata()))
+
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		assert.Equal(collect, 0, testutil.CollectAndCount(clientFactory, "omni_talos_clientfactory_cache_misses_total"))
+		assert.Equal(collect, 0, testutil.CollectAndCount(clientFactory, "omni_talos_clientfactory_cache_releases_total"))
+	}, 10*time.Second, 100*time.Millisecond)
 }
 
 func (suite *ClientsSuite) TearDownTest() {
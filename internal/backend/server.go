@@ -261,6 +261,15 @@ func (s *Server) Run(ctx context.Context) error {
 		fns = append(fns, func() error { return runPprofServer(ctx, s.pprofBindAddress, s.logger) })
 	}
 
+	if config.Config.LogForwarding.URL != "" {
+		logForwarder, err := siderolink.NewLogForwarder(s.logHandler, &config.Config.LogForwarding, s.logger.With(logging.Component("log_forwarder")))
+		if err != nil {
+			return fmt.Errorf("failed to set up log forwarding: %w", err)
+		}
+
+		fns = append(fns, func() error { return logForwarder.Run(ctx) })
+	}
+
 	for _, fn := range fns {
 		eg.Go(fn)
 	}
@@ -399,7 +408,7 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 			return nil, err
 		}
 
-		if pubKey.TypedSpec().Value.Expiration.AsTime().Before(time.Now()) {
+		if authres.PublicKeyValidUntil(pubKey.TypedSpec().Value).Before(time.Now()) {
 			return nil, errors.New("public key expired")
 		}
 
@@ -436,11 +445,14 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 			finalRole = role.Reader
 		}
 
+		clusterScope, _ := user.Metadata().Labels().Get(authres.LabelClusterScope)
+
 		return &auth.Authenticator{
-			UserID:   userID,
-			Identity: pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
-			Role:     finalRole,
-			Verifier: verifier,
+			UserID:       userID,
+			Identity:     pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
+			ClusterScope: clusterScope,
+			Role:         finalRole,
+			Verifier:     verifier,
 		}, nil
 	}
 }
@@ -22,22 +22,29 @@ import (
 
 // RoleForCluster returns the role of the current user for the given cluster, and whether the role matches all clusters.
 func RoleForCluster(ctx context.Context, id resource.ID, st state.State) (role.Role, bool, error) {
+	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](actor.MarkContextAsInternalActor(ctx), st, authres.NewAccessPolicy().Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return role.None, false, err
+	}
+
+	return RoleForClusterWithPolicy(ctx, id, st, accessPolicy)
+}
+
+// RoleForClusterWithPolicy returns the role of the current user for the given cluster using the given access policy instead of the stored one.
+//
+// If the access policy is nil, the user role is returned as is.
+func RoleForClusterWithPolicy(ctx context.Context, id resource.ID, st state.State, accessPolicy *authres.AccessPolicy) (role.Role, bool, error) {
 	userRole, userRoleExists := ctx.Value(auth.RoleContextKey{}).(role.Role)
 	if !userRoleExists {
 		userRole = role.None
 	}
 
-	ctx = actor.MarkContextAsInternalActor(ctx)
-
-	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](ctx, st, authres.NewAccessPolicy().Metadata())
-	if err != nil {
-		if state.IsNotFoundError(err) {
-			return userRole, false, nil
-		}
-
-		return role.None, false, err
+	if accessPolicy == nil {
+		return userRole, false, nil
 	}
 
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
 	identityStr, identityExists := ctx.Value(auth.IdentityContextKey{}).(string)
 	if !identityExists {
 		return userRole, false, nil
@@ -15,10 +15,11 @@ import (
 
 // Authenticator represents an authenticator.
 type Authenticator struct {
-	Verifier message.SignatureVerifier
-	Identity string
-	UserID   string
-	Role     role.Role
+	Verifier     message.SignatureVerifier
+	Identity     string
+	UserID       string
+	ClusterScope string
+	Role         role.Role
 }
 
 // AuthenticatorFunc represents a function that returns an authenticator for the given public key fingerprint.
@@ -148,6 +148,18 @@ func Check(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 	return result, nil
 }
 
+// CheckClusterScope checks that the user in the context is allowed to access the given cluster.
+//
+// The users limited to a single cluster are denied the access to any other cluster, the other users are not affected.
+func CheckClusterScope(ctx context.Context, clusterID string) error {
+	clusterScope, ok := ctx.Value(ClusterScopeContextKey{}).(string)
+	if !ok || clusterScope == clusterID {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "access is limited to the cluster %q", clusterScope)
+}
+
 // CheckGRPC wraps Check function returning gRPC error codes.
 func CheckGRPC(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 	result, err := Check(ctx, opt...)
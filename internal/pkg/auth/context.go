@@ -20,5 +20,10 @@ type UserIDContextKey struct{}
 // RoleContextKey is the context key for the role. Value has the type role.Role.
 type RoleContextKey struct{}
 
+// ClusterScopeContextKey is the context key for the cluster the user is limited to. Value has the type string.
+//
+// It is set only for the users which are limited to a single cluster.
+type ClusterScopeContextKey struct{}
+
 // IdentityContextKey is the context key for the user identity. Value has the type string.
 type IdentityContextKey struct{}
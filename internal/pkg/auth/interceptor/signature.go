@@ -116,5 +116,9 @@ func (i *Signature) intercept(ctx context.Context) (context.Context, error) {
 	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, authenticator.Identity)
 	ctx = context.WithValue(ctx, auth.RoleContextKey{}, authenticator.Role)
 
+	if authenticator.ClusterScope != "" {
+		ctx = context.WithValue(ctx, auth.ClusterScopeContextKey{}, authenticator.ClusterScope)
+	}
+
 	return ctx, nil
 }
@@ -5,7 +5,13 @@
 
 package config
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
 
 // AuthParams configures authentication.
 //
@@ -15,6 +21,16 @@ type AuthParams struct {
 	WebAuthn WebAuthnParams `yaml:"webauthn"`
 	SAML     SAMLParams     `yaml:"saml"`
 
+	// MethodRoleOverrides overrides the role required to call the management API methods.
+	//
+	// Only the role checked on the method call is replaced, the per-cluster checks done by the methods keep their roles.
+	MethodRoleOverrides MethodRoleOverrides `yaml:"methodRoleOverrides"`
+
+	// ServiceAccountDefaultRole is the role of the service accounts created without an explicit role.
+	//
+	// The role is capped by the role of the user creating the service account.
+	ServiceAccountDefaultRole string `yaml:"serviceAccountDefaultRole"`
+
 	Suspended bool `yaml:"suspended"`
 }
 
@@ -61,3 +77,60 @@ func (s *SAMLLabelRules) Set(value string) error {
 func (SAMLLabelRules) Type() string {
 	return "JSON encoded key/value map"
 }
+
+// Validate checks the role overrides and the default service account role.
+func (p AuthParams) Validate() error {
+	if err := p.MethodRoleOverrides.Validate(); err != nil {
+		return err
+	}
+
+	parsed, err := role.Parse(p.ServiceAccountDefaultRole)
+	if err != nil {
+		return fmt.Errorf("invalid default service account role: %w", err)
+	}
+
+	if parsed == role.None {
+		return errors.New("invalid default service account role: the service accounts can not be created without any role")
+	}
+
+	return nil
+}
+
+// MethodRoleOverrides maps the management API method names (e.g. CreateSchematic) to the role required to call them.
+type MethodRoleOverrides map[string]string
+
+// Validate checks that all overrides are known roles, and that none of them allows unauthenticated access.
+func (m MethodRoleOverrides) Validate() error {
+	for method, roleName := range m {
+		parsed, err := role.Parse(roleName)
+		if err != nil {
+			return fmt.Errorf("invalid role override for method %q: %w", method, err)
+		}
+
+		if parsed == role.None {
+			return fmt.Errorf("invalid role override for method %q: the method can not be opened to unauthenticated access", method)
+		}
+	}
+
+	return nil
+}
+
+// String implements pflag.Value.
+func (m MethodRoleOverrides) String() string {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+// Set implements pflag.Value.
+func (m *MethodRoleOverrides) Set(value string) error {
+	return json.Unmarshal([]byte(value), &m)
+}
+
+// Type implements pflag.Value.
+func (MethodRoleOverrides) Type() string {
+	return "JSON encoded method/role map"
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
@@ -18,6 +19,8 @@ import (
 
 	consts "github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/common"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
 
 const (
@@ -54,8 +57,13 @@ type Params struct {
 	LoadBalancer     LoadBalancerParams `yaml:"loadbalancer"`
 	LogServerPort    int                `yaml:"logServerPort"`
 
+	// MachineLogsMaxLineSize is the maximum size of a single log line sent by the MachineLogs API, longer lines are truncated.
+	MachineLogsMaxLineSize int `yaml:"machineLogsMaxLineSize"`
+
 	LogStorage LogStorageParams `yaml:"logStorage"`
 
+	LogForwarding LogForwardingParams `yaml:"logForwarding"`
+
 	Auth AuthParams `yaml:"auth"`
 
 	InitialUsers []string `yaml:"initialUsers"`
@@ -85,6 +93,23 @@ type Params struct {
 
 	DisableControllerRuntimeCache bool `yaml:"disableControllerRuntimeCache"`
 
+	ConnectivityWebhook ConnectivityWebhookParams `yaml:"connectivityWebhook"`
+
+	KeyExpiryNotification KeyExpiryNotificationParams `yaml:"keyExpiryNotification"`
+
+	ApprovalWebhook ApprovalWebhookParams `yaml:"approvalWebhook"`
+
+	MachineStatusHistory MachineStatusHistoryParams `yaml:"machineStatusHistory"`
+
+	// MachineStatusPlatformLabels are the platform metadata fields projected as the machine status labels.
+	MachineStatusPlatformLabels []string `yaml:"machineStatusPlatformLabels"`
+
+	// MachineStatusSideroLinkAddress makes the machine status report the SideroLink address of the machine, used to troubleshoot the SideroLink connectivity.
+	MachineStatusSideroLinkAddress bool `yaml:"machineStatusSideroLinkAddress"`
+
+	// MachineStatusLongDisconnectThreshold is the time the machine should stay disconnected to be labeled as long disconnected, zero disables the label.
+	MachineStatusLongDisconnectThreshold time.Duration `yaml:"machineStatusLongDisconnectThreshold"`
+
 	LogResourceUpdatesTypes    []string
 	LogResourceUpdatesLogLevel string
 }
@@ -116,6 +141,96 @@ func (ebp EtcdBackupParams) GetStorageType() (EtcdBackupStorage, error) {
 	}
 }
 
+// ConnectivityWebhookParams defines the machine connectivity webhook configs.
+type ConnectivityWebhookParams struct {
+	// URL is the endpoint which receives the machine connectivity changes, webhook is disabled if empty.
+	URL           string        `yaml:"url"`
+	Timeout       time.Duration `yaml:"timeout"`
+	RetryDuration time.Duration `yaml:"retryDuration"`
+}
+
+// KeyExpiryNotificationParams defines the service account key expiry notification configs.
+type KeyExpiryNotificationParams struct {
+	// Threshold is how long before the expiration the key owners are notified, notifications are disabled if zero.
+	Threshold time.Duration `yaml:"threshold"`
+	Interval  time.Duration `yaml:"interval"`
+	// WebhookURL is the endpoint which receives the notifications, the notifications are only logged if empty.
+	WebhookURL    string        `yaml:"webhookURL"`
+	Timeout       time.Duration `yaml:"timeout"`
+	RetryDuration time.Duration `yaml:"retryDuration"`
+}
+
+// The destructive management operations which can be gated by the approval webhook.
+const (
+	ApprovalOperationDestroyServiceAccount = "DestroyServiceAccount"
+	// ApprovalOperationDecommissionMachine also gates the machine reset, as the decommission resets the cluster machines.
+	ApprovalOperationDecommissionMachine = "DecommissionMachine"
+)
+
+// ApprovalWebhookParams defines the approval gate for the destructive management operations.
+type ApprovalWebhookParams struct {
+	// URL is the endpoint which approves the operations.
+	URL string `yaml:"url"`
+	// Operations are the operations which require the approval, the gate is disabled if empty.
+	Operations []string      `yaml:"operations"`
+	Timeout    time.Duration `yaml:"timeout"`
+}
+
+// Validate checks that the gated operations are known and the webhook URL is set for them.
+func (p ApprovalWebhookParams) Validate() error {
+	for _, operation := range p.Operations {
+		switch operation {
+		case ApprovalOperationDestroyServiceAccount, ApprovalOperationDecommissionMachine:
+		default:
+			return fmt.Errorf("unknown approval gated operation %q", operation)
+		}
+	}
+
+	if len(p.Operations) > 0 && p.URL == "" {
+		return fmt.Errorf("approval webhook URL is required for the gated operations")
+	}
+
+	return nil
+}
+
+// RequiresApproval returns true if the operation is gated by the approval webhook.
+func (p ApprovalWebhookParams) RequiresApproval(operation string) bool {
+	return p.URL != "" && slices.Contains(p.Operations, operation)
+}
+
+// MaxMachineStatusHistoryDepth is the upper bound of the machine status snapshots kept per machine.
+//
+// The whole history is stored in a single resource, so the depth is kept small to fit into the etcd value size limit.
+const MaxMachineStatusHistoryDepth = 50
+
+// MachineStatusHistoryParams defines the machine status history configs.
+type MachineStatusHistoryParams struct {
+	// Depth is the number of the machine status snapshots kept per machine, the history is disabled if zero.
+	Depth int `yaml:"depth"`
+	// Interval is the minimum interval between the snapshots of the same machine.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Validate checks that the history depth is within the bounds.
+func (p MachineStatusHistoryParams) Validate() error {
+	if p.Depth < 0 || p.Depth > MaxMachineStatusHistoryDepth {
+		return fmt.Errorf("machine status history depth must be between 0 and %d, got %d", MaxMachineStatusHistoryDepth, p.Depth)
+	}
+
+	return nil
+}
+
+// ValidateMachineStatusPlatformLabels checks that all projected platform metadata fields are known.
+func (p *Params) ValidateMachineStatusPlatformLabels() error {
+	for _, field := range p.MachineStatusPlatformLabels {
+		if _, ok := omni.PlatformLabelFields[field]; !ok {
+			return fmt.Errorf("unknown platform metadata field %q", field)
+		}
+	}
+
+	return nil
+}
+
 // WorkloadProxyingParams defines workload proxying configs.
 type WorkloadProxyingParams struct {
 	Enabled bool `yaml:"enabled"`
@@ -176,6 +291,20 @@ type LogStorageParams struct {
 	Enabled     bool          `yaml:"enabled"`
 }
 
+// LogForwardingParams defines the machine logs forwarding configs.
+type LogForwardingParams struct {
+	// URL is the sink the machine logs are forwarded to, forwarding is disabled if empty.
+	//
+	// The tcp:// and udp:// URLs are syslog receivers, the http:// and https:// URLs are Loki push API endpoints.
+	URL string `yaml:"url"`
+	// BatchSize is the maximum number of the log lines sent at once.
+	BatchSize int `yaml:"batchSize"`
+	// FlushPeriod is how often the incomplete batch is sent.
+	FlushPeriod   time.Duration `yaml:"flushPeriod"`
+	Timeout       time.Duration `yaml:"timeout"`
+	RetryDuration time.Duration `yaml:"retryDuration"`
+}
+
 var (
 	localIP = getLocalIPOrEmpty()
 
@@ -205,12 +334,22 @@ var (
 		KeyPruner: KeyPrunerParams{
 			Interval: 10 * time.Minute,
 		},
-		LogServerPort: 8092,
+		Auth: AuthParams{
+			ServiceAccountDefaultRole: string(role.Reader),
+		},
+		LogServerPort:          8092,
+		MachineLogsMaxLineSize: 1024 * 1024,
 		LogStorage: LogStorageParams{
 			Enabled:     true,
 			Path:        "_out/logs",
 			FlushPeriod: 10 * time.Minute,
 		},
+		LogForwarding: LogForwardingParams{
+			BatchSize:     100,
+			FlushPeriod:   5 * time.Second,
+			Timeout:       10 * time.Second,
+			RetryDuration: 5 * time.Minute,
+		},
 		TalosRegistry:       consts.TalosRegistry,
 		KubernetesRegistry:  consts.KubernetesRegistry,
 		ImageFactoryBaseURL: consts.ImageFactoryBaseURL,
@@ -246,6 +385,29 @@ var (
 			MaxInterval:  24 * time.Hour,
 		},
 
+		ConnectivityWebhook: ConnectivityWebhookParams{
+			Timeout:       10 * time.Second,
+			RetryDuration: 5 * time.Minute,
+		},
+
+		MachineStatusHistory: MachineStatusHistoryParams{
+			Interval: 5 * time.Minute,
+		},
+
+		MachineStatusPlatformLabels: slices.Clone(omni.DefaultPlatformLabelFields),
+
+		MachineStatusLongDisconnectThreshold: time.Hour,
+
+		KeyExpiryNotification: KeyExpiryNotificationParams{
+			Interval:      time.Hour,
+			Timeout:       10 * time.Second,
+			RetryDuration: 5 * time.Minute,
+		},
+
+		ApprovalWebhook: ApprovalWebhookParams{
+			Timeout: 10 * time.Second,
+		},
+
 		LogResourceUpdatesLogLevel: zapcore.InfoLevel.String(),
 		LogResourceUpdatesTypes:    common.UserManagedResourceTypes,
 	}
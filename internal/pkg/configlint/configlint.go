@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package configlint implements the best practice checks for the machine config patches.
+//
+// The checks are additive to the config patch validation: they never reject the patch, but report the warnings.
+package configlint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is a single parsed document of the config patch.
+type Document map[string]any
+
+// Kind returns the kind of the document, the v1alpha1 config document has no kind.
+func (d Document) Kind() string {
+	kind, _ := d["kind"].(string)
+
+	return kind
+}
+
+// Get returns the value of the field by the dot separated path.
+func (d Document) Get(path string) (any, bool) {
+	var obj any = map[string]any(d)
+
+	for _, part := range strings.Split(path, ".") {
+		current, ok := obj.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		if obj, ok = current[part]; !ok {
+			return nil, false
+		}
+	}
+
+	return obj, true
+}
+
+// Rule is a single lint check.
+type Rule interface {
+	// ID is the unique identifier of the rule, it is used to suppress the rule.
+	ID() string
+	// Check returns the warning messages for the config patch document.
+	Check(doc Document) []string
+}
+
+// Warning is a single lint warning.
+type Warning struct {
+	RuleID  string
+	Message string
+}
+
+// Linter runs the set of rules against the config patches.
+type Linter struct {
+	rules []Rule
+}
+
+// NewLinter creates a new Linter with the given rules.
+func NewLinter(rules ...Rule) *Linter {
+	return &Linter{
+		rules: rules,
+	}
+}
+
+// Default returns the Linter with the default rules.
+func Default() *Linter {
+	return NewLinter(DefaultRules()...)
+}
+
+// Lint checks the config patch, the rules with the IDs in suppressed are skipped.
+func (l *Linter) Lint(data string, suppressed ...string) ([]Warning, error) {
+	docs, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []Warning
+
+	for _, rule := range l.rules {
+		if slices.Contains(suppressed, rule.ID()) {
+			continue
+		}
+
+		for _, doc := range docs {
+			for _, message := range rule.Check(doc) {
+				warnings = append(warnings, Warning{
+					RuleID:  rule.ID(),
+					Message: message,
+				})
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+func parse(data string) ([]Document, error) {
+	var docs []Document
+
+	decoder := yaml.NewDecoder(bytes.NewBufferString(data))
+
+	for {
+		// decoding into Document would make the nested maps Documents as well
+		var doc map[string]any
+
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+
+			return nil, fmt.Errorf("failed to parse the config patch: %w", err)
+		}
+
+		if doc != nil {
+			docs = append(docs, Document(doc))
+		}
+	}
+}
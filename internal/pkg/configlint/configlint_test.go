@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package configlint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/configlint"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		config     string
+		suppressed []string
+		want       []configlint.Warning
+	}{
+		{
+			name: "clean",
+			config: `machine:
+  network:
+    hostname: abcd
+`,
+		},
+		{
+			name: "firewall",
+			config: `machine:
+  network:
+    hostname: abcd
+---
+apiVersion: v1alpha1
+kind: NetworkDefaultActionConfig
+ingress: accept
+`,
+			want: []configlint.Warning{
+				{RuleID: "firewall-disabled", Message: "the default ingress firewall action is accept, all the traffic is allowed"},
+			},
+		},
+		{
+			name: "multiple",
+			config: `debug: true
+machine:
+  install:
+    extraKernelArgs:
+      - console=ttyS0
+      - mitigations=off
+  kubelet:
+    extraArgs:
+      anonymous-auth: "true"
+  registries:
+    config:
+      registry.local:
+        tls:
+          insecureSkipVerify: true
+`,
+			want: []configlint.Warning{
+				{RuleID: "insecure-registry", Message: `TLS verification is disabled for the registry "registry.local"`},
+				{RuleID: "anonymous-auth", Message: "anonymous authentication is enabled for the kubelet"},
+				{RuleID: "mitigations-disabled", Message: "the CPU vulnerability mitigations are disabled"},
+				{RuleID: "debug-enabled", Message: "the debug mode is enabled"},
+			},
+		},
+		{
+			name: "suppressed",
+			config: `debug: true
+machine:
+  install:
+    extraKernelArgs:
+      - mitigations=off
+`,
+			suppressed: []string{"debug-enabled"},
+			want: []configlint.Warning{
+				{RuleID: "mitigations-disabled", Message: "the CPU vulnerability mitigations are disabled"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := configlint.Default().Lint(test.config, test.suppressed...)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, warnings)
+		})
+	}
+}
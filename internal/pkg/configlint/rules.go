@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package configlint
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// DefaultRules returns the rules which are checked by default.
+func DefaultRules() []Rule {
+	return []Rule{
+		FirewallDisabled{},
+		InsecureRegistry{},
+		AnonymousAuth{},
+		MitigationsDisabled{},
+		DebugEnabled{},
+	}
+}
+
+// FirewallDisabled warns if the ingress firewall accepts all traffic by default.
+type FirewallDisabled struct{}
+
+// ID implements Rule.
+func (FirewallDisabled) ID() string {
+	return "firewall-disabled"
+}
+
+// Check implements Rule.
+func (FirewallDisabled) Check(doc Document) []string {
+	if doc.Kind() != "NetworkDefaultActionConfig" {
+		return nil
+	}
+
+	if ingress, _ := doc.Get("ingress"); ingress == "accept" {
+		return []string{"the default ingress firewall action is accept, all the traffic is allowed"}
+	}
+
+	return nil
+}
+
+// InsecureRegistry warns if the TLS verification is disabled for the registry.
+type InsecureRegistry struct{}
+
+// ID implements Rule.
+func (InsecureRegistry) ID() string {
+	return "insecure-registry"
+}
+
+// Check implements Rule.
+func (InsecureRegistry) Check(doc Document) []string {
+	value, ok := doc.Get("machine.registries.config")
+	if !ok {
+		return nil
+	}
+
+	registries, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	var warnings []string
+
+	for _, name := range sortedKeys(registries) {
+		registry, ok := registries[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if insecure, _ := Document(registry).Get("tls.insecureSkipVerify"); insecure == true {
+			warnings = append(warnings, fmt.Sprintf("TLS verification is disabled for the registry %q", name))
+		}
+	}
+
+	return warnings
+}
+
+// AnonymousAuth warns if the anonymous requests are allowed to the kubelet or the API server.
+type AnonymousAuth struct{}
+
+// ID implements Rule.
+func (AnonymousAuth) ID() string {
+	return "anonymous-auth"
+}
+
+// Check implements Rule.
+func (AnonymousAuth) Check(doc Document) []string {
+	var warnings []string
+
+	for component, path := range map[string]string{
+		"kubelet":    "machine.kubelet.extraArgs.anonymous-auth",
+		"API server": "cluster.apiServer.extraArgs.anonymous-auth",
+	} {
+		if value, _ := doc.Get(path); value == "true" || value == true {
+			warnings = append(warnings, fmt.Sprintf("anonymous authentication is enabled for the %s", component))
+		}
+	}
+
+	sort.Strings(warnings)
+
+	return warnings
+}
+
+// MitigationsDisabled warns if the CPU vulnerability mitigations are disabled with the kernel args.
+type MitigationsDisabled struct{}
+
+// ID implements Rule.
+func (MitigationsDisabled) ID() string {
+	return "mitigations-disabled"
+}
+
+// Check implements Rule.
+func (MitigationsDisabled) Check(doc Document) []string {
+	value, ok := doc.Get("machine.install.extraKernelArgs")
+	if !ok {
+		return nil
+	}
+
+	args, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+
+	if slices.Contains(args, any("mitigations=off")) {
+		return []string{"the CPU vulnerability mitigations are disabled"}
+	}
+
+	return nil
+}
+
+// DebugEnabled warns if the debug mode is enabled, it streams the kernel logs to the console.
+type DebugEnabled struct{}
+
+// ID implements Rule.
+func (DebugEnabled) ID() string {
+	return "debug-enabled"
+}
+
+// Check implements Rule.
+func (DebugEnabled) Check(doc Document) []string {
+	if debug, _ := doc.Get("debug"); debug == true {
+		return []string{"the debug mode is enabled"}
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
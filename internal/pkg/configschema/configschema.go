@@ -0,0 +1,196 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package configschema generates the JSON schema of the Talos machine config from the Talos machinery types.
+//
+// The schema is meant for the editor hints: the types with the custom YAML encoding are described, but not constrained.
+package configschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// Schema is a JSON schema node.
+type Schema struct {
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	SchemaURI            string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Examples             []string           `json:"examples,omitempty"`
+}
+
+var (
+	durationType   = reflect.TypeFor[time.Duration]()
+	documentedType = reflect.TypeFor[interface{ Doc() *encoder.Doc }]()
+)
+
+var machineConfigSchema = sync.OnceValues(func() ([]byte, error) {
+	return json.Marshal(Generate())
+})
+
+// MachineConfig returns the encoded JSON schema of the v1alpha1 machine config document.
+func MachineConfig() ([]byte, error) {
+	return machineConfigSchema()
+}
+
+// Generate builds the JSON schema of the v1alpha1 machine config document.
+func Generate() *Schema {
+	g := generator{
+		defs: map[string]*Schema{},
+	}
+
+	root := g.schema(reflect.TypeFor[v1alpha1.Config]())
+	root.SchemaURI = "https://json-schema.org/draft/2020-12/schema"
+	root.Defs = g.defs
+
+	return root
+}
+
+type generator struct {
+	defs map[string]*Schema
+}
+
+func (g *generator) schema(typ reflect.Type) *Schema {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == durationType {
+		return &Schema{Type: "string"}
+	}
+
+	// the types with the custom encoding might be represented in several ways
+	if hasCustomEncoding(typ) {
+		return &Schema{}
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schema(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(typ.Elem())}
+	case reflect.Struct:
+		return g.structRef(typ)
+	default:
+		return &Schema{}
+	}
+}
+
+// structRef puts the struct schema to the definitions, as the structs are reused and might be recursive.
+func (g *generator) structRef(typ reflect.Type) *Schema {
+	ref := &Schema{Ref: "#/$defs/" + typ.Name()}
+
+	if _, ok := g.defs[typ.Name()]; ok {
+		return ref
+	}
+
+	def := &Schema{
+		Type:                 "object",
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+
+	g.defs[typ.Name()] = def
+
+	g.addFields(def, typ)
+
+	return ref
+}
+
+func (g *generator) addFields(def *Schema, typ reflect.Type) {
+	fieldDocs := map[string]encoder.Doc{}
+
+	if reflect.PointerTo(typ).Implements(documentedType) {
+		doc := reflect.New(typ).Interface().(interface{ Doc() *encoder.Doc }).Doc() //nolint:forcetypeassert
+
+		if def.Description == "" {
+			def.Description = doc.Description
+		}
+
+		for _, field := range doc.Fields {
+			fieldDocs[field.Name] = field
+		}
+	}
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if strings.Contains(opts, "inline") {
+			fieldType := field.Type
+
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			switch fieldType.Kind() { //nolint:exhaustive
+			case reflect.Struct:
+				g.addFields(def, fieldType)
+			case reflect.Map:
+				def.AdditionalProperties = g.schema(fieldType.Elem())
+			}
+
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		property := g.schema(field.Type)
+
+		if doc, ok := fieldDocs[name]; ok {
+			property.Title = name
+			property.Description = doc.Description
+
+			// the documented values are not always exhaustive, so they are only suggested
+			if property.Type == "string" {
+				property.Examples = doc.Values
+			}
+		}
+
+		def.Properties[name] = property
+	}
+}
+
+func hasCustomEncoding(typ reflect.Type) bool {
+	ptr := reflect.PointerTo(typ)
+
+	for _, method := range []string{"MarshalYAML", "UnmarshalYAML"} {
+		if _, ok := ptr.MethodByName(method); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package configschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/configschema"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	schema := configschema.Generate()
+
+	assert.Equal(t, "#/$defs/Config", schema.Ref)
+
+	config := schema.Defs["Config"]
+	require.NotNil(t, config)
+
+	assert.Equal(t, false, config.AdditionalProperties)
+	assert.Equal(t, "#/$defs/MachineConfig", config.Properties["machine"].Ref)
+	assert.Equal(t, "Provides machine specific configuration options.", config.Properties["machine"].Description)
+	assert.Equal(t, []string{"v1alpha1"}, config.Properties["version"].Examples)
+
+	install := schema.Defs["InstallConfig"]
+	require.NotNil(t, install)
+
+	assert.Equal(t, "string", install.Properties["disk"].Type)
+	assert.Equal(t, "boolean", install.Properties["wipe"].Type)
+	assert.Equal(t, "array", install.Properties["extraKernelArgs"].Type)
+	assert.Equal(t, "string", install.Properties["extraKernelArgs"].Items.Type)
+
+	// durations are encoded as strings
+	adminKubeconfig := schema.Defs["AdminKubeconfigConfig"]
+	require.NotNil(t, adminKubeconfig)
+	assert.Equal(t, "string", adminKubeconfig.Properties["certLifetime"].Type)
+
+	// the inline maps accept any fields
+	unstructured := schema.Defs["Unstructured"]
+	require.NotNil(t, unstructured)
+	assert.Equal(t, &configschema.Schema{}, unstructured.AdditionalProperties)
+}
+
+func TestMachineConfig(t *testing.T) {
+	t.Parallel()
+
+	data, err := configschema.MachineConfig()
+	require.NoError(t, err)
+
+	var schema map[string]any
+
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+	assert.Contains(t, schema["$defs"], "ClusterConfig")
+}
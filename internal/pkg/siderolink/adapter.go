@@ -17,12 +17,17 @@ import (
 	"github.com/siderolabs/siderolink/pkg/events"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 )
 
+// machineStatusEventHistoryDepth is the number of the recent machine status events kept in the snapshot.
+const machineStatusEventHistoryDepth = 32
+
 // NewEventsAdapter creates new Events.
 func NewEventsAdapter(state state.State, logger *zap.Logger) *Adapter {
 	return &Adapter{
@@ -116,12 +121,25 @@ func (e *Adapter) handleMachineStatusEvent(ctx context.Context, event *machineap
 
 	snapshot := omni.NewMachineStatusSnapshot(resources.DefaultNamespace, machine.Metadata().ID())
 
+	recordEvent := func(spec *specs.MachineStatusSnapshotSpec) {
+		spec.MachineStatus = event
+
+		spec.RecentEvents = append(spec.RecentEvents, &specs.MachineStatusSnapshotSpec_Event{
+			ReceivedAt:    timestamppb.Now(),
+			MachineStatus: event,
+		})
+
+		if len(spec.RecentEvents) > machineStatusEventHistoryDepth {
+			spec.RecentEvents = spec.RecentEvents[len(spec.RecentEvents)-machineStatusEventHistoryDepth:]
+		}
+	}
+
 	if _, err := safe.StateUpdateWithConflicts(
 		ctx,
 		e.state,
 		snapshot.Metadata(),
 		func(status *omni.MachineStatusSnapshot) error {
-			status.TypedSpec().Value.MachineStatus = event
+			recordEvent(status.TypedSpec().Value)
 
 			return nil
 		},
@@ -130,7 +148,7 @@ func (e *Adapter) handleMachineStatusEvent(ctx context.Context, event *machineap
 			return err
 		}
 
-		snapshot.TypedSpec().Value.MachineStatus = event
+		recordEvent(snapshot.TypedSpec().Value)
 
 		return e.state.Create(ctx, snapshot)
 	}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package siderolink_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/siderolink/pkg/events"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+func TestAdapterRecentEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	machine := omni.NewMachine(resources.DefaultNamespace, "machine")
+	machine.Metadata().Labels().Set(omni.MachineAddressLabel, "fdae:41e4:649b:9303::1")
+
+	require.NoError(t, st.Create(ctx, machine))
+
+	adapter := siderolink.NewEventsAdapter(st, zaptest.NewLogger(t))
+
+	for i := range 40 {
+		stage := machineapi.MachineStatusEvent_BOOTING
+		if i%2 == 1 {
+			stage = machineapi.MachineStatusEvent_RUNNING
+		}
+
+		require.NoError(t, adapter.HandleEvent(ctx, events.Event{
+			Node:    "[fdae:41e4:649b:9303::1]:4000",
+			Payload: &machineapi.MachineStatusEvent{Stage: stage},
+		}))
+	}
+
+	snapshot, err := safe.StateGet[*omni.MachineStatusSnapshot](ctx, st, omni.NewMachineStatusSnapshot(resources.DefaultNamespace, "machine").Metadata())
+	require.NoError(t, err)
+
+	recentEvents := snapshot.TypedSpec().Value.RecentEvents
+
+	// the history is bounded, and the latest event is kept as the current status
+	assert.Len(t, recentEvents, 32)
+	assert.Equal(t, machineapi.MachineStatusEvent_RUNNING, snapshot.TypedSpec().Value.MachineStatus.Stage)
+	assert.Equal(t, machineapi.MachineStatusEvent_RUNNING, recentEvents[len(recentEvents)-1].MachineStatus.Stage)
+	assert.Equal(t, machineapi.MachineStatusEvent_BOOTING, recentEvents[0].MachineStatus.Stage)
+
+	for _, event := range recentEvents {
+		assert.NotNil(t, event.ReceivedAt)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package siderolink
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-retry/retry"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// LabelNoLogForwarding opts the machine out of the log forwarding.
+//
+// It is a user label, so it is set on the machine labels and copied to the machine status.
+const LabelNoLogForwarding = "no-log-forwarding"
+
+const (
+	// logForwardingQueueSize is the number of the log lines waiting to be sent, the readers are blocked when the queue is full,
+	// so the lines are buffered by the machine log buffers.
+	logForwardingQueueSize = 4096
+
+	// logReaderRetryInterval is how often the reader is reopened if the machine hasn't sent any logs yet, or the reader failed.
+	logReaderRetryInterval = 5 * time.Second
+)
+
+// LogForwarder continuously forwards the logs of all machines to the log sink.
+type LogForwarder struct {
+	handler *LogHandler
+	sink    LogSink
+	logger  *zap.Logger
+	queue   chan LogEntry
+
+	batchSize     int
+	flushPeriod   time.Duration
+	retryDuration time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NewLogForwarder creates a new LogForwarder.
+func NewLogForwarder(handler *LogHandler, forwardingConfig *config.LogForwardingParams, logger *zap.Logger) (*LogForwarder, error) {
+	if forwardingConfig.BatchSize <= 0 {
+		return nil, fmt.Errorf("log forwarding batch size must be positive, got %d", forwardingConfig.BatchSize)
+	}
+
+	if forwardingConfig.FlushPeriod <= 0 {
+		return nil, fmt.Errorf("log forwarding flush period must be positive, got %s", forwardingConfig.FlushPeriod)
+	}
+
+	sink, err := NewLogSink(forwardingConfig.URL, forwardingConfig.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogForwarder{
+		handler:       handler,
+		sink:          sink,
+		logger:        logger,
+		queue:         make(chan LogEntry, logForwardingQueueSize),
+		batchSize:     forwardingConfig.BatchSize,
+		flushPeriod:   forwardingConfig.FlushPeriod,
+		retryDuration: forwardingConfig.RetryDuration,
+	}, nil
+}
+
+// Run forwards the machine logs until the context is canceled.
+//
+// The machines are tracked by their statuses, as the opt-out label is a user label.
+func (f *LogForwarder) Run(ctx context.Context) error {
+	f.logger.Info("starting log forwarder")
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	defer func() {
+		cancel()
+		f.wg.Wait()
+
+		if err := f.sink.Close(); err != nil {
+			f.logger.Warn("failed to close log sink", zap.Error(err))
+		}
+	}()
+
+	eventCh := make(chan state.Event)
+
+	if err := f.handler.OmniState.WatchKind(
+		ctx,
+		omni.NewMachineStatus(resources.DefaultNamespace, "").Metadata(),
+		eventCh,
+		state.WithBootstrapContents(true),
+	); err != nil {
+		return err
+	}
+
+	f.wg.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+
+		f.ship(ctx)
+	}()
+
+	readers := map[MachineID]context.CancelFunc{}
+
+	stopReader := func(machineID MachineID) {
+		if stop, ok := readers[machineID]; ok {
+			stop()
+
+			delete(readers, machineID)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-eventCh:
+			switch event.Type {
+			case state.Bootstrapped:
+				// ignore
+			case state.Errored:
+				return fmt.Errorf("error watching machine statuses: %w", event.Error)
+			case state.Destroyed:
+				stopReader(MachineID(event.Resource.Metadata().ID()))
+			case state.Created, state.Updated:
+				machineID := MachineID(event.Resource.Metadata().ID())
+
+				if _, optOut := event.Resource.Metadata().Labels().Get(LabelNoLogForwarding); optOut {
+					stopReader(machineID)
+
+					continue
+				}
+
+				if _, ok := readers[machineID]; ok {
+					continue
+				}
+
+				readerCtx, readerCancel := context.WithCancel(ctx)
+				readers[machineID] = readerCancel
+
+				f.wg.Add(1)
+
+				go func() {
+					defer f.wg.Done()
+
+					f.forwardMachineLogs(readerCtx, machineID)
+				}()
+			}
+		}
+	}
+}
+
+// forwardMachineLogs queues the log lines of the machine, the reader is reopened if the machine has no logs yet or the reader fails.
+func (f *LogForwarder) forwardMachineLogs(ctx context.Context, machineID MachineID) {
+	for {
+		if err := f.readMachineLogs(ctx, machineID); err != nil && !IsBufferNotFoundError(err) {
+			f.logger.Warn("failed to read machine logs", zap.String("machine_id", string(machineID)), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(logReaderRetryInterval):
+		}
+	}
+}
+
+func (f *LogForwarder) readMachineLogs(ctx context.Context, machineID MachineID) error {
+	// only the new lines are forwarded, so the lines are not sent again when Omni restarts
+	reader, err := f.handler.GetReader(machineID, true, optional.Some[int32](0))
+	if err != nil {
+		return err
+	}
+
+	// the streaming reader blocks until the new data arrives, closing it unblocks the reader
+	stop := context.AfterFunc(ctx, func() {
+		reader.Close() //nolint:errcheck
+	})
+
+	defer func() {
+		stop()
+
+		reader.Close() //nolint:errcheck
+	}()
+
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case f.queue <- LogEntry{
+			Timestamp: time.Now(),
+			MachineID: machineID,
+			Line:      line,
+		}:
+		}
+	}
+}
+
+// ship sends the queued log lines in batches, the batch is dropped if it can't be sent within the retry duration.
+func (f *LogForwarder) ship(ctx context.Context) {
+	ticker := time.NewTicker(f.flushPeriod)
+	defer ticker.Stop()
+
+	batch := make([]LogEntry, 0, f.batchSize)
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := f.send(ctx, batch); err != nil && ctx.Err() == nil {
+			f.logger.Warn("failed to forward machine logs, dropping the batch", zap.Int("lines", len(batch)), zap.Error(err))
+		}
+
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			flush()
+		case entry := <-f.queue:
+			batch = append(batch, entry)
+
+			if len(batch) >= f.batchSize {
+				flush()
+			}
+		}
+	}
+}
+
+func (f *LogForwarder) send(ctx context.Context, batch []LogEntry) error {
+	return retry.Exponential(f.retryDuration, retry.WithUnits(time.Second), retry.WithJitter(100*time.Millisecond)).RetryWithContext(ctx, func(ctx context.Context) error {
+		return f.sink.Send(ctx, batch)
+	})
+}
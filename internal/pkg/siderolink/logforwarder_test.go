@@ -0,0 +1,211 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package siderolink_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+type lokiPush struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	} `json:"streams"`
+}
+
+func startLogForwarder(ctx context.Context, t *testing.T, url string, machineIDs ...string) *siderolink.LogHandler {
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	handler := siderolink.NewLogHandler(siderolink.NewMachineMap(&siderolink.MapStorage{}), st, &config.LogStorageParams{}, zaptest.NewLogger(t))
+
+	for _, machineID := range machineIDs {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, machineID)
+
+		if machineID == "opted-out" {
+			machineStatus.Metadata().Labels().Set(siderolink.LabelNoLogForwarding, "")
+		}
+
+		require.NoError(t, st.Create(ctx, machineStatus))
+
+		// the buffer should exist for the reader to be opened
+		require.NoError(t, handler.Cache.WriteMessage(siderolink.MachineID(machineID), []byte("before")))
+	}
+
+	forwarder, err := siderolink.NewLogForwarder(handler, &config.LogForwardingParams{
+		URL:           url,
+		BatchSize:     10,
+		FlushPeriod:   100 * time.Millisecond,
+		Timeout:       time.Second,
+		RetryDuration: 5 * time.Second,
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- forwarder.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+
+		require.NoError(t, <-errCh)
+	})
+
+	return handler
+}
+
+// writeUntil writes the log lines until the condition is met, the forwarder only sends the lines written after the reader is opened.
+func writeUntil(ctx context.Context, t *testing.T, handler *siderolink.LogHandler, machineID string, condition func() bool) {
+	for !condition() {
+		require.NoError(t, handler.Cache.WriteMessage(siderolink.MachineID(machineID), []byte(`{"msg":"hello"}`)))
+
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "logs were not forwarded")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestLogForwarderLoki(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	var (
+		mu    sync.Mutex
+		lines = map[string][]string{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var push lokiPush
+
+		if err := json.NewDecoder(r.Body).Decode(&push); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, stream := range push.Streams {
+			for _, value := range stream.Values {
+				lines[stream.Stream["machine_id"]] = append(lines[stream.Stream["machine_id"]], value[1])
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	t.Cleanup(server.Close)
+
+	handler := startLogForwarder(ctx, t, server.URL, "machine-1", "opted-out")
+
+	received := func(machineID string) []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return lines[machineID]
+	}
+
+	writeUntil(ctx, t, handler, "machine-1", func() bool { return len(received("machine-1")) > 0 })
+
+	for _, line := range received("machine-1") {
+		assert.Equal(t, `{"msg":"hello"}`, line)
+	}
+
+	// the opted out machine logs are not forwarded
+	require.NoError(t, handler.Cache.WriteMessage("opted-out", []byte(`{"msg":"hello"}`)))
+
+	writeUntil(ctx, t, handler, "machine-1", func() bool { return len(received("machine-1")) > 5 })
+
+	assert.Empty(t, received("opted-out"))
+}
+
+func TestLogForwarderSyslog(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	messages := make(chan string, 100)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		scanner := bufio.NewScanner(conn)
+
+		for scanner.Scan() {
+			messages <- scanner.Text()
+		}
+	}()
+
+	handler := startLogForwarder(ctx, t, "tcp://"+listener.Addr().String(), "machine-1")
+
+	var message string
+
+	writeUntil(ctx, t, handler, "machine-1", func() bool {
+		select {
+		case message = <-messages:
+			return true
+		default:
+			return false
+		}
+	})
+
+	assert.True(t, strings.HasPrefix(message, "<30>1 "), message)
+	assert.True(t, strings.HasSuffix(message, ` machine-1 talos - - - {"msg":"hello"}`), message)
+}
+
+func TestNewLogSink(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"tcp://127.0.0.1:514", "udp://127.0.0.1:514", "http://loki:3100/loki/api/v1/push"} {
+		_, err := siderolink.NewLogSink(url, time.Second)
+		assert.NoError(t, err, url)
+	}
+
+	for _, url := range []string{"ftp://127.0.0.1", "tcp://", "127.0.0.1:514"} {
+		_, err := siderolink.NewLogSink(url, time.Second)
+		assert.Error(t, err, url)
+	}
+}
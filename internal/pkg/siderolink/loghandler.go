@@ -68,6 +68,15 @@ func (h *LogHandler) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err := h.OmniState.WatchKind(
+		ctx,
+		omni.NewMachineLogRetention(resources.DefaultNamespace, "").Metadata(),
+		eventCh,
+		state.WithBootstrapContents(true),
+	); err != nil {
+		return err
+	}
+
 	var tickerCh <-chan time.Time
 
 	var storagePath string
@@ -114,11 +123,20 @@ func (h *LogHandler) Start(ctx context.Context) error {
 			}
 		case event := <-eventCh:
 			switch event.Type {
-			case state.Created, state.Updated, state.Bootstrapped:
-				// ignore
+			case state.Created, state.Updated:
+				if retention, ok := event.Resource.(*omni.MachineLogRetention); ok {
+					h.applyRetentionOverride(retention)
+				}
 			case state.Errored:
 				return fmt.Errorf("error watching machines: %w", event.Error)
 			case state.Destroyed:
+				// the override is removed, the buffer is resized back to the default capacity
+				if _, ok := event.Resource.(*omni.MachineLogRetention); ok {
+					h.resize(MachineID(event.Resource.Metadata().ID()), 0)
+
+					continue
+				}
+
 				machineID := MachineID(event.Resource.Metadata().ID())
 
 				h.Map.RemoveByMachineID(machineID)
@@ -132,6 +150,28 @@ func (h *LogHandler) Start(ctx context.Context) error {
 	}
 }
 
+// applyRetentionOverride sets the buffer capacity of the machine from the log retention resource.
+func (h *LogHandler) applyRetentionOverride(retention *omni.MachineLogRetention) {
+	machineID := MachineID(retention.Metadata().ID())
+
+	var capacity int
+
+	if value := retention.TypedSpec().Value.MaxSizeBytes; value < MinCapacityOverride || value > MaxCapacityOverride {
+		h.logger.Warn("invalid log retention override, using the default", zap.String("machine_id", string(machineID)), zap.Uint64("value", value))
+	} else {
+		capacity = int(value)
+	}
+
+	h.resize(machineID, capacity)
+}
+
+// resize sets the buffer capacity of the machine, zero capacity resets it to the default.
+func (h *LogHandler) resize(machineID MachineID, capacity int) {
+	if err := h.Cache.SetMaxCapacity(machineID, capacity); err != nil {
+		h.logger.Error("failed to resize machine buffer", zap.String("machine_id", string(machineID)), zap.Error(err))
+	}
+}
+
 // HandleMessage handles a log message.
 func (h *LogHandler) HandleMessage(srcAddress netip.Addr, rawData []byte) {
 	currentIP := srcAddress.String()
@@ -208,7 +248,10 @@ func (h *LogHandler) GetReader(machineID MachineID, follow bool, tailLines optio
 	}
 
 	if follow {
-		r = buf.GetStreamingReader()
+		r, err = h.Cache.GetStreamingReader(machineID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get buffer for machine '%s': %w", machineID, err)
+		}
 	} else {
 		r = buf.GetReader()
 	}
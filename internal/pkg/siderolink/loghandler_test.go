@@ -8,9 +8,12 @@ package siderolink_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/netip"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xtesting/must"
+	"github.com/siderolabs/go-circular"
 	"github.com/siderolabs/go-retry/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -313,3 +317,106 @@ func fileExists(path string) (bool, error) {
 
 	return err == nil, err
 }
+
+// TestLogHandlerRetentionOverride ensures that the machine buffer is resized when the log retention changes.
+func TestLogHandlerRetentionOverride(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	machineMap := siderolink.NewMachineMap(&siderolink.MapStorage{
+		IPToMachine: map[string]siderolink.MachineID{
+			"1.2.3.4": "machine-1",
+		},
+	})
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	machine := omni.NewMachine(resources.DefaultNamespace, "machine-1")
+
+	require.NoError(t, st.Create(ctx, machine))
+
+	logHandler := siderolink.NewLogHandler(machineMap, st, &config.LogStorageParams{}, zaptest.NewLogger(t))
+
+	var eg errgroup.Group
+
+	eg.Go(func() error { return logHandler.Start(ctx) })
+
+	line := strings.Repeat("a", 1000)
+
+	for i := range 200 {
+		logHandler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(fmt.Sprintf("%03d%s", i, line)))
+	}
+
+	follower, err := logHandler.GetReader("machine-1", true, optional.None[int32]())
+	require.NoError(t, err)
+
+	defer follower.Close() //nolint:errcheck
+
+	retention := omni.NewMachineLogRetention(resources.DefaultNamespace, "machine-1")
+	retention.TypedSpec().Value.MaxSizeBytes = siderolink.MinCapacityOverride
+
+	require.NoError(t, st.Create(ctx, retention))
+
+	require.NoError(t, retry.Constant(5*time.Second).Retry(func() error {
+		buffer, bufferErr := logHandler.Cache.GetBuffer("machine-1")
+		require.NoError(t, bufferErr)
+
+		if buffer.Capacity() != siderolink.MinCapacityOverride {
+			return retry.ExpectedErrorf("buffer capacity is %d", buffer.Capacity())
+		}
+
+		return nil
+	}))
+
+	// the follower is closed, as it reads the old buffer
+	for {
+		if _, err = follower.ReadLine(); err != nil {
+			break
+		}
+	}
+
+	require.ErrorIs(t, err, circular.ErrClosed)
+
+	// the most recent lines are kept
+	reader, err := logHandler.GetReader("machine-1", false, optional.None[int32]())
+	require.NoError(t, err)
+
+	var lines []string
+
+	for {
+		logLine, readErr := reader.ReadLine()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+
+		require.NoError(t, readErr)
+
+		lines = append(lines, string(logLine))
+	}
+
+	require.NotEmpty(t, lines)
+	assert.Less(t, len(lines), 200)
+	assert.Equal(t, fmt.Sprintf("%03d%s", 199, line), lines[len(lines)-1])
+
+	// removing the override resizes the buffer back to the default
+	require.NoError(t, st.Destroy(ctx, retention.Metadata()))
+
+	require.NoError(t, retry.Constant(5*time.Second).Retry(func() error {
+		for i := range 100 {
+			logHandler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(fmt.Sprintf("%03d%s", i, line)))
+		}
+
+		buffer, bufferErr := logHandler.Cache.GetBuffer("machine-1")
+		require.NoError(t, bufferErr)
+
+		if buffer.Capacity() <= siderolink.MinCapacityOverride {
+			return retry.ExpectedErrorf("buffer capacity is %d", buffer.Capacity())
+		}
+
+		return nil
+	}))
+
+	ctxCancel()
+
+	require.NoError(t, eg.Wait())
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package siderolink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+)
+
+// LogEntry is a single log line of the machine.
+type LogEntry struct {
+	Timestamp time.Time
+	MachineID MachineID
+	Line      []byte
+}
+
+// LogSink ships the machine log lines to the external system.
+//
+// Send returns the retryable errors wrapped with retry.ExpectedError.
+type LogSink interface {
+	Send(ctx context.Context, entries []LogEntry) error
+	Close() error
+}
+
+// NewLogSink creates the log sink for the URL.
+//
+// The tcp:// and udp:// URLs are syslog receivers, the http:// and https:// URLs are Loki push API endpoints.
+func NewLogSink(sinkURL string, timeout time.Duration) (LogSink, error) {
+	parsed, err := url.Parse(sinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log sink URL: %w", err)
+	}
+
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("log sink URL %q has no host", sinkURL)
+	}
+
+	switch parsed.Scheme {
+	case "tcp", "udp":
+		return &syslogSink{
+			network: parsed.Scheme,
+			address: parsed.Host,
+			timeout: timeout,
+		}, nil
+	case "http", "https":
+		return &lokiSink{
+			client: &http.Client{
+				Timeout: timeout,
+			},
+			url: sinkURL,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported log sink URL scheme %q", parsed.Scheme)
+	}
+}
+
+// syslogSink sends the log lines as RFC 5424 messages, the hostname of the message is the machine ID.
+type syslogSink struct {
+	conn    net.Conn
+	network string
+	address string
+	timeout time.Duration
+	mu      sync.Mutex
+}
+
+// syslogPriority is the priority of the messages: the daemon facility and the informational severity.
+const syslogPriority = 3*8 + 6
+
+func (s *syslogSink) Send(ctx context.Context, entries []LogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		dialer := net.Dialer{Timeout: s.timeout}
+
+		conn, err := dialer.DialContext(ctx, s.network, s.address)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return retry.ExpectedError(s.reset(err))
+	}
+
+	for _, entry := range entries {
+		// the messages are framed with the trailing newline, so each message is written separately for the datagram transport as well
+		msg := fmt.Sprintf("<%d>1 %s %s talos - - - %s\n", syslogPriority, entry.Timestamp.UTC().Format(time.RFC3339Nano), entry.MachineID, entry.Line)
+
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			return retry.ExpectedError(s.reset(err))
+		}
+	}
+
+	return nil
+}
+
+// reset drops the connection after the write error, the next Send reconnects.
+func (s *syslogSink) reset(err error) error {
+	s.conn.Close() //nolint:errcheck
+
+	s.conn = nil
+
+	return err
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+// lokiSink sends the log lines to the Loki push API, each machine is a separate stream.
+type lokiSink struct {
+	client *http.Client
+	url    string
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+func (s *lokiSink) Send(ctx context.Context, entries []LogEntry) error {
+	var request lokiPushRequest
+
+	streams := map[MachineID]*lokiStream{}
+
+	for _, entry := range entries {
+		stream, ok := streams[entry.MachineID]
+		if !ok {
+			stream = &lokiStream{
+				Stream: map[string]string{
+					"machine_id": string(entry.MachineID),
+					"source":     "omni",
+				},
+			}
+
+			streams[entry.MachineID] = stream
+			request.Streams = append(request.Streams, stream)
+		}
+
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.Timestamp.UnixNano(), 10), string(entry.Line)})
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return retry.ExpectedError(err)
+	}
+
+	resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError, resp.StatusCode == http.StatusTooManyRequests:
+		return retry.ExpectedErrorf("log sink responded with status %d", resp.StatusCode)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return fmt.Errorf("log sink responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *lokiSink) Close() error {
+	s.client.CloseIdleConnections()
+
+	return nil
+}
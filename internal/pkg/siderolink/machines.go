@@ -31,8 +31,16 @@ type MachineCache struct {
 	machineBuffers containers.LazyMap[MachineID, *circular.Buffer]
 	logger         *zap.Logger
 	Storage        optional.Optional[*LogStorage]
-	mx             sync.Mutex
-	inited         bool
+
+	// maxCapacities holds the per-machine buffer capacity overrides.
+	maxCapacities map[MachineID]int
+	// seeds holds the contents of the buffers which are being recreated, used instead of the storage.
+	seeds map[MachineID]io.Reader
+	// followers holds the streaming readers of the buffers, they are closed when the buffer is recreated.
+	followers map[MachineID]map[*follower]struct{}
+
+	mx     sync.Mutex
+	inited bool
 }
 
 // NewMachineCache returns a new MachineCache.
@@ -74,6 +82,80 @@ func (m *MachineCache) GetBuffer(id MachineID) (*circular.Buffer, error) {
 	defer m.mx.Unlock()
 	m.init()
 
+	return m.getBuffer(id)
+}
+
+// GetStreamingReader returns the streaming reader of the circular buffer for the given machine ID.
+//
+// The reader is closed if the buffer is recreated with a different capacity, so the caller should reopen it.
+func (m *MachineCache) GetStreamingReader(id MachineID) (io.ReadSeekCloser, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.init()
+
+	buffer, err := m.getBuffer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &follower{
+		StreamingReader: buffer.GetStreamingReader(),
+		cache:           m,
+		id:              id,
+	}
+
+	if m.followers[id] == nil {
+		m.followers[id] = map[*follower]struct{}{}
+	}
+
+	m.followers[id][f] = struct{}{}
+
+	return f, nil
+}
+
+// SetMaxCapacity sets the buffer capacity override for the given machine ID, zero capacity resets it to the default.
+//
+// If the buffer already exists with a different capacity, it is recreated keeping the most recent logs which fit into the new capacity.
+func (m *MachineCache) SetMaxCapacity(id MachineID, capacity int) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.init()
+
+	// the buffer is always created with the current capacity
+	previousCapacity := m.maxCapacity(id)
+
+	if capacity == 0 {
+		delete(m.maxCapacities, id)
+	} else {
+		m.maxCapacities[id] = capacity
+	}
+
+	buffer, ok := m.machineBuffers.Get(id)
+	if !ok || previousCapacity == m.maxCapacity(id) {
+		return nil
+	}
+
+	m.logger.Info("resize log buffer for machine", zap.String("machine_id", string(id)), zap.Int("capacity", m.maxCapacity(id)))
+
+	m.seeds[id] = buffer.GetReader()
+	defer delete(m.seeds, id)
+
+	m.machineBuffers.Remove(id)
+
+	if _, err := m.machineBuffers.GetOrCreate(id); err != nil {
+		return err
+	}
+
+	for f := range m.followers[id] {
+		f.StreamingReader.Close() //nolint:errcheck
+	}
+
+	delete(m.followers, id)
+
+	return nil
+}
+
+func (m *MachineCache) getBuffer(id MachineID) (*circular.Buffer, error) {
 	val, ok := m.machineBuffers.Get(id)
 	if ok {
 		return val, nil
@@ -124,6 +206,7 @@ func (m *MachineCache) Remove(id MachineID) error {
 	}
 
 	m.machineBuffers.Remove(id)
+	delete(m.maxCapacities, id)
 
 	storage, storageEnabled := m.Storage.Get()
 	if storageEnabled {
@@ -175,16 +258,29 @@ func (m *MachineCache) init() {
 	}
 
 	m.inited = true
+	m.maxCapacities = map[MachineID]int{}
+	m.seeds = map[MachineID]io.Reader{}
+	m.followers = map[MachineID]map[*follower]struct{}{}
 	m.machineBuffers = containers.LazyMap[MachineID, *circular.Buffer]{
 		Creator: func(id MachineID) (*circular.Buffer, error) {
+			maxCapacity := m.maxCapacity(id)
+
 			buffer, err := circular.NewBuffer(
-				circular.WithInitialCapacity(InitialCapacity),
-				circular.WithMaxCapacity(MaxCapacity),
+				circular.WithInitialCapacity(min(InitialCapacity, maxCapacity)),
+				circular.WithMaxCapacity(maxCapacity),
 				circular.WithSafetyGap(SafetyGap))
 			if err != nil {
 				return nil, fmt.Errorf("failed to create circular buffer for machine '%s': %w", id, err)
 			}
 
+			if seed, ok := m.seeds[id]; ok {
+				if _, err = io.Copy(buffer, seed); err != nil {
+					return nil, fmt.Errorf("failed to copy logs for machine '%s': %w", id, err)
+				}
+
+				return buffer, nil
+			}
+
 			storage, storageEnabled := m.Storage.Get()
 
 			if storageEnabled {
@@ -200,6 +296,31 @@ func (m *MachineCache) init() {
 	}
 }
 
+// maxCapacity returns the buffer capacity for the given machine ID.
+func (m *MachineCache) maxCapacity(id MachineID) int {
+	if capacity, ok := m.maxCapacities[id]; ok {
+		return capacity
+	}
+
+	return MaxCapacity
+}
+
+// follower is a streaming reader which is unregistered from the cache when closed.
+type follower struct {
+	*circular.StreamingReader
+	cache *MachineCache
+	id    MachineID
+}
+
+// Close implements io.Closer.
+func (f *follower) Close() error {
+	f.cache.mx.Lock()
+	delete(f.cache.followers[f.id], f)
+	f.cache.mx.Unlock()
+
+	return f.StreamingReader.Close()
+}
+
 // These constants should some day move to config.
 const (
 	// Some logs are tiny, no need to reserve too much memory.
@@ -208,6 +329,11 @@ const (
 	MaxCapacity = 1048576
 	// Safety gap to avoid buffer overruns.
 	SafetyGap = 2048
+
+	// MinCapacityOverride is the smallest allowed per-machine capacity override.
+	MinCapacityOverride = 65536
+	// MaxCapacityOverride is the largest allowed per-machine capacity override.
+	MaxCapacityOverride = 67108864
 )
 
 // NewMachineMap returns a new MachineMap.